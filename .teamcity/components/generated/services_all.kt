@@ -166,6 +166,7 @@ val services = mapOf(
     "networkfirewall" to ServiceSpec("Network Firewall", vpcLock = true),
     "networkmanager" to ServiceSpec("Network Manager", vpcLock = true),
     "networkmonitor" to ServiceSpec("CloudWatch Network Monitor"),
+    "notifications" to ServiceSpec("User Notifications"),
     "oam" to ServiceSpec("CloudWatch Observability Access Manager"),
     "opensearch" to ServiceSpec("OpenSearch", vpcLock = true),
     "opensearchserverless" to ServiceSpec("OpenSearch Serverless"),
@@ -256,6 +257,7 @@ val services = mapOf(
     "wavelength" to ServiceSpec("Wavelength", vpcLock = true, patternOverride = "TestAccWavelength", splitPackageRealPackage = "ec2"),
     "wellarchitected" to ServiceSpec("Well-Architected Tool"),
     "worklink" to ServiceSpec("WorkLink"),
+    "workmail" to ServiceSpec("WorkMail"),
     "workspaces" to ServiceSpec("WorkSpaces", vpcLock = true),
     "workspacesweb" to ServiceSpec("WorkSpaces Web"),
     "xray" to ServiceSpec("X-Ray"),
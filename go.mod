@@ -181,6 +181,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/networkfirewall v1.47.1
 	github.com/aws/aws-sdk-go-v2/service/networkmanager v1.34.0
 	github.com/aws/aws-sdk-go-v2/service/networkmonitor v1.8.1
+	github.com/aws/aws-sdk-go-v2/service/notifications v1.2.2
 	github.com/aws/aws-sdk-go-v2/service/oam v1.17.2
 	github.com/aws/aws-sdk-go-v2/service/opensearch v1.46.1
 	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.19.1
@@ -264,6 +265,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/wafv2 v1.60.0
 	github.com/aws/aws-sdk-go-v2/service/wellarchitected v1.35.1
 	github.com/aws/aws-sdk-go-v2/service/worklink v1.23.2
+	github.com/aws/aws-sdk-go-v2/service/workmail v1.25.10
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.55.1
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.27.1
 	github.com/aws/aws-sdk-go-v2/service/xray v1.31.1
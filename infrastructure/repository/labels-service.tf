@@ -236,6 +236,7 @@ variable "service_labels" {
     "networkmanager",
     "networkmonitor",
     "nimble",
+    "notifications",
     "oam",
     "opensearch",
     "opensearchserverless",
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"testing"
+)
+
+// FixturePool shares long-running, heavyweight test dependencies (for example Redshift Serverless
+// workgroups or OpenSearch domains) across the test cases of a single test run.
+// Each fixture is leased exclusively by one test at a time and returned to the pool when that test
+// completes. Fixtures are created lazily, at most size at once, and are destroyed by DestroyFixturePools.
+type FixturePool[T any] struct {
+	name    string
+	create  func(context.Context) (T, error)
+	destroy func(context.Context, T) error
+
+	// leases bounds the number of outstanding leases and therefore the number of fixtures created.
+	leases chan struct{}
+
+	mu   sync.Mutex
+	idle []T
+	all  []T
+}
+
+var fixturePools = &struct {
+	mu    sync.Mutex
+	store []interface {
+		destroyAll(context.Context) error
+	}
+}{}
+
+// NewFixturePool returns a new pool that holds at most size fixtures of one kind.
+// create is called when a test leases a fixture and none is idle; destroy is called once per created fixture
+// by DestroyFixturePools.
+func NewFixturePool[T any](name string, size int, create func(context.Context) (T, error), destroy func(context.Context, T) error) *FixturePool[T] {
+	if size < 1 {
+		size = 1
+	}
+
+	pool := &FixturePool[T]{
+		name:    name,
+		create:  create,
+		destroy: destroy,
+		leases:  make(chan struct{}, size),
+	}
+
+	fixturePools.mu.Lock()
+	defer fixturePools.mu.Unlock()
+
+	fixturePools.store = append(fixturePools.store, pool)
+
+	return pool
+}
+
+// Lease returns a fixture for the exclusive use of the test, creating one if none is idle.
+// It blocks while all fixtures are leased by other tests. The fixture is returned to the pool when the test completes.
+func (p *FixturePool[T]) Lease(ctx context.Context, t *testing.T) T {
+	t.Helper()
+
+	v, err := p.lease(ctx)
+
+	if err != nil {
+		t.Fatalf("leasing %s fixture: %s", p.name, err)
+	}
+
+	t.Cleanup(func() {
+		p.release(v)
+	})
+
+	return v
+}
+
+func (p *FixturePool[T]) lease(ctx context.Context) (T, error) {
+	var zero T
+
+	select {
+	case p.leases <- struct{}{}:
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+
+	p.mu.Lock()
+	if n := len(p.idle); n > 0 {
+		v := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.mu.Unlock()
+
+		return v, nil
+	}
+	p.mu.Unlock()
+
+	log.Printf("[INFO] Creating %s fixture", p.name)
+	v, err := p.create(ctx)
+
+	if err != nil {
+		<-p.leases
+
+		return zero, err
+	}
+
+	p.mu.Lock()
+	p.all = append(p.all, v)
+	p.mu.Unlock()
+
+	return v, nil
+}
+
+func (p *FixturePool[T]) release(v T) {
+	p.mu.Lock()
+	p.idle = append(p.idle, v)
+	p.mu.Unlock()
+
+	<-p.leases
+}
+
+func (p *FixturePool[T]) destroyAll(ctx context.Context) error {
+	p.mu.Lock()
+	all := p.all
+	p.all, p.idle = nil, nil
+	p.mu.Unlock()
+
+	var errs []error
+	for _, v := range all {
+		log.Printf("[INFO] Destroying %s fixture", p.name)
+		if err := p.destroy(ctx, v); err != nil {
+			errs = append(errs, fmt.Errorf("destroying %s fixture: %w", p.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// DestroyFixturePools destroys every fixture created by any pool during the test run.
+func DestroyFixturePools(ctx context.Context) error {
+	fixturePools.mu.Lock()
+	pools := fixturePools.store
+	fixturePools.mu.Unlock()
+
+	var errs []error
+	for _, pool := range pools {
+		if err := pool.destroyAll(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// RunWithFixturePools runs the tests and then destroys any pooled fixtures.
+// Call it from a service package's TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		acctest.RunWithFixturePools(m)
+//	}
+func RunWithFixturePools(m *testing.M) {
+	code := m.Run()
+
+	if err := DestroyFixturePools(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "destroying test fixtures: %s\n", err)
+		if code == 0 {
+			code = 1
+		}
+	}
+
+	os.Exit(code)
+}
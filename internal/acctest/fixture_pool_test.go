@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestFixturePool(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var (
+		created, destroyed, inUse, maxInUse atomic.Int32
+		mu                                  sync.Mutex
+		leased                              = make(map[string]bool)
+	)
+
+	pool := acctest.NewFixturePool("test", 2, func(context.Context) (string, error) {
+		return fmt.Sprintf("fixture-%d", created.Add(1)), nil
+	}, func(context.Context, string) error {
+		destroyed.Add(1)
+		return nil
+	})
+
+	t.Run("group", func(t *testing.T) {
+		for i := range 10 {
+			t.Run(fmt.Sprintf("lease-%d", i), func(t *testing.T) {
+				t.Parallel()
+
+				v := pool.Lease(ctx, t)
+
+				mu.Lock()
+				if leased[v] {
+					t.Errorf("fixture %s leased more than once", v)
+				}
+				leased[v] = true
+				mu.Unlock()
+
+				n := inUse.Add(1)
+				for {
+					m := maxInUse.Load()
+					if n <= m || maxInUse.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				inUse.Add(-1)
+
+				mu.Lock()
+				leased[v] = false
+				mu.Unlock()
+			})
+		}
+	})
+
+	if got, want := created.Load(), int32(2); got > want {
+		t.Errorf("created = %d, want at most %d", got, want)
+	}
+	if got, want := maxInUse.Load(), int32(2); got > want {
+		t.Errorf("leased at once = %d, want at most %d", got, want)
+	}
+
+	if err := acctest.DestroyFixturePools(ctx); err != nil {
+		t.Fatalf("DestroyFixturePools: %s", err)
+	}
+
+	if got, want := destroyed.Load(), created.Load(); got != want {
+		t.Errorf("destroyed = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	apiErrorsMiddlewareID = "TerraformProviderAWSAPIErrors"
+)
+
+// APIErrors records the results of the AWS API calls made with a Context.
+// The errors keep their types, so they can be inspected with errs.IsA.
+type APIErrors struct {
+	errs []error // nil for a successful call.
+	mu   sync.Mutex
+}
+
+type apiErrorsContextKeyType int
+
+var apiErrorsContextKey apiErrorsContextKeyType
+
+// NewAPIErrorsContext returns a Context in which the results of AWS API calls are recorded.
+func NewAPIErrorsContext(ctx context.Context) (context.Context, *APIErrors) {
+	v := &APIErrors{}
+	return context.WithValue(ctx, apiErrorsContextKey, v), v
+}
+
+// APIErrorsFromContext returns the APIErrors recorded in the Context, if any.
+func APIErrorsFromContext(ctx context.Context) (*APIErrors, bool) {
+	v, ok := ctx.Value(apiErrorsContextKey).(*APIErrors)
+	return v, ok
+}
+
+// Record records the result of an AWS API call. A nil error records a successful call.
+func (r *APIErrors) Record(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.errs = append(r.errs, err)
+}
+
+// LastError returns the error returned by the most recent AWS API call if that call failed with an error for which match returns true.
+func (r *APIErrors) LastError(match func(error) bool) (error, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := len(r.errs)
+	if n == 0 {
+		return nil, false
+	}
+
+	if err := r.errs[n-1]; err != nil && match(err) {
+		return err, true
+	}
+
+	return nil, false
+}
+
+// recordAPIErrors adds middleware that records API call results in the call's Context.
+func recordAPIErrors(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(apiErrorsMiddlewareID, handleInitializeRecordAPIErrors), middleware.Before)
+}
+
+func handleInitializeRecordAPIErrors(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	if v, ok := APIErrorsFromContext(ctx); ok {
+		v.Record(err)
+	}
+
+	return out, metadata, err
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestRecordAPIErrors(t *testing.T) {
+	t.Parallel()
+
+	apiErr := &smithy.GenericAPIError{Code: "ResourceNotFoundException", Message: "not found"}
+
+	call := func(ctx context.Context, err error) error {
+		stack := middleware.NewStack("DeleteThing", func() any { return struct{}{} })
+		if err := recordAPIErrors(stack); err != nil {
+			t.Fatalf("adding API errors middleware: %s", err)
+		}
+
+		handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+			return nil, middleware.Metadata{}, err
+		}), stack)
+
+		_, _, err = handler.Handle(ctx, struct{}{})
+
+		return err
+	}
+
+	// Results are not recorded without APIErrors in the Context.
+	if err := call(context.Background(), apiErr); !errors.Is(err, apiErr) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, apiErrors := NewAPIErrorsContext(context.Background())
+
+	isNotFound := errs.IsA[*smithy.GenericAPIError]
+	isOther := func(error) bool { return false }
+
+	if _, ok := apiErrors.LastError(isNotFound); ok {
+		t.Errorf("LastError with no calls = true, want false")
+	}
+
+	if err := call(ctx, apiErr); !errors.Is(err, apiErr) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err, ok := apiErrors.LastError(isNotFound); !ok || !errors.Is(err, apiErr) {
+		t.Errorf("LastError = %v, %t, want %v, true", err, ok, apiErr)
+	}
+	if _, ok := apiErrors.LastError(isOther); ok {
+		t.Errorf("LastError with non-matching error = true, want false")
+	}
+
+	if err := call(ctx, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := apiErrors.LastError(isNotFound); ok {
+		t.Errorf("LastError after successful call = true, want false")
+	}
+}
@@ -4,6 +4,8 @@
 package conns
 
 import (
+	"errors"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 )
@@ -22,6 +24,10 @@ type withIsErrorRetryables struct {
 }
 
 func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
+	// Errors from mutating operations for which retries are disabled are never retried.
+	if errors.As(err, new(*nonRetryableError)) {
+		return false
+	}
 	if v := r.retryables.IsErrorRetryable(err); v != aws.UnknownTernary {
 		return v.Bool()
 	}
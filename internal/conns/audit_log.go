@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	auditLogMiddlewareID = "TerraformProviderAWSAuditLog"
+)
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operation names that do not mutate resources.
+// Calls to these operations are not recorded in the audit log.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// AuditLogger writes a JSON Lines record for every mutating AWS API call made by the provider.
+type AuditLogger struct {
+	closer io.Closer
+	key    []byte
+	mu     sync.Mutex
+	now    func() time.Time
+	w      io.Writer
+}
+
+// AuditRecord is a single audit log entry.
+// Request parameters are never logged, only an HMAC-SHA256 of their JSON representation.
+// The HMAC key is generated randomly for each AuditLogger and never written, so hashes can be
+// correlated within a single provider run but can't be used to guess low-entropy parameter values such as passwords.
+type AuditRecord struct {
+	DurationMS int64     `json:"duration_ms"`
+	Error      string    `json:"error,omitempty"`
+	Operation  string    `json:"operation"`
+	ParamsHash string    `json:"params_hash,omitempty"`
+	Region     string    `json:"region,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+	Service    string    `json:"service"`
+	Time       time.Time `json:"time"`
+}
+
+// NewAuditLogger returns an AuditLogger that appends records to the file at the specified path.
+// The file is created if it does not exist.
+func NewAuditLogger(path string) (*AuditLogger, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := NewAuditLoggerWithWriter(f)
+	if err != nil {
+		return nil, errors.Join(err, f.Close())
+	}
+	l.closer = f
+
+	return l, nil
+}
+
+// NewAuditLoggerWithWriter returns an AuditLogger that writes records to the specified writer.
+// Closing the AuditLogger does not close the writer.
+func NewAuditLoggerWithWriter(w io.Writer) (*AuditLogger, error) {
+	key := make([]byte, sha256.Size)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	return &AuditLogger{
+		key: key,
+		now: time.Now,
+		w:   w,
+	}, nil
+}
+
+// Close closes the audit log file, if the AuditLogger opened one.
+// Records for API calls made after Close are discarded.
+func (l *AuditLogger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.w = io.Discard
+
+	if l.closer == nil {
+		return nil
+	}
+
+	err := l.closer.Close()
+	l.closer = nil
+
+	return err
+}
+
+// APIOption adds the audit logging middleware to an AWS SDK for Go v2 middleware stack.
+// It is intended to be appended to aws.Config.APIOptions.
+func (l *AuditLogger) APIOption(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(auditLogMiddlewareID, l.handleInitialize), middleware.After)
+}
+
+func (l *AuditLogger) handleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	operation := awsmiddleware.GetOperationName(ctx)
+
+	if !isMutatingOperation(operation) {
+		return next.HandleInitialize(ctx, in)
+	}
+
+	start := l.now()
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	record := AuditRecord{
+		DurationMS: l.now().Sub(start).Milliseconds(),
+		Operation:  operation,
+		ParamsHash: l.paramsHash(in.Parameters),
+		Region:     awsmiddleware.GetRegion(ctx),
+		Service:    awsmiddleware.GetServiceID(ctx),
+		Time:       start.UTC(),
+	}
+
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		record.RequestID = v
+	}
+
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			record.Error = apiErr.ErrorCode()
+		} else {
+			record.Error = err.Error()
+		}
+	}
+
+	l.write(record)
+
+	return out, metadata, err
+}
+
+func (l *AuditLogger) write(record AuditRecord) {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Audit logging must never cause an API call to fail.
+	_, _ = l.w.Write(append(b, '\n'))
+}
+
+func isMutatingOperation(operation string) bool {
+	if operation == "" {
+		return false
+	}
+
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (l *AuditLogger) paramsHash(params any) string {
+	if params == nil {
+		return ""
+	}
+
+	b, err := json.Marshal(params)
+	if err != nil {
+		return ""
+	}
+
+	mac := hmac.New(sha256.New, l.key)
+	mac.Write(b)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAuditLogger(t *testing.T) {
+	t.Parallel()
+
+	type input struct {
+		Name   *string
+		Secret *string
+	}
+
+	name, secret := "example", "s3cr3t"
+
+	testCases := map[string]struct {
+		operation     string
+		err           error
+		expectRecord  bool
+		expectedError string
+	}{
+		"create": {
+			operation:    "CreateLayer",
+			expectRecord: true,
+		},
+		"delete error": {
+			operation:     "DeleteIntegration",
+			err:           &smithy.GenericAPIError{Code: "ResourceNotFoundException", Message: "not found"},
+			expectRecord:  true,
+			expectedError: "ResourceNotFoundException",
+		},
+		"describe": {
+			operation: "DescribeLayers",
+		},
+		"get": {
+			operation: "GetIntegration",
+		},
+		"list": {
+			operation: "ListTagsForResource",
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			logger, err := conns.NewAuditLoggerWithWriter(&buf)
+			if err != nil {
+				t.Fatalf("creating audit logger: %s", err)
+			}
+
+			err = testAuditLogCall(t, logger, testCase.operation, &input{Name: &name, Secret: &secret}, testCase.err)
+
+			if !errors.Is(err, testCase.err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !testCase.expectRecord {
+				if buf.Len() != 0 {
+					t.Fatalf("unexpected audit record: %s", buf.String())
+				}
+				return
+			}
+
+			if bytes.Contains(buf.Bytes(), []byte(secret)) {
+				t.Fatalf("audit record contains request parameters: %s", buf.String())
+			}
+
+			var record conns.AuditRecord
+			if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+				t.Fatalf("decoding audit record: %s", err)
+			}
+
+			if got, want := record.Operation, testCase.operation; got != want {
+				t.Errorf("operation = %q, want %q", got, want)
+			}
+			if got, want := record.Service, "OpsWorks"; got != want {
+				t.Errorf("service = %q, want %q", got, want)
+			}
+			if got, want := record.Region, "test-region-1"; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+			if got, want := record.RequestID, "request-1"; got != want {
+				t.Errorf("request_id = %q, want %q", got, want)
+			}
+			if got, want := len(record.ParamsHash), 64; got != want {
+				t.Errorf("len(params_hash) = %d, want %d", got, want)
+			}
+			if got, want := record.Error, testCase.expectedError; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAuditLoggerParamsHash(t *testing.T) {
+	t.Parallel()
+
+	type input struct {
+		Password *string
+	}
+
+	password := "password1"
+	params := &input{Password: &password}
+
+	paramsHash := func(t *testing.T, logger *conns.AuditLogger, buf *bytes.Buffer) string {
+		t.Helper()
+
+		buf.Reset()
+		if err := testAuditLogCall(t, logger, "CreateUser", params, nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		var record conns.AuditRecord
+		if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+			t.Fatalf("decoding audit record: %s", err)
+		}
+
+		return record.ParamsHash
+	}
+
+	var buf1, buf2 bytes.Buffer
+	logger1, err := conns.NewAuditLoggerWithWriter(&buf1)
+	if err != nil {
+		t.Fatalf("creating audit logger: %s", err)
+	}
+	logger2, err := conns.NewAuditLoggerWithWriter(&buf2)
+	if err != nil {
+		t.Fatalf("creating audit logger: %s", err)
+	}
+
+	hash1 := paramsHash(t, logger1, &buf1)
+
+	if got, want := paramsHash(t, logger1, &buf1), hash1; got != want {
+		t.Errorf("params_hash = %q, want %q for the same parameters", got, want)
+	}
+
+	if got := paramsHash(t, logger2, &buf2); got == hash1 {
+		t.Errorf("params_hash = %q for different audit loggers, want different hashes", got)
+	}
+
+	b, err := json.Marshal(params)
+	if err != nil {
+		t.Fatalf("encoding parameters: %s", err)
+	}
+	if unkeyed := sha256.Sum256(b); hash1 == hex.EncodeToString(unkeyed[:]) {
+		t.Errorf("params_hash is an unkeyed SHA-256 hash")
+	}
+}
+
+func TestAuditLoggerClose(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	logger, err := conns.NewAuditLogger(path)
+	if err != nil {
+		t.Fatalf("creating audit logger: %s", err)
+	}
+
+	if err := testAuditLogCall(t, logger, "CreateLayer", struct{}{}, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatalf("closing audit logger: %s", err)
+	}
+
+	// Calls after Close must still succeed and are not recorded.
+	if err := testAuditLogCall(t, logger, "DeleteLayer", struct{}{}, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatalf("closing audit logger again: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading audit log: %s", err)
+	}
+
+	if got, want := bytes.Count(b, []byte("\n")), 1; got != want {
+		t.Errorf("audit records = %d, want %d: %s", got, want, b)
+	}
+	if !bytes.Contains(b, []byte(`"operation":"CreateLayer"`)) {
+		t.Errorf("audit log does not contain the CreateLayer record: %s", b)
+	}
+}
+
+// testAuditLogCall runs an API call with the specified operation name and parameters through a
+// middleware stack with the audit log middleware and returns the call's error.
+func testAuditLogCall(t *testing.T, logger *conns.AuditLogger, operation string, params any, callErr error) error {
+	t.Helper()
+
+	stack := middleware.NewStack(operation, func() any { return struct{}{} })
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		OperationName: operation,
+		Region:        "test-region-1",
+		ServiceID:     "OpsWorks",
+	}, middleware.Before); err != nil {
+		t.Fatalf("adding service metadata middleware: %s", err)
+	}
+	if err := logger.APIOption(stack); err != nil {
+		t.Fatalf("adding audit log middleware: %s", err)
+	}
+
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+		var metadata middleware.Metadata
+		awsmiddleware.SetRequestIDMetadata(&metadata, "request-1")
+		return nil, metadata, callErr
+	}), stack)
+
+	_, _, err := handler.Handle(context.Background(), params)
+
+	return err
+}
@@ -28,23 +28,37 @@ import (
 )
 
 type AWSClient struct {
-	accountID                 string
-	awsConfig                 *aws.Config
-	clients                   map[string]any
-	defaultTagsConfig         *tftags.DefaultConfig
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	ignoreTagsConfig          *tftags.IgnoreConfig
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	partition                 endpoints.Partition
-	region                    string
-	servicePackages           map[string]ServicePackage
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	accountID                   string
+	auditLogger                 *AuditLogger
+	awsConfig                   *aws.Config
+	clients                     map[string]any
+	defaultTagsConfig           *tftags.DefaultConfig
+	endpoints                   map[string]string // From provider configuration.
+	httpClient                  *http.Client
+	ignoreTagsConfig            *tftags.IgnoreConfig
+	lock                        sync.Mutex
+	logger                      baselogging.Logger
+	partition                   endpoints.Partition
+	region                      string
+	resourceTimeouts            *ResourceTimeouts // From provider configuration.
+	serviceCaches               map[string]any
+	servicePackages             map[string]ServicePackage
+	session                     *session_sdkv1.Session
+	s3ExpressClient             *s3.Client
+	s3UsePathStyle              bool   // From provider configuration.
+	s3USEast1RegionalEndpoint   string // From provider configuration.
+	skipIAMRoleInlinePolicyRead bool   // From provider configuration.
+	skipRegionValidation        bool   // From provider configuration.
+	stsRegion                   string // From provider configuration.
+}
+
+// Close releases resources held by the client, such as the audit log file.
+func (c *AWSClient) Close() error {
+	if c.auditLogger == nil {
+		return nil
+	}
+
+	return c.auditLogger.Close()
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -63,6 +77,25 @@ func (c *AWSClient) ServicePackages(context.Context) iter.Seq2[string, ServicePa
 	return maps.All(c.servicePackages)
 }
 
+// ServiceCache returns the service-specific cache with the specified key, calling newCache to create it on first use.
+// A cache lives as long as the client, so it is never shared between provider configurations.
+func (c *AWSClient) ServiceCache(_ context.Context, key string, newCache func() any) any {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.serviceCaches == nil {
+		c.serviceCaches = make(map[string]any)
+	}
+
+	v, ok := c.serviceCaches[key]
+	if !ok {
+		v = newCache()
+		c.serviceCaches[key] = v
+	}
+
+	return v
+}
+
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
 func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider {
 	if c.awsConfig == nil {
@@ -71,7 +104,13 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the provider's default tags configuration.
+// No default tags apply to resource types excluded by the configuration.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if v, ok := FromContext(ctx); ok && c.defaultTagsConfig.ExcludesResourceType(v.TypeName()) {
+		return nil
+	}
+
 	return c.defaultTagsConfig
 }
 
@@ -102,8 +141,12 @@ func (c *AWSClient) Partition(context.Context) string {
 	return c.partition.ID()
 }
 
-// Region returns the ID of the configured AWS Region.
-func (c *AWSClient) Region(context.Context) string {
+// Region returns the ID of the configured AWS Region, or of the Region that overrides it in the Context.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if region, ok := regionOverrideFromContext(ctx); ok {
+		return region
+	}
+
 	return c.region
 }
 
@@ -185,11 +228,21 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	return c.s3ExpressClient
 }
 
+// ResourceTimeouts returns the resource_timeouts provider configuration value.
+func (c *AWSClient) ResourceTimeouts(context.Context) *ResourceTimeouts {
+	return c.resourceTimeouts
+}
+
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
 func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
 }
 
+// SkipIAMRoleInlinePolicyRead returns the skip_iam_role_inline_policy_read provider configuration value.
+func (c *AWSClient) SkipIAMRoleInlinePolicyRead(context.Context) bool {
+	return c.skipIAMRoleInlinePolicyRead
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (c *AWSClient) SetHTTPClient(_ context.Context, httpClient *http.Client) {
@@ -316,9 +369,19 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	// A custom endpoint is specific to the provider's Region, so it is not used when the Region is overridden.
+	endpoint := c.endpoints[servicePackageName]
+	if region, ok := regionOverrideFromContext(ctx); ok && region != c.region {
+		v := awsConfig.Copy()
+		v.Region = region
+		awsConfig = &v
+		endpoint = ""
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
-		"endpoint":         c.endpoints[servicePackageName],
+		"aws_sdkv2_config": awsConfig,
+		"endpoint":         endpoint,
 		"partition":        c.Partition(ctx),
 	}
 	switch servicePackageName {
@@ -338,18 +401,22 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per Region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := servicePackageName
+	if region, ok := regionOverrideFromContext(ctx); ok && region != c.region {
+		key = servicePackageName + "/" + region
+	}
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -384,7 +451,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
@@ -7,7 +7,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var (
@@ -184,3 +186,146 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientServiceCache(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	calls := 0
+	newCache := func() any {
+		calls++
+		return &calls
+	}
+
+	client1, client2 := &AWSClient{}, &AWSClient{}
+
+	v1 := client1.ServiceCache(ctx, "test", newCache)
+	if got := client1.ServiceCache(ctx, "test", newCache); got != v1 {
+		t.Errorf("ServiceCache returned a different cache for the same client and key")
+	}
+	if got, want := calls, 1; got != want {
+		t.Errorf("same client: calls = %d, want %d", got, want)
+	}
+
+	client1.ServiceCache(ctx, "other", newCache)
+	client2.ServiceCache(ctx, "test", newCache)
+	if got, want := calls, 3; got != want {
+		t.Errorf("different key or client: calls = %d, want %d", got, want)
+	}
+}
+
+func TestAWSClientRegionOverride(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	client := &AWSClient{
+		awsConfig: &aws.Config{Region: endpoints.UsWest2RegionID},
+		endpoints: map[string]string{
+			names.Redshift: "https://redshift.example.com",
+		},
+		partition: standardPartition,
+		region:    endpoints.UsWest2RegionID,
+	}
+
+	testCases := map[string]struct {
+		ctx              context.Context
+		expected         string
+		expectedEndpoint string
+	}{
+		"no override": {
+			ctx:              ctx,
+			expected:         endpoints.UsWest2RegionID,
+			expectedEndpoint: "https://redshift.example.com",
+		},
+		"empty override": {
+			ctx:              NewRegionOverrideContext(ctx, ""),
+			expected:         endpoints.UsWest2RegionID,
+			expectedEndpoint: "https://redshift.example.com",
+		},
+		"same Region override": {
+			ctx:              NewRegionOverrideContext(ctx, endpoints.UsWest2RegionID),
+			expected:         endpoints.UsWest2RegionID,
+			expectedEndpoint: "https://redshift.example.com",
+		},
+		"override": {
+			ctx:      NewRegionOverrideContext(ctx, endpoints.EuWest1RegionID),
+			expected: endpoints.EuWest1RegionID,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := client.Region(testCase.ctx), testCase.expected; got != want {
+				t.Errorf("Region = %s, want %s", got, want)
+			}
+
+			config := client.apiClientConfig(testCase.ctx, names.Redshift)
+			if got, want := config["aws_sdkv2_config"].(*aws.Config).Region, testCase.expected; got != want {
+				t.Errorf("API client Region = %s, want %s", got, want)
+			}
+			if got, want := config["endpoint"].(string), testCase.expectedEndpoint; got != want {
+				t.Errorf("API client endpoint = %s, want %s", got, want)
+			}
+		})
+	}
+
+	if got, want := client.awsConfig.Region, endpoints.UsWest2RegionID; got != want {
+		t.Errorf("provider Region = %s, want %s", got, want)
+	}
+}
+
+func TestAWSClientValidateRegionOverride(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	testCases := map[string]struct {
+		skipRegionValidation bool
+		region               string
+		expectError          bool
+	}{
+		"provider Region": {
+			region: endpoints.UsWest2RegionID,
+		},
+		"same partition": {
+			region: endpoints.EuWest1RegionID,
+		},
+		"different partition": {
+			region:      endpoints.CnNorth1RegionID,
+			expectError: true,
+		},
+		"unknown Region": {
+			region:      "unknown-region-1",
+			expectError: true,
+		},
+		"unknown Region skip validation": {
+			skipRegionValidation: true,
+			region:               "unknown-region-1",
+		},
+		"different partition skip validation": {
+			skipRegionValidation: true,
+			region:               endpoints.CnNorth1RegionID,
+			expectError:          true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{
+				partition:            standardPartition,
+				region:               endpoints.UsWest2RegionID,
+				skipRegionValidation: testCase.skipRegionValidation,
+			}
+
+			err := client.ValidateRegionOverride(ctx, testCase.region)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateRegionOverride(%q) error = %v, want error: %t", testCase.region, err, want)
+			}
+		})
+	}
+}
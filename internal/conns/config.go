@@ -31,6 +31,7 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogPath                   string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -43,9 +44,11 @@ type Config struct {
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	MutatingOperationRetries       *MutatingOperationRetries
 	NoProxy                        string
 	Profile                        string
 	Region                         string
+	ResourceTimeouts               *ResourceTimeouts
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
@@ -53,6 +56,7 @@ type Config struct {
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
+	SkipIAMRoleInlinePolicyRead    bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
 	STSRegion                      string
@@ -156,6 +160,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	// Errors are recorded so that callers such as resource Delete handlers can inspect them after the call.
+	cfg.APIOptions = append(cfg.APIOptions, recordAPIErrors)
+
+	if c.MutatingOperationRetries != nil {
+		cfg.APIOptions = append(cfg.APIOptions, c.MutatingOperationRetries.APIOption)
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -211,9 +222,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.region = c.Region
+	client.resourceTimeouts = c.ResourceTimeouts
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
+	// Opened last so that the file isn't left open if configuration fails.
+	if c.AuditLogPath != "" {
+		auditLogger, err := NewAuditLogger(c.AuditLogPath)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening audit log (%s): %s", c.AuditLogPath, err)
+		}
+		cfg.APIOptions = append(cfg.APIOptions, auditLogger.APIOption)
+		client.auditLogger = auditLogger
+	}
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
@@ -221,6 +243,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.skipIAMRoleInlinePolicyRead = c.SkipIAMRoleInlinePolicyRead
+	client.skipRegionValidation = c.SkipRegionValidation
 	client.stsRegion = c.STSRegion
 
 	return client, diags
@@ -26,6 +26,15 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithNotFoundErrors is an interface that extends ServicePackage with a matcher for the API errors
+// that indicate a resource does not exist.
+// A Plugin Framework resource Read that fails with such an error removes the resource from state,
+// and a resource Delete that fails with such an error is treated as having succeeded.
+type ServicePackageWithNotFoundErrors interface {
+	ServicePackage
+	IsNotFoundError(context.Context, error) bool
+}
+
 type (
 	contextKeyType int
 )
@@ -40,6 +49,7 @@ type InContext struct {
 	isEphemeralResource bool   // Ephemeral resource?
 	resourceName        string // Friendly resource name, e.g. "Subnet"
 	servicePackageName  string // Canonical name defined as a constant in names package
+	typeName            string // Terraform type name, e.g. "aws_subnet"
 }
 
 // IsDataSource returns true if the resource is a data source.
@@ -62,30 +72,38 @@ func (c *InContext) ServicePackageName() string {
 	return c.servicePackageName
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+// TypeName returns the Terraform type name, e.g. "aws_subnet".
+func (c *InContext) TypeName() string {
+	return c.typeName
+}
+
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		isDataSource:       true,
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
+		typeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		isEphemeralResource: true,
 		resourceName:        resourceName,
 		servicePackageName:  servicePackageName,
+		typeName:            typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
+		typeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	mutatingOperationRetriesMiddlewareID = "TerraformProviderAWSMutatingOperationRetries"
+	retryMiddlewareID                    = "Retry"
+)
+
+// MutatingOperationRetries controls whether the AWS SDK retries failed calls to mutating AWS API operations,
+// from the disable_mutating_operation_retries and mutating_operation_retries provider configuration.
+// Calls to read-only operations are always retried.
+type MutatingOperationRetries struct {
+	disabled  bool
+	overrides map[string]bool
+}
+
+// NewMutatingOperationRetries parses the mutating operation retry provider configuration.
+// Each override key is a resource type name (e.g. "aws_instance") or a prefix ending in "*" (e.g. "aws_iam_*")
+// and each value specifies whether mutating calls made by matching resources are retried.
+func NewMutatingOperationRetries(disabled bool, overrides map[string]bool) (*MutatingOperationRetries, error) {
+	if !disabled && len(overrides) == 0 {
+		return nil, nil
+	}
+
+	for k := range overrides {
+		if k == "" || strings.Contains(strings.TrimSuffix(k, "*"), "*") {
+			return nil, fmt.Errorf("mutating_operation_retries key (%s): resource type must be a type name or a prefix ending in \"*\"", k)
+		}
+	}
+
+	return &MutatingOperationRetries{
+		disabled:  disabled,
+		overrides: overrides,
+	}, nil
+}
+
+// Enabled returns whether mutating calls made by the specified resource type are retried.
+// Exact type names take precedence over prefixes, and longer prefixes over shorter ones.
+func (r *MutatingOperationRetries) Enabled(typeName string) bool {
+	if r == nil {
+		return true
+	}
+
+	if v, ok := r.overrides[typeName]; ok {
+		return v
+	}
+
+	var match string
+	for k := range r.overrides {
+		if prefix, ok := strings.CutSuffix(k, "*"); ok && strings.HasPrefix(typeName, prefix) && len(k) > len(match) {
+			match = k
+		}
+	}
+
+	if match != "" {
+		return r.overrides[match]
+	}
+
+	return !r.disabled
+}
+
+// APIOption adds the mutating operation retry middleware to an AWS SDK for Go v2 middleware stack.
+// It is intended to be appended to aws.Config.APIOptions.
+func (r *MutatingOperationRetries) APIOption(stack *middleware.Stack) error {
+	// The middleware must run inside the retry loop so that it sees the result of each attempt.
+	if _, ok := stack.Finalize.Get(retryMiddlewareID); !ok {
+		return nil
+	}
+
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc(mutatingOperationRetriesMiddlewareID, r.handleFinalize), retryMiddlewareID, middleware.After)
+}
+
+func (r *MutatingOperationRetries) handleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	out, metadata, err := next.HandleFinalize(ctx, in)
+
+	if err == nil || !isMutatingOperation(awsmiddleware.GetOperationName(ctx)) {
+		return out, metadata, err
+	}
+
+	var typeName string
+	if v, ok := FromContext(ctx); ok {
+		typeName = v.TypeName()
+	}
+
+	if !r.Enabled(typeName) {
+		err = &nonRetryableError{err: err}
+	}
+
+	return out, metadata, err
+}
+
+// nonRetryableError marks an error as not retryable by the AWS SDK's standard retryer.
+type nonRetryableError struct {
+	err error
+}
+
+func (e *nonRetryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *nonRetryableError) Unwrap() error {
+	return e.err
+}
+
+func (e *nonRetryableError) RetryableError() bool {
+	return false
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestNewMutatingOperationRetries(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		disabled    bool
+		overrides   map[string]bool
+		expectNil   bool
+		expectError bool
+	}{
+		"not configured": {
+			expectNil: true,
+		},
+		"disabled": {
+			disabled: true,
+		},
+		"overrides only": {
+			overrides: map[string]bool{
+				"aws_iam_*": false,
+			},
+		},
+		"invalid wildcard": {
+			overrides: map[string]bool{
+				"aws_*_role": false,
+			},
+			expectError: true,
+		},
+		"empty resource type": {
+			overrides: map[string]bool{
+				"": false,
+			},
+			expectError: true,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := conns.NewMutatingOperationRetries(testCase.disabled, testCase.overrides)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expectError = %t", err, want)
+			}
+
+			if err == nil {
+				if got, want := r == nil, testCase.expectNil; got != want {
+					t.Errorf("nil = %t, want %t", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestMutatingOperationRetriesEnabled(t *testing.T) {
+	t.Parallel()
+
+	r, err := conns.NewMutatingOperationRetries(true, map[string]bool{
+		"aws_iam_*":         true,
+		"aws_iam_role*":     false,
+		"aws_iam_role_name": true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		r        *conns.MutatingOperationRetries
+		typeName string
+		expected bool
+	}{
+		"not configured": {
+			typeName: "aws_instance",
+			expected: true,
+		},
+		"provider default": {
+			r:        r,
+			typeName: "aws_instance",
+		},
+		"no resource": {
+			r: r,
+		},
+		"prefix": {
+			r:        r,
+			typeName: "aws_iam_user",
+			expected: true,
+		},
+		"longer prefix": {
+			r:        r,
+			typeName: "aws_iam_role_policy",
+		},
+		"exact": {
+			r:        r,
+			typeName: "aws_iam_role_name",
+			expected: true,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.r.Enabled(testCase.typeName), testCase.expected; got != want {
+				t.Errorf("Enabled(%q) = %t, want %t", testCase.typeName, got, want)
+			}
+		})
+	}
+}
+
+func TestMutatingOperationRetriesAPIOption(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		disabled         bool
+		overrides        map[string]bool
+		operation        string
+		customRetryables bool
+		expectedAttempts int
+	}{
+		"enabled": {
+			operation:        "CreateStack",
+			expectedAttempts: 3,
+		},
+		"disabled": {
+			disabled:         true,
+			operation:        "CreateStack",
+			expectedAttempts: 1,
+		},
+		"disabled custom retryables": {
+			disabled:         true,
+			operation:        "CreateStack",
+			customRetryables: true,
+			expectedAttempts: 1,
+		},
+		"disabled read-only operation": {
+			disabled:         true,
+			operation:        "DescribeStacks",
+			expectedAttempts: 3,
+		},
+		"disabled resource override": {
+			disabled: true,
+			overrides: map[string]bool{
+				"aws_opsworks_*": true,
+			},
+			operation:        "CreateStack",
+			expectedAttempts: 3,
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := conns.NewMutatingOperationRetries(testCase.disabled, testCase.overrides)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var retryer aws.RetryerV2 = retry.NewStandard(func(o *retry.StandardOptions) {
+				o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+					return 0, nil
+				})
+				o.MaxAttempts = 3
+			})
+			if testCase.customRetryables {
+				retryer = conns.AddIsErrorRetryables(retryer, retry.IsErrorRetryableFunc(func(error) aws.Ternary {
+					return aws.TrueTernary
+				}))
+			}
+
+			stack := middleware.NewStack(testCase.operation, smithyhttp.NewStackRequest)
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				OperationName: testCase.operation,
+				ServiceID:     "OpsWorks",
+			}, middleware.Before); err != nil {
+				t.Fatalf("adding service metadata middleware: %s", err)
+			}
+			if err := stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("Signing", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+				return next.HandleFinalize(ctx, in)
+			}), middleware.After); err != nil {
+				t.Fatalf("adding signing middleware: %s", err)
+			}
+			if err := retry.AddRetryMiddlewares(stack, retry.AddRetryMiddlewaresOptions{Retryer: retryer}); err != nil {
+				t.Fatalf("adding retry middleware: %s", err)
+			}
+			if err := r.APIOption(stack); err != nil {
+				t.Fatalf("adding mutating operation retries middleware: %s", err)
+			}
+
+			apiErr := &smithy.GenericAPIError{Code: "ThrottlingException", Message: "rate exceeded"}
+			var attempts int
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				attempts++
+				return nil, middleware.Metadata{}, apiErr
+			}), stack)
+
+			ctx := conns.NewResourceContext(context.Background(), "opsworks", "Stack", "aws_opsworks_stack")
+			_, _, err = handler.Handle(ctx, struct{}{})
+
+			if !errors.Is(err, apiErr) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := attempts, testCase.expectedAttempts; got != want {
+				t.Errorf("attempts = %d, want %d", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+type regionOverrideContextKeyType int
+
+var regionOverrideContextKey regionOverrideContextKeyType
+
+// NewRegionOverrideContext returns a Context in which AWS API clients, and Region-dependent values such as ARNs,
+// use the specified Region instead of the provider's configured Region.
+func NewRegionOverrideContext(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionOverrideContextKey, region)
+}
+
+// regionOverrideFromContext returns the Region that overrides the provider's configured Region in the Context, if any.
+func regionOverrideFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(regionOverrideContextKey).(string)
+	return v, ok && v != ""
+}
+
+// ValidateRegionOverride returns an error if the specified Region cannot override the provider's configured Region.
+// The Region must be in the provider's partition and, unless Region validation is skipped, must be a known Region.
+func (c *AWSClient) ValidateRegionOverride(_ context.Context, region string) error {
+	if region == c.region {
+		return nil
+	}
+
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok {
+		if c.skipRegionValidation {
+			return nil
+		}
+
+		return fmt.Errorf("invalid AWS Region: %s", region)
+	}
+
+	if got, want := partition.ID(), c.partition.ID(); got != want {
+		return fmt.Errorf("AWS Region %s is in partition %s, not the provider's partition %s", region, got, want)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	ResourceTimeoutOperationCreate = "create"
+	ResourceTimeoutOperationRead   = "read"
+	ResourceTimeoutOperationUpdate = "update"
+	ResourceTimeoutOperationDelete = "delete"
+)
+
+// ResourceTimeouts holds provider-level default operation timeouts, from the resource_timeouts provider configuration.
+// Each key is a resource type name (e.g. "aws_redshift_cluster") or a prefix ending in "*" (e.g. "aws_redshift_*"),
+// optionally followed by "." and an operation name. Keys without an operation apply to all operations.
+type ResourceTimeouts struct {
+	entries []resourceTimeoutsEntry
+}
+
+type resourceTimeoutsEntry struct {
+	pattern   string
+	operation string // Empty for all operations.
+	timeout   time.Duration
+}
+
+// NewResourceTimeouts parses the resource_timeouts provider configuration.
+func NewResourceTimeouts(m map[string]string) (*ResourceTimeouts, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+
+	rt := &ResourceTimeouts{}
+
+	for k, v := range m {
+		pattern, operation, _ := strings.Cut(k, ".")
+
+		if pattern == "" || strings.Contains(strings.TrimSuffix(pattern, "*"), "*") {
+			return nil, fmt.Errorf("resource_timeouts key (%s): resource type must be a type name or a prefix ending in \"*\"", k)
+		}
+
+		if operation != "" && !slices.Contains([]string{ResourceTimeoutOperationCreate, ResourceTimeoutOperationRead, ResourceTimeoutOperationUpdate, ResourceTimeoutOperationDelete}, operation) {
+			return nil, fmt.Errorf("resource_timeouts key (%s): operation must be one of create, read, update or delete", k)
+		}
+
+		timeout, err := time.ParseDuration(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("resource_timeouts (%s): %w", k, err)
+		}
+
+		if timeout <= 0 {
+			return nil, fmt.Errorf("resource_timeouts (%s): timeout must be positive", k)
+		}
+
+		rt.entries = append(rt.entries, resourceTimeoutsEntry{
+			pattern:   pattern,
+			operation: operation,
+			timeout:   timeout,
+		})
+	}
+
+	return rt, nil
+}
+
+// Timeout returns the default timeout for the specified resource type and operation, if one is configured.
+// Exact type names take precedence over prefixes, and longer prefixes over shorter ones.
+// For the same resource type pattern, an operation-specific entry takes precedence.
+func (rt *ResourceTimeouts) Timeout(typeName, operation string) (time.Duration, bool) {
+	if rt == nil {
+		return 0, false
+	}
+
+	var match *resourceTimeoutsEntry
+
+	for i, v := range rt.entries {
+		if v.operation != "" && v.operation != operation {
+			continue
+		}
+
+		if prefix, ok := strings.CutSuffix(v.pattern, "*"); ok {
+			if !strings.HasPrefix(typeName, prefix) {
+				continue
+			}
+		} else if v.pattern != typeName {
+			continue
+		}
+
+		if match == nil || v.moreSpecificThan(match) {
+			match = &rt.entries[i]
+		}
+	}
+
+	if match == nil {
+		return 0, false
+	}
+
+	return match.timeout, true
+}
+
+func (e *resourceTimeoutsEntry) moreSpecificThan(other *resourceTimeoutsEntry) bool {
+	isPrefix, otherIsPrefix := strings.HasSuffix(e.pattern, "*"), strings.HasSuffix(other.pattern, "*")
+
+	if isPrefix != otherIsPrefix {
+		return !isPrefix
+	}
+
+	if len(e.pattern) != len(other.pattern) {
+		return len(e.pattern) > len(other.pattern)
+	}
+
+	return e.operation != "" && other.operation == ""
+}
+
+type resourceTimeoutsContextKeyType int
+
+var resourceTimeoutsContextKey resourceTimeoutsContextKeyType
+
+// NewResourceTimeoutsContext returns a Context enhanced with the provider's default resource timeouts.
+func NewResourceTimeoutsContext(ctx context.Context, rt *ResourceTimeouts) context.Context {
+	return context.WithValue(ctx, resourceTimeoutsContextKey, rt)
+}
+
+// ResourceTimeoutFromContext returns the provider's default timeout for the current resource and the specified operation, if one is configured.
+func ResourceTimeoutFromContext(ctx context.Context, operation string) (time.Duration, bool) {
+	inContext, ok := FromContext(ctx)
+	if !ok {
+		return 0, false
+	}
+
+	rt, ok := ctx.Value(resourceTimeoutsContextKey).(*ResourceTimeouts)
+	if !ok {
+		return 0, false
+	}
+
+	return rt.Timeout(inContext.TypeName(), operation)
+}
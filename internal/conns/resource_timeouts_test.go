@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestNewResourceTimeouts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config      map[string]string
+		expectError bool
+	}{
+		"empty": {
+			config: map[string]string{},
+		},
+		"valid": {
+			config: map[string]string{
+				"aws_redshift_cluster":            "90m",
+				"aws_redshift_*.delete":           "2h",
+				"aws_rds_cluster_instance.create": "45m",
+			},
+		},
+		"invalid duration": {
+			config: map[string]string{
+				"aws_redshift_cluster": "ninety",
+			},
+			expectError: true,
+		},
+		"non-positive duration": {
+			config: map[string]string{
+				"aws_redshift_cluster": "0s",
+			},
+			expectError: true,
+		},
+		"invalid operation": {
+			config: map[string]string{
+				"aws_redshift_cluster.refresh": "10m",
+			},
+			expectError: true,
+		},
+		"invalid wildcard": {
+			config: map[string]string{
+				"aws_*_cluster": "10m",
+			},
+			expectError: true,
+		},
+		"empty resource type": {
+			config: map[string]string{
+				".create": "10m",
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := conns.NewResourceTimeouts(testCase.config)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
+
+func TestResourceTimeoutsTimeout(t *testing.T) {
+	t.Parallel()
+
+	rt, err := conns.NewResourceTimeouts(map[string]string{
+		"aws_redshift_*":                  "30m",
+		"aws_redshift_*.delete":           "40m",
+		"aws_redshift_snapshot_*":         "50m",
+		"aws_redshift_cluster":            "90m",
+		"aws_redshift_cluster.update":     "2h",
+		"aws_rds_cluster_instance.create": "45m",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		typeName      string
+		operation     string
+		expectedOK    bool
+		expectedValue time.Duration
+	}{
+		"exact name": {
+			typeName:      "aws_redshift_cluster",
+			operation:     conns.ResourceTimeoutOperationCreate,
+			expectedOK:    true,
+			expectedValue: 90 * time.Minute,
+		},
+		"exact name and operation": {
+			typeName:      "aws_redshift_cluster",
+			operation:     conns.ResourceTimeoutOperationUpdate,
+			expectedOK:    true,
+			expectedValue: 2 * time.Hour,
+		},
+		"exact name beats prefix and operation": {
+			typeName:      "aws_redshift_cluster",
+			operation:     conns.ResourceTimeoutOperationDelete,
+			expectedOK:    true,
+			expectedValue: 90 * time.Minute,
+		},
+		"prefix": {
+			typeName:      "aws_redshift_parameter_group",
+			operation:     conns.ResourceTimeoutOperationCreate,
+			expectedOK:    true,
+			expectedValue: 30 * time.Minute,
+		},
+		"prefix and operation": {
+			typeName:      "aws_redshift_parameter_group",
+			operation:     conns.ResourceTimeoutOperationDelete,
+			expectedOK:    true,
+			expectedValue: 40 * time.Minute,
+		},
+		"longer prefix": {
+			typeName:      "aws_redshift_snapshot_copy_grant",
+			operation:     conns.ResourceTimeoutOperationDelete,
+			expectedOK:    true,
+			expectedValue: 50 * time.Minute,
+		},
+		"operation not configured": {
+			typeName:  "aws_rds_cluster_instance",
+			operation: conns.ResourceTimeoutOperationDelete,
+		},
+		"no match": {
+			typeName:  "aws_s3_bucket",
+			operation: conns.ResourceTimeoutOperationCreate,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := rt.Timeout(testCase.typeName, testCase.operation)
+
+			if ok != testCase.expectedOK {
+				t.Fatalf("ok = %t, expected %t", ok, testCase.expectedOK)
+			}
+
+			if got != testCase.expectedValue {
+				t.Errorf("timeout = %s, expected %s", got, testCase.expectedValue)
+			}
+		})
+	}
+}
+
+func TestResourceTimeoutsTimeout_nil(t *testing.T) {
+	t.Parallel()
+
+	var rt *conns.ResourceTimeouts
+
+	if _, ok := rt.Timeout("aws_redshift_cluster", conns.ResourceTimeoutOperationCreate); ok {
+		t.Error("expected no timeout")
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -61,9 +62,14 @@ func ProblemStandardMessage(service, action, resource, id string, gotError error
 }
 
 func AddError(d *fwdiag.Diagnostics, service, action, resource, id string, gotError error) {
+	detail := gotError.Error()
+	if remediation := errs.FaultRemediation(gotError); remediation != "" {
+		detail += "\n\n" + remediation
+	}
+
 	d.AddError(
 		ProblemStandardMessage(service, action, resource, id, nil),
-		gotError.Error(),
+		detail,
 	)
 }
 
@@ -84,6 +90,7 @@ func diagError(service, action, resource, id string, gotError error) diag.Diagno
 	return diag.Diagnostic{
 		Severity: diag.Error,
 		Summary:  ProblemStandardMessage(service, action, resource, id, gotError),
+		Detail:   errs.FaultRemediation(gotError),
 	}
 }
 
@@ -84,6 +84,15 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for restricting resource sweepers
+const (
+	// If set, sweepers that support tag filtering only delete resources tagged with this key
+	SweepTagKey = "TF_AWS_SWEEP_TAG_KEY"
+
+	// If set together with TF_AWS_SWEEP_TAG_KEY, the tag value that resources must also have
+	SweepTagValue = "TF_AWS_SWEEP_TAG_VALUE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"strings"
+
+	smithy "github.com/aws/smithy-go"
+)
+
+// FaultClass is a broad category of AWS API fault.
+type FaultClass int
+
+const (
+	FaultClassUnknown FaultClass = iota
+	FaultClassThrottling
+	FaultClassAccessDenied
+	FaultClassLimitExceeded
+	FaultClassDependencyViolation
+)
+
+var (
+	// Error codes returned when a request is rejected by API rate limiting.
+	throttlingErrorCodes = map[string]struct{}{
+		"BandwidthLimitExceeded":                 {},
+		"EC2ThrottledException":                  {},
+		"PriorRequestNotComplete":                {},
+		"ProvisionedThroughputExceededException": {},
+		"RequestLimitExceeded":                   {},
+		"RequestThrottled":                       {},
+		"RequestThrottledException":              {},
+		"SlowDown":                               {},
+		"ThrottledException":                     {},
+		"Throttling":                             {},
+		"ThrottlingException":                    {},
+		"TooManyRequestsException":               {},
+	}
+
+	// Error codes returned when the caller is not authorized to perform an operation.
+	accessDeniedErrorCodes = map[string]struct{}{
+		"AccessDenied":                {},
+		"AccessDeniedException":       {},
+		"AuthorizationError":          {},
+		"AuthorizationErrorException": {},
+		"Forbidden":                   {},
+		"ForbiddenException":          {},
+		"NotAuthorized":               {},
+		"NotAuthorizedException":      {},
+		"UnauthorizedAccess":          {},
+		"UnauthorizedException":       {},
+		"UnauthorizedOperation":       {},
+	}
+
+	// Error codes returned when a resource cannot be modified or deleted because other resources depend on it.
+	dependencyViolationErrorCodes = map[string]struct{}{
+		"DeleteConflict":         {},
+		"DependencyViolation":    {},
+		"ResourceInUse":          {},
+		"ResourceInUseException": {},
+		"ResourceInUseFault":     {},
+	}
+)
+
+// ClassifyFault returns the broad category of the AWS API fault wrapped by err.
+// FaultClassUnknown is returned if err is not an AWS API error or its code is not recognized.
+func ClassifyFault(err error) FaultClass {
+	apiErr, ok := As[smithy.APIError](err)
+	if !ok {
+		return FaultClassUnknown
+	}
+
+	code := apiErr.ErrorCode()
+
+	// Check throttling first as some throttling codes (e.g. "RequestLimitExceeded") look like quota errors.
+	if _, ok := throttlingErrorCodes[code]; ok {
+		return FaultClassThrottling
+	}
+
+	if _, ok := accessDeniedErrorCodes[code]; ok {
+		return FaultClassAccessDenied
+	}
+
+	if strings.Contains(code, "LimitExceeded") || strings.Contains(code, "QuotaExceeded") {
+		return FaultClassLimitExceeded
+	}
+
+	if _, ok := dependencyViolationErrorCodes[code]; ok || strings.HasSuffix(code, ".InUse") {
+		return FaultClassDependencyViolation
+	}
+
+	return FaultClassUnknown
+}
+
+// FaultRemediation returns a suggested remediation for the AWS API fault wrapped by err,
+// suitable for use as a diagnostic's detail.
+// An empty string is returned if the fault is not classified.
+func FaultRemediation(err error) string {
+	switch ClassifyFault(err) {
+	case FaultClassThrottling:
+		return "The request was throttled by AWS. Retry later, reduce concurrency (for example with `terraform apply -parallelism=n`), " +
+			"or increase the provider `max_retries` argument."
+	case FaultClassAccessDenied:
+		return "The credentials used by the provider are not authorized to perform this operation. " +
+			"Check the IAM policies attached to the caller, and any permissions boundaries, Service Control Policies, " +
+			"or resource-based policies that may deny the action."
+	case FaultClassLimitExceeded:
+		return "An AWS service quota or limit was exceeded. Remove unused resources or request a quota increase " +
+			"using Service Quotas (the `aws_servicequotas_service_quota` resource can manage this)."
+	case FaultClassDependencyViolation:
+		return "The resource is in use by, or has dependencies on, other resources. Remove or detach the dependent resources, " +
+			"or add a `depends_on` reference so that Terraform orders the operations correctly, and then retry."
+	default:
+		return ""
+	}
+}
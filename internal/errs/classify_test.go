@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestClassifyFault(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected errs.FaultClass
+	}{
+		"nil": {
+			expected: errs.FaultClassUnknown,
+		},
+		"not an API error": {
+			err:      errors.New("ThrottlingException"),
+			expected: errs.FaultClassUnknown,
+		},
+		"unrecognized code": {
+			err:      errs.APIError("ValidationException", "invalid"),
+			expected: errs.FaultClassUnknown,
+		},
+		"throttling": {
+			err:      errs.APIError("ThrottlingException", "Rate exceeded"),
+			expected: errs.FaultClassThrottling,
+		},
+		"EC2 request limit": {
+			err:      errs.APIError("RequestLimitExceeded", "Request limit exceeded."),
+			expected: errs.FaultClassThrottling,
+		},
+		"wrapped throttling": {
+			err:      fmt.Errorf("operation error: %w", errs.APIError("TooManyRequestsException", "")),
+			expected: errs.FaultClassThrottling,
+		},
+		"access denied": {
+			err:      errs.APIError("AccessDeniedException", "not authorized"),
+			expected: errs.FaultClassAccessDenied,
+		},
+		"EC2 unauthorized": {
+			err:      errs.APIError("UnauthorizedOperation", "You are not authorized to perform this operation."),
+			expected: errs.FaultClassAccessDenied,
+		},
+		"limit exceeded": {
+			err:      errs.APIError("LimitExceededException", "limit"),
+			expected: errs.FaultClassLimitExceeded,
+		},
+		"service quota exceeded": {
+			err:      errs.APIError("ServiceQuotaExceededException", "quota"),
+			expected: errs.FaultClassLimitExceeded,
+		},
+		"EC2 VPC limit": {
+			err:      errs.APIError("VpcLimitExceeded", "The maximum number of VPCs has been reached."),
+			expected: errs.FaultClassLimitExceeded,
+		},
+		"RDS quota": {
+			err:      errs.APIError("DBInstanceQuotaExceeded", "quota"),
+			expected: errs.FaultClassLimitExceeded,
+		},
+		"dependency violation": {
+			err:      errs.APIError("DependencyViolation", "resource has a dependent object"),
+			expected: errs.FaultClassDependencyViolation,
+		},
+		"resource in use": {
+			err:      errs.APIError("ResourceInUseException", "in use"),
+			expected: errs.FaultClassDependencyViolation,
+		},
+		"EC2 in use": {
+			err:      errs.APIError("InvalidGroup.InUse", "Group is used by another group"),
+			expected: errs.FaultClassDependencyViolation,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := errs.ClassifyFault(testCase.err), testCase.expected; got != want {
+				t.Errorf("ClassifyFault = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestFaultRemediation(t *testing.T) {
+	t.Parallel()
+
+	if got := errs.FaultRemediation(errs.APIError("ValidationException", "invalid")); got != "" {
+		t.Errorf("FaultRemediation(unclassified) = %q, want empty", got)
+	}
+
+	if got := errs.FaultRemediation(errs.APIError("ThrottlingException", "Rate exceeded")); got == "" {
+		t.Error("FaultRemediation(throttling) is empty")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
@@ -17,15 +18,27 @@ func AppendWarningf(diags diag.Diagnostics, format string, a ...any) diag.Diagno
 	})
 }
 
+// AppendErrorf appends an error diagnostic with a formatted summary.
+// If any of the arguments is a recognized AWS API fault, a suggested remediation is added as the detail.
 func AppendErrorf(diags diag.Diagnostics, format string, a ...any) diag.Diagnostics {
-	return append(diags, diag.Errorf(format, a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf(format, a...),
+		Detail:   faultRemediation(a...),
+	})
 }
 
+// AppendFromErr appends an error diagnostic for err, if not nil.
+// If err is a recognized AWS API fault, a suggested remediation is added as the detail.
 func AppendFromErr(diags diag.Diagnostics, err error) diag.Diagnostics {
 	if err == nil {
 		return diags
 	}
-	return append(diags, diag.FromErr(err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+		Detail:   errs.FaultRemediation(err),
+	})
 }
 
 func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics {
@@ -43,3 +56,16 @@ func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics
 		}
 	})
 }
+
+// faultRemediation returns the remediation for the first error argument that is a recognized AWS API fault.
+func faultRemediation(a ...any) string {
+	for _, v := range a {
+		if err, ok := v.(error); ok {
+			if remediation := errs.FaultRemediation(err); remediation != "" {
+				return remediation
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkdiag_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func TestAppendErrorf(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName        string
+		err             error
+		expectedSummary string
+		expectDetail    bool
+	}{
+		{
+			testName:        "plain error",
+			err:             errors.New("boom"),
+			expectedSummary: "creating Example (123): boom",
+		},
+		{
+			testName:        "unclassified API error",
+			err:             errs.APIError("ValidationException", "invalid"),
+			expectedSummary: "creating Example (123): api error ValidationException: invalid",
+		},
+		{
+			testName:        "classified API error",
+			err:             errs.APIError("AccessDeniedException", "not authorized"),
+			expectedSummary: "creating Example (123): api error AccessDeniedException: not authorized",
+			expectDetail:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := sdkdiag.AppendErrorf(nil, "creating Example (%s): %s", "123", testCase.err)
+
+			if got, want := len(diags), 1; got != want {
+				t.Fatalf("length of Diagnostics = %d, want %d", got, want)
+			}
+
+			d := diags[0]
+
+			if got, want := d.Severity, diag.Error; got != want {
+				t.Errorf("Severity = %v, want %v", got, want)
+			}
+
+			if got, want := d.Summary, testCase.expectedSummary; got != want {
+				t.Errorf("Summary = %q, want %q", got, want)
+			}
+
+			if got, want := d.Detail != "", testCase.expectDetail; got != want {
+				t.Errorf("Detail = %q, want detail: %t", d.Detail, want)
+			}
+		})
+	}
+}
@@ -101,6 +101,15 @@ func autoExpandConvert(ctx context.Context, from, to any, flexer autoFlexer) dia
 			diags.Append(expandStruct(ctx, sourcePath, from, targetPath, to, flexer)...)
 			return diags
 		}
+
+		// Top-level struct to union conversion.
+		if typFrom, typTo := valFrom.Type(), valTo.Type(); typFrom.Kind() == reflect.Struct && typTo.Kind() == reflect.Interface {
+			if _, ok := registeredUnion(typTo); ok {
+				tflog.SubsystemInfo(ctx, subsystemName, "Converting")
+				diags.Append(expandStruct(ctx, sourcePath, from, targetPath, to, flexer)...)
+				return diags
+			}
+		}
 	}
 
 	// Anything else.
@@ -1152,6 +1161,12 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		if members, ok := registeredUnion(valTo.Type()); ok && valFrom.Kind() == reflect.Struct {
+			tflog.SubsystemInfo(ctx, subsystemName, "Target is a union")
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, members, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
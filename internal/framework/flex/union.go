@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+// AWS SDK for Go v2 union types are modelled as an interface (e.g. `AnalyticsFilter`)
+// implemented by one pointer-to-struct type per member (e.g. `*AnalyticsFilterMemberPrefix`).
+// Each member struct has a single exported `Value` field holding the member's data.
+//
+// Go's reflection cannot enumerate the types implementing an interface, so union member types
+// must be registered with AutoFlex before use.
+// The corresponding Terraform data structure has one field per union member, at most one of
+// which may be set. Source and target fields are matched (case insensitively) on the member
+// name, i.e. the member type's name with the interface name and "Member" removed.
+
+const (
+	unionMemberValueFieldName = "Value"
+)
+
+// unionMember describes a single member of an AWS API union type.
+type unionMember struct {
+	name string       // e.g. "Prefix".
+	typ  reflect.Type // Struct type, e.g. AnalyticsFilterMemberPrefix.
+}
+
+var (
+	unionsMu sync.RWMutex
+	// unions maps union interface types to their members.
+	unions = make(map[reflect.Type][]unionMember)
+	// unionMembers maps union member struct types to their member names.
+	unionMembers = make(map[reflect.Type]string)
+)
+
+// RegisterUnion registers the member types of the AWS SDK for Go v2 union interface T.
+// Members are passed as (typically zero) values of their pointer types, e.g.
+//
+//	RegisterUnion[awstypes.AnalyticsFilter](
+//		&awstypes.AnalyticsFilterMemberAnd{},
+//		&awstypes.AnalyticsFilterMemberPrefix{},
+//		&awstypes.AnalyticsFilterMemberTag{},
+//	)
+//
+// Registering the same union more than once is harmless.
+func RegisterUnion[T any](members ...T) {
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("AutoFlex union %s is not an interface", fullTypeName(typ)))
+	}
+
+	prefix := typ.Name() + "Member"
+	var ms []unionMember
+	for _, member := range members {
+		memberType := reflect.TypeOf(member)
+		if memberType.Kind() != reflect.Pointer || memberType.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("AutoFlex union %s member %s is not a pointer to struct", fullTypeName(typ), fullTypeName(memberType)))
+		}
+		memberType = memberType.Elem()
+		if _, ok := memberType.FieldByName(unionMemberValueFieldName); !ok {
+			panic(fmt.Sprintf("AutoFlex union %s member %s has no %s field", fullTypeName(typ), fullTypeName(memberType), unionMemberValueFieldName))
+		}
+
+		ms = append(ms, unionMember{
+			name: strings.TrimPrefix(memberType.Name(), prefix),
+			typ:  memberType,
+		})
+	}
+
+	unionsMu.Lock()
+	defer unionsMu.Unlock()
+
+	unions[typ] = ms
+	for _, m := range ms {
+		unionMembers[m.typ] = m.name
+	}
+}
+
+// registeredUnion returns the registered members of the union interface type `typ`.
+func registeredUnion(typ reflect.Type) ([]unionMember, bool) {
+	unionsMu.RLock()
+	defer unionsMu.RUnlock()
+
+	members, ok := unions[typ]
+	return members, ok
+}
+
+// registeredUnionMember returns the member name of the union member struct type `typ`.
+func registeredUnionMember(typ reflect.Type) (string, bool) {
+	unionsMu.RLock()
+	defer unionsMu.RUnlock()
+
+	name, ok := unionMembers[typ]
+	return name, ok
+}
+
+// expandUnion copies the single set field of struct `valFrom` to a new member of union interface `valTo`.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, members []unionMember, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var setFieldName string
+	for fromField := range expandSourceFields(ctx, valFrom.Type(), flexer.getOptions()) {
+		fromFieldName := fromField.Name
+		fromFieldVal := valFrom.FieldByIndex(fromField.Index)
+
+		if v, ok := fromFieldVal.Interface().(attr.Value); !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		idx := -1
+		for i, m := range members {
+			if strings.EqualFold(fromFieldName, m.name) {
+				idx = i
+				break
+			}
+		}
+		if idx == -1 {
+			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding union member", map[string]any{
+				logAttrKeySourceFieldname: fromFieldName,
+			})
+			continue
+		}
+
+		if setFieldName != "" {
+			tflog.SubsystemError(ctx, subsystemName, "Multiple union members set")
+			diags.Append(diagExpandingMultipleUnionMembers(valFrom.Type(), valTo.Type(), setFieldName, fromFieldName))
+			return diags
+		}
+		setFieldName = fromFieldName
+
+		member := members[idx]
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: fromFieldName,
+			logAttrKeyTargetFieldname: member.name,
+		})
+
+		to := reflect.New(member.typ)
+		toFieldVal := to.Elem().FieldByName(unionMemberValueFieldName)
+
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromFieldName), fromFieldVal, targetPath.AtName(member.name), toFieldVal, fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+
+		valTo.Set(to)
+	}
+
+	return diags
+}
+
+// flattenUnionMember copies the value of union member struct `valFrom` to the matching field of struct `valTo`.
+func flattenUnionMember(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, memberName string, targetPath path.Path, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Any unset members are Null.
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var toField reflect.StructField
+	var found bool
+	for field := range tfreflect.ExportedStructFields(valTo.Type()) {
+		if strings.EqualFold(field.Name, memberName) {
+			toField, found = field, true
+			break
+		}
+	}
+	if !found {
+		tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+			logAttrKeySourceFieldname: memberName,
+		})
+		return diags
+	}
+
+	toFieldName := toField.Name
+	toFieldVal := valTo.FieldByIndex(toField.Index)
+	if !toFieldVal.CanSet() {
+		tflog.SubsystemDebug(ctx, subsystemName, "Field cannot be set", map[string]any{
+			logAttrKeySourceFieldname: memberName,
+			logAttrKeyTargetFieldname: toFieldName,
+		})
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeySourceFieldname: memberName,
+		logAttrKeyTargetFieldname: toFieldName,
+	})
+
+	_, toOpts := autoflexTags(toField)
+	opts := fieldOpts{
+		legacy:    toOpts.Legacy(),
+		omitempty: toOpts.OmitEmpty(),
+	}
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(unionMemberValueFieldName), valFrom.FieldByName(unionMemberValueFieldName), targetPath.AtName(toFieldName), toFieldVal, opts)...)
+
+	return diags
+}
+
+func diagExpandingMultipleUnionMembers(sourceType, targetType reflect.Type, fieldName1, fieldName2 string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source type %q sets multiple members (%q, %q) of union %q.", fullTypeName(sourceType), fieldName1, fieldName2, fullTypeName(targetType)),
+	)
+}
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// AWS SDK for Go v2 style union.
+type awsUnionFilter interface {
+	isAWSUnionFilter()
+}
+
+type awsUnionFilterMemberPrefix struct {
+	Value string
+}
+
+func (*awsUnionFilterMemberPrefix) isAWSUnionFilter() {}
+
+type awsUnionFilterMemberTag struct {
+	Value awsUnionTag
+}
+
+func (*awsUnionFilterMemberTag) isAWSUnionFilter() {}
+
+type awsUnionUnknownMember struct {
+	Tag   string
+	Value []byte
+}
+
+func (*awsUnionUnknownMember) isAWSUnionFilter() {}
+
+type awsUnionTag struct {
+	Key   *string
+	Value *string
+}
+
+type awsUnionSingle struct {
+	Filter awsUnionFilter
+}
+
+type awsUnionSlice struct {
+	Filters []awsUnionFilter
+}
+
+type tfUnionFilter struct {
+	Prefix types.String                                     `tfsdk:"prefix"`
+	Tag    fwtypes.ListNestedObjectValueOf[tfUnionTagModel] `tfsdk:"tag"`
+}
+
+type tfUnionTagModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type tfUnionSingle struct {
+	Filter fwtypes.ListNestedObjectValueOf[tfUnionFilter] `tfsdk:"filter"`
+}
+
+type tfUnionSlice struct {
+	Filters fwtypes.ListNestedObjectValueOf[tfUnionFilter] `tfsdk:"filters"`
+}
+
+func init() {
+	RegisterUnion[awsUnionFilter](
+		&awsUnionFilterMemberPrefix{},
+		&awsUnionFilterMemberTag{},
+	)
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		source        any
+		target        any
+		wantTarget    any
+		expectedDiags diag.Diagnostics
+	}{
+		"top level primitive member": {
+			source: &tfUnionFilter{
+				Prefix: types.StringValue("logs/"),
+				Tag:    fwtypes.NewListNestedObjectValueOfNull[tfUnionTagModel](ctx),
+			},
+			target:     new(awsUnionFilter),
+			wantTarget: unionFilterPtr(&awsUnionFilterMemberPrefix{Value: "logs/"}),
+		},
+		"nested struct member": {
+			source: &tfUnionSingle{
+				Filter: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionFilter{
+					Prefix: types.StringNull(),
+					Tag: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionTagModel{
+						Key:   types.StringValue("k"),
+						Value: types.StringValue("v"),
+					}),
+				}),
+			},
+			target: &awsUnionSingle{},
+			wantTarget: &awsUnionSingle{
+				Filter: &awsUnionFilterMemberTag{Value: awsUnionTag{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+		},
+		"slice of unions": {
+			source: &tfUnionSlice{
+				Filters: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnionFilter{
+					{
+						Prefix: types.StringValue("a/"),
+						Tag:    fwtypes.NewListNestedObjectValueOfNull[tfUnionTagModel](ctx),
+					},
+					{
+						Prefix: types.StringValue("b/"),
+						Tag:    fwtypes.NewListNestedObjectValueOfNull[tfUnionTagModel](ctx),
+					},
+				}),
+			},
+			target: &awsUnionSlice{},
+			wantTarget: &awsUnionSlice{
+				Filters: []awsUnionFilter{
+					&awsUnionFilterMemberPrefix{Value: "a/"},
+					&awsUnionFilterMemberPrefix{Value: "b/"},
+				},
+			},
+		},
+		"no member set": {
+			source: &tfUnionSingle{
+				Filter: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionFilter{
+					Prefix: types.StringNull(),
+					Tag:    fwtypes.NewListNestedObjectValueOfNull[tfUnionTagModel](ctx),
+				}),
+			},
+			target:     &awsUnionSingle{},
+			wantTarget: &awsUnionSingle{},
+		},
+		"multiple members set": {
+			source: &tfUnionFilter{
+				Prefix: types.StringValue("logs/"),
+				Tag: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionTagModel{
+					Key:   types.StringValue("k"),
+					Value: types.StringValue("v"),
+				}),
+			},
+			target: new(awsUnionFilter),
+			expectedDiags: diag.Diagnostics{
+				diagExpandingMultipleUnionMembers(reflect.TypeFor[tfUnionFilter](), reflect.TypeFor[awsUnionFilter](), "Prefix", "Tag"),
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Expand(ctx, testCase.source, testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !diags.HasError() {
+				if diff := cmp.Diff(testCase.target, testCase.wantTarget); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		source     any
+		target     any
+		wantTarget any
+	}{
+		"top level primitive member": {
+			source: &awsUnionFilterMemberPrefix{Value: "logs/"},
+			target: &tfUnionFilter{},
+			wantTarget: &tfUnionFilter{
+				Prefix: types.StringValue("logs/"),
+				Tag:    fwtypes.NewListNestedObjectValueOfNull[tfUnionTagModel](ctx),
+			},
+		},
+		"nested struct member": {
+			source: &awsUnionSingle{
+				Filter: &awsUnionFilterMemberTag{Value: awsUnionTag{Key: aws.String("k"), Value: aws.String("v")}},
+			},
+			target: &tfUnionSingle{},
+			wantTarget: &tfUnionSingle{
+				Filter: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionFilter{
+					Prefix: types.StringNull(),
+					Tag: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionTagModel{
+						Key:   types.StringValue("k"),
+						Value: types.StringValue("v"),
+					}),
+				}),
+			},
+		},
+		"nil union": {
+			source: &awsUnionSingle{},
+			target: &tfUnionSingle{},
+			wantTarget: &tfUnionSingle{
+				Filter: fwtypes.NewListNestedObjectValueOfNull[tfUnionFilter](ctx),
+			},
+		},
+		"unknown member": {
+			source: &awsUnionSingle{
+				Filter: &awsUnionUnknownMember{Tag: "new", Value: []byte("{}")},
+			},
+			target: &tfUnionSingle{},
+			wantTarget: &tfUnionSingle{
+				Filter: fwtypes.NewListNestedObjectValueOfNull[tfUnionFilter](ctx),
+			},
+		},
+		"slice of unions": {
+			source: &awsUnionSlice{
+				Filters: []awsUnionFilter{
+					&awsUnionFilterMemberPrefix{Value: "a/"},
+					&awsUnionFilterMemberPrefix{Value: "b/"},
+				},
+			},
+			target: &tfUnionSlice{},
+			wantTarget: &tfUnionSlice{
+				Filters: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnionFilter{
+					{
+						Prefix: types.StringValue("a/"),
+						Tag:    fwtypes.NewListNestedObjectValueOfNull[tfUnionTagModel](ctx),
+					},
+					{
+						Prefix: types.StringValue("b/"),
+						Tag:    fwtypes.NewListNestedObjectValueOfNull[tfUnionTagModel](ctx),
+					},
+				}),
+			},
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Flatten(ctx, testCase.source, testCase.target)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.wantTarget); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func unionFilterPtr(v awsUnionFilter) *awsUnionFilter {
+	return &v
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.MapTypable  = (*emptyAsNullMapTypeOf[basetypes.StringValue])(nil)
+	_ basetypes.MapValuable = (*EmptyAsNullMapValueOf[basetypes.StringValue])(nil)
+)
+
+var (
+	// EmptyAsNullMapOfStringType is a custom type used for defining a Map of strings
+	// for which an empty map read from AWS is stored as null.
+	EmptyAsNullMapOfStringType = emptyAsNullMapTypeOf[basetypes.StringValue]{mapTypeOf[basetypes.StringValue]{basetypes.MapType{ElemType: basetypes.StringType{}}}}
+)
+
+// emptyAsNullMapTypeOf is a mapTypeOf for optional attributes that AWS returns as an
+// empty map when nothing was configured.
+// Values constructed via ValueFromMap (e.g. by AutoFlex when flattening an API response)
+// collapse an empty map to null, avoiding a spurious diff against an unconfigured attribute.
+// Values read from Terraform configuration, plan or state are preserved as-is.
+type emptyAsNullMapTypeOf[T attr.Value] struct {
+	mapTypeOf[T]
+}
+
+func NewEmptyAsNullMapTypeOf[T attr.Value](ctx context.Context) emptyAsNullMapTypeOf[T] {
+	return emptyAsNullMapTypeOf[T]{NewMapTypeOf[T](ctx)}
+}
+
+func (t emptyAsNullMapTypeOf[T]) Equal(o attr.Type) bool {
+	other, ok := o.(emptyAsNullMapTypeOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return t.mapTypeOf.Equal(other.mapTypeOf)
+}
+
+func (t emptyAsNullMapTypeOf[T]) String() string {
+	var zero T
+	return fmt.Sprintf("EmptyAsNullMapTypeOf[%T]", zero)
+}
+
+func (t emptyAsNullMapTypeOf[T]) ValueFromMap(ctx context.Context, in basetypes.MapValue) (basetypes.MapValuable, diag.Diagnostics) {
+	if !in.IsNull() && !in.IsUnknown() && len(in.Elements()) == 0 {
+		return NewEmptyAsNullMapValueOfNull[T](ctx), nil
+	}
+
+	return t.valueFromMap(ctx, in)
+}
+
+func (t emptyAsNullMapTypeOf[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.MapType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mapValue, ok := attrValue.(basetypes.MapValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	// Don't collapse empty maps here: the planned value must match configuration.
+	mapValuable, diags := t.valueFromMap(ctx, mapValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting MapValue to MapValuable: %v", diags)
+	}
+
+	return mapValuable, nil
+}
+
+func (t emptyAsNullMapTypeOf[T]) ValueType(ctx context.Context) attr.Value {
+	return EmptyAsNullMapValueOf[T]{}
+}
+
+func (t emptyAsNullMapTypeOf[T]) valueFromMap(ctx context.Context, in basetypes.MapValue) (basetypes.MapValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, d := t.mapTypeOf.ValueFromMap(ctx, in)
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewEmptyAsNullMapValueOfUnknown[T](ctx), diags
+	}
+
+	return EmptyAsNullMapValueOf[T]{MapValueOf: v.(MapValueOf[T])}, diags
+}
+
+// EmptyAsNullMapValueOf represents a Terraform Plugin Framework Map value whose elements are of type T
+// and for which an empty map read from AWS is stored as null.
+type EmptyAsNullMapValueOf[T attr.Value] struct {
+	MapValueOf[T]
+}
+
+type (
+	EmptyAsNullMapOfString = EmptyAsNullMapValueOf[basetypes.StringValue]
+)
+
+func (v EmptyAsNullMapValueOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(EmptyAsNullMapValueOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.MapValueOf.Equal(other.MapValueOf)
+}
+
+func (v EmptyAsNullMapValueOf[T]) Type(ctx context.Context) attr.Type {
+	return NewEmptyAsNullMapTypeOf[T](ctx)
+}
+
+func NewEmptyAsNullMapValueOfNull[T attr.Value](ctx context.Context) EmptyAsNullMapValueOf[T] {
+	return EmptyAsNullMapValueOf[T]{MapValueOf: NewMapValueOfNull[T](ctx)}
+}
+
+func NewEmptyAsNullMapValueOfUnknown[T attr.Value](ctx context.Context) EmptyAsNullMapValueOf[T] {
+	return EmptyAsNullMapValueOf[T]{MapValueOf: NewMapValueOfUnknown[T](ctx)}
+}
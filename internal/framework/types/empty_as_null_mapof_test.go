@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestEmptyAsNullMapOfStringFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := map[string]struct {
+		val      tftypes.Value
+		expected attr.Value
+	}{
+		"values": {
+			val: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"key1": tftypes.NewValue(tftypes.String, "value1"),
+			}),
+			expected: fwtypes.EmptyAsNullMapValueOf[types.String]{MapValueOf: fwtypes.NewMapValueOfMust[types.String](ctx, map[string]attr.Value{
+				"key1": types.StringValue("value1"),
+			})},
+		},
+		"empty": {
+			val: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{}),
+			expected: fwtypes.EmptyAsNullMapValueOf[types.String]{MapValueOf: fwtypes.NewMapValueOfMust[types.String](ctx, map[string]attr.Value{})},
+		},
+		"null": {
+			val: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, nil),
+			expected: fwtypes.NewEmptyAsNullMapValueOfNull[types.String](ctx),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			val, err := fwtypes.EmptyAsNullMapOfStringType.ValueFromTerraform(ctx, test.val)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(val, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEmptyAsNullMapOfStringFromMap(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := map[string]struct {
+		val      types.Map
+		expected attr.Value
+	}{
+		"values": {
+			val: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"key1": types.StringValue("value1"),
+			}),
+			expected: fwtypes.EmptyAsNullMapValueOf[types.String]{MapValueOf: fwtypes.NewMapValueOfMust[types.String](ctx, map[string]attr.Value{
+				"key1": types.StringValue("value1"),
+			})},
+		},
+		"empty": {
+			val:      types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			expected: fwtypes.NewEmptyAsNullMapValueOfNull[types.String](ctx),
+		},
+		"null": {
+			val:      types.MapNull(types.StringType),
+			expected: fwtypes.NewEmptyAsNullMapValueOfNull[types.String](ctx),
+		},
+		"unknown": {
+			val:      types.MapUnknown(types.StringType),
+			expected: fwtypes.NewEmptyAsNullMapValueOfUnknown[types.String](ctx),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			val, diags := fwtypes.EmptyAsNullMapOfStringType.ValueFromMap(ctx, test.val)
+
+			if diags.HasError() {
+				t.Fatalf("got unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(val, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
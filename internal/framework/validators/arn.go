@@ -5,6 +5,9 @@ package validators
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -39,3 +42,83 @@ func (validator arnValidator) ValidateString(ctx context.Context, request valida
 func ARN() validator.String {
 	return arnValidator{}
 }
+
+type arnOfServiceValidator struct {
+	services      []string
+	resourceTypes []string
+}
+
+func (validator arnOfServiceValidator) Description(_ context.Context) string {
+	description := fmt.Sprintf("An Amazon Resource Name for service %s", strings.Join(validator.services, ", "))
+
+	if len(validator.resourceTypes) > 0 {
+		description += fmt.Sprintf(" and resource type %s", strings.Join(validator.resourceTypes, ", "))
+	}
+
+	return description
+}
+
+func (validator arnOfServiceValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator arnOfServiceValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	v, err := arn.Parse(request.ConfigValue.ValueString())
+
+	if err != nil {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			"value must be a valid ARN",
+		))
+		return
+	}
+
+	if !slices.Contains(validator.services, v.Service) {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			fmt.Sprintf("value must be an ARN for service %s, got: %s", strings.Join(validator.services, ", "), v.Service),
+		))
+		return
+	}
+
+	if len(validator.resourceTypes) == 0 {
+		return
+	}
+
+	resourceType, _, _ := strings.Cut(v.Resource, "/")
+	resourceType, _, _ = strings.Cut(resourceType, ":")
+
+	if !slices.Contains(validator.resourceTypes, resourceType) {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			fmt.Sprintf("value must be an ARN for resource type %s, got: %s", strings.Join(validator.resourceTypes, ", "), resourceType),
+		))
+		return
+	}
+}
+
+// ARNOfService returns a validator which ensures that any configured value is an ARN
+// whose service component (e.g. "dynamodb" or "s3") is one of the specified services.
+func ARNOfService(services ...string) validator.String {
+	return arnOfServiceValidator{
+		services: services,
+	}
+}
+
+// ARNOfResourceType returns a validator which ensures that any configured value is an ARN
+// for the specified service whose resource type (the leading part of the resource component,
+// e.g. "cluster" in "cluster:my-cluster" or "namespace" in "namespace/my-namespace") is one of
+// the specified resource types.
+func ARNOfResourceType(service string, resourceTypes ...string) validator.String {
+	return arnOfServiceValidator{
+		services:      []string{service},
+		resourceTypes: resourceTypes,
+	}
+}
@@ -59,3 +59,78 @@ func TestARNValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestARNOfServiceValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		validator   validator.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:       types.StringUnknown(),
+			validator: fwvalidators.ARNOfService("rds"),
+		},
+		"null String": {
+			val:       types.StringNull(),
+			validator: fwvalidators.ARNOfService("rds"),
+		},
+		"invalid arn": {
+			val:         types.StringValue("arn"),
+			validator:   fwvalidators.ARNOfService("rds"),
+			expectError: true,
+		},
+		"service match": {
+			val:       types.StringValue("arn:aws:dynamodb:us-west-2:123456789012:table/test"), //lintignore:AWSAT003,AWSAT005
+			validator: fwvalidators.ARNOfService("dynamodb", "s3"),
+		},
+		"service mismatch": {
+			val:         types.StringValue("arn:aws:iam::aws:policy/CloudWatchReadOnlyAccess"),
+			validator:   fwvalidators.ARNOfService("dynamodb", "s3"),
+			expectError: true,
+		},
+		"resource type match colon": {
+			val:       types.StringValue("arn:aws:rds:us-west-2:123456789012:cluster:test"), //lintignore:AWSAT003,AWSAT005
+			validator: fwvalidators.ARNOfResourceType("rds", "cluster", "db"),
+		},
+		"resource type match slash": {
+			val:       types.StringValue("arn:aws:redshift-serverless:us-west-2:123456789012:namespace/a1b2c3d4"), //lintignore:AWSAT003,AWSAT005
+			validator: fwvalidators.ARNOfResourceType("redshift-serverless", "namespace"),
+		},
+		"resource type mismatch": {
+			val:         types.StringValue("arn:aws:rds:us-west-2:123456789012:snapshot:test"), //lintignore:AWSAT003,AWSAT005
+			validator:   fwvalidators.ARNOfResourceType("rds", "cluster", "db"),
+			expectError: true,
+		},
+		"resource type service mismatch": {
+			val:         types.StringValue("arn:aws:docdb:us-west-2:123456789012:cluster:test"), //lintignore:AWSAT003,AWSAT005
+			validator:   fwvalidators.ARNOfResourceType("rds", "cluster", "db"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			test.validator.ValidateString(context.Background(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
@@ -120,6 +120,9 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	ComputedRegion          bool
+	ComputedAccountID       bool
+	RegionOverride          bool
 }
 
 type ServiceDatum struct {
@@ -201,12 +204,24 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging and computed attribute annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "ComputedAccountID" {
+			d.ComputedAccountID = true
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "ComputedRegion" {
+			d.ComputedRegion = true
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "RegionOverride" {
+			d.RegionOverride = true
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -354,7 +369,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ComputedAccountID", "ComputedRegion", "RegionOverride", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
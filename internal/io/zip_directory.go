@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// zipEntryModified is the modification time recorded for every ZIP entry so that
+// the archive (and its hash) depends only on file names, modes and contents.
+var zipEntryModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// ZipDirectoryContents creates a ZIP archive in memory of the regular files under the specified directory.
+// Entries are named relative to the directory and are written in lexical order with a fixed modification time,
+// so the same directory contents always produce the same archive.
+// Usually a call to this function is protected by an exclusive lock (per resource type)
+// to prevent memory exhaustion (e.g. `conns.GlobalMutexKV.Lock`).
+func ZipDirectoryContents(v string) ([]byte, error) {
+	dir, err := homedir.Expand(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := os.Stat(path) // Follow symbolic links.
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		header := &zip.FileHeader{
+			Name:     filepath.ToSlash(name),
+			Method:   zip.Deflate,
+			Modified: zipEntryModified,
+		}
+		header.SetMode(info.Mode())
+
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		_, err = fw.Write(content)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Base64SHA256 returns the base64-encoded SHA256 hash of the specified content,
+// equivalent to the Terraform filebase64sha256 function.
+func Base64SHA256(content []byte) string {
+	hash := sha256.Sum256(content)
+
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
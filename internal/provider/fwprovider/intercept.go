@@ -77,13 +77,26 @@ func (s ephemeralResourceInterceptors) close() []interceptorFunc[ephemeral.Close
 // If a Before interceptor returns Diagnostics indicating an error occurred then
 // no further interceptors in the chain are run and neither is the schema's method.
 // In other cases all interceptors in the chain are run.
-type resourceInterceptor interface {
+// A resource interceptor implements one or more of the resourceCreateInterceptor, resourceReadInterceptor,
+// resourceUpdateInterceptor and resourceDeleteInterceptor interfaces and is only invoked for those calls.
+type resourceInterceptor any
+
+type resourceCreateInterceptor interface {
 	// create is invoked for a Create call.
 	create(context.Context, interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics
+}
+
+type resourceReadInterceptor interface {
 	// read is invoked for a Read call.
 	read(context.Context, interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics
+}
+
+type resourceUpdateInterceptor interface {
 	// update is invoked for an Update call.
 	update(context.Context, interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics
+}
+
+type resourceDeleteInterceptor interface {
 	// delete is invoked for a Delete call.
 	delete(context.Context, interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics
 }
@@ -92,32 +105,38 @@ type resourceInterceptors []resourceInterceptor
 
 // create returns a slice of interceptors that run on resource Create.
 func (s resourceInterceptors) create() []interceptorFunc[resource.CreateRequest, resource.CreateResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.CreateRequest, resource.CreateResponse] {
-		return e.create
+	return slices.ApplyToAll(slices.Filter(s, isA[resourceCreateInterceptor]), func(e resourceInterceptor) interceptorFunc[resource.CreateRequest, resource.CreateResponse] {
+		return e.(resourceCreateInterceptor).create
 	})
 }
 
 // read returns a slice of interceptors that run on resource Read.
 func (s resourceInterceptors) read() []interceptorFunc[resource.ReadRequest, resource.ReadResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.ReadRequest, resource.ReadResponse] {
-		return e.read
+	return slices.ApplyToAll(slices.Filter(s, isA[resourceReadInterceptor]), func(e resourceInterceptor) interceptorFunc[resource.ReadRequest, resource.ReadResponse] {
+		return e.(resourceReadInterceptor).read
 	})
 }
 
 // update returns a slice of interceptors that run on resource Update.
 func (s resourceInterceptors) update() []interceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
-		return e.update
+	return slices.ApplyToAll(slices.Filter(s, isA[resourceUpdateInterceptor]), func(e resourceInterceptor) interceptorFunc[resource.UpdateRequest, resource.UpdateResponse] {
+		return e.(resourceUpdateInterceptor).update
 	})
 }
 
 // delete returns a slice of interceptors that run on resource Delete.
 func (s resourceInterceptors) delete() []interceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
-	return slices.ApplyToAll(s, func(e resourceInterceptor) interceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
-		return e.delete
+	return slices.ApplyToAll(slices.Filter(s, isA[resourceDeleteInterceptor]), func(e resourceInterceptor) interceptorFunc[resource.DeleteRequest, resource.DeleteResponse] {
+		return e.(resourceDeleteInterceptor).delete
 	})
 }
 
+// isA returns whether the resource interceptor implements the specified interface.
+func isA[T any](e resourceInterceptor) bool {
+	_, ok := e.(T)
+	return ok
+}
+
 // when represents the point in the CRUD request lifecycle that an interceptor is run.
 // Multiple values can be ORed together.
 type when uint16
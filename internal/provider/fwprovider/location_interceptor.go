@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// locationResourceInterceptor populates a resource's computed `region` and `account_id` attributes
+// with the Region and account ID of the provider that manages the resource.
+// It is only invoked for Read. The values are known at plan time, see setLocation.
+type locationResourceInterceptor struct {
+	attributes []string
+}
+
+func newLocationResourceInterceptor(attributes []string) resourceInterceptor {
+	return &locationResourceInterceptor{
+		attributes: attributes,
+	}
+}
+
+func (r locationResourceInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return diags
+		}
+
+		// Populates the attributes for imported resources and for resources created before the attributes were added.
+		for _, attribute := range r.attributes {
+			diags.Append(response.State.SetAttribute(ctx, path.Root(attribute), locationAttributeValue(ctx, c, attribute))...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+// setLocation returns a plan modifier that sets the known values of the computed `region` and `account_id` attributes.
+func setLocation(attributes []string) modifyPlanFunc {
+	return func(ctx context.Context, meta *conns.AWSClient, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		for _, attribute := range attributes {
+			var v types.String
+			response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(attribute), &v)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if !v.IsUnknown() {
+				continue
+			}
+
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(attribute), locationAttributeValue(ctx, meta, attribute))...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+}
+
+// validateLocationAttributes checks that each of the specified attributes is a Computed-only string attribute.
+func validateLocationAttributes(s schema.Schema, attributes []string) error {
+	for _, attribute := range attributes {
+		v, ok := s.Attributes[attribute]
+		if !ok {
+			return fmt.Errorf("no `%s` attribute defined in schema", attribute)
+		}
+
+		if _, ok := v.(schema.StringAttribute); !ok || !v.IsComputed() || v.IsOptional() || v.IsRequired() {
+			return fmt.Errorf("`%s` attribute must be a Computed-only string", attribute)
+		}
+	}
+
+	return nil
+}
+
+func locationAttributeValue(ctx context.Context, c *conns.AWSClient, attribute string) types.String {
+	switch attribute {
+	case names.AttrAccountID:
+		return types.StringValue(c.AccountID(ctx))
+	default:
+		return types.StringValue(c.Region(ctx))
+	}
+}
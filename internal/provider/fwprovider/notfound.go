@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+// notFoundOnReadHandler returns a Read handler that removes the resource from state when the Read fails
+// and its most recent AWS API call returned an error for which isNotFoundError returns true.
+func notFoundOnReadHandler(f func(context.Context, resource.ReadRequest, *resource.ReadResponse) diag.Diagnostics, isNotFoundError func(error) bool) func(context.Context, resource.ReadRequest, *resource.ReadResponse) diag.Diagnostics {
+	return func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+		ctx, apiErrors := conns.NewAPIErrorsContext(ctx)
+		diags := f(ctx, request, response)
+
+		if !diags.HasError() {
+			return diags
+		}
+
+		err, ok := apiErrors.LastError(isNotFoundError)
+		if !ok {
+			return diags
+		}
+
+		response.Diagnostics = diags.Warnings()
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return response.Diagnostics
+	}
+}
+
+// notFoundOnDeleteHandler returns a Delete handler that treats a failure whose most recent AWS API call
+// returned an error for which isNotFoundError returns true as a successful delete.
+// The resource no longer exists, so there is nothing left to delete.
+func notFoundOnDeleteHandler(f func(context.Context, resource.DeleteRequest, *resource.DeleteResponse) diag.Diagnostics, isNotFoundError func(error) bool) func(context.Context, resource.DeleteRequest, *resource.DeleteResponse) diag.Diagnostics {
+	return func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		ctx, apiErrors := conns.NewAPIErrorsContext(ctx)
+		diags := f(ctx, request, response)
+
+		if !diags.HasError() {
+			return diags
+		}
+
+		err, ok := apiErrors.LastError(isNotFoundError)
+		if !ok {
+			return diags
+		}
+
+		tflog.Debug(ctx, "resource not found during delete", map[string]any{
+			"error": err.Error(),
+		})
+
+		response.Diagnostics = diags.Warnings()
+
+		return response.Diagnostics
+	}
+}
@@ -61,10 +61,18 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"audit_log_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON Lines audit record is appended for every AWS API call that may modify resources. Request parameters are recorded only as an HMAC-SHA256 keyed per provider run.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"disable_mutating_operation_retries": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to disable automatic retries of failed AWS API calls that may modify resources. Calls to read-only operations are still retried.",
+			},
 			"ec2_metadata_service_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: "Address of the EC2 metadata service endpoint to use. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
@@ -93,6 +101,11 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
 			},
+			"mutating_operation_retries": schema.MapAttribute{
+				ElementType: types.BoolType,
+				Optional:    true,
+				Description: "Per-resource overrides of `disable_mutating_operation_retries`. Keys are a resource type name or a prefix ending in `*`. Values specify whether failed AWS API calls that may modify resources are retried.",
+			},
 			"no_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
@@ -105,6 +118,11 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"resource_timeouts": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Default operation timeouts for resources that support the `timeouts` block, used when a resource does not configure its own. Keys are a resource type name or a prefix ending in `*`, optionally followed by `.` and one of `create`, `read`, `update` or `delete`. Values are durations such as `60m`. Only resources implemented with the Terraform Plugin Framework use these defaults.",
+			},
 			"retry_mode": schema.StringAttribute{
 				Optional:    true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
@@ -137,6 +155,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "Skip the credentials validation via STS API. Used for AWS API implementations that do not have STS available/implemented.",
 			},
+			"skip_iam_role_inline_policy_read": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip reading IAM role inline policies when `inline_policy` is not configured. Reduces the number of IAM API calls made when refreshing many roles.",
+			},
 			"skip_metadata_api_check": schema.StringAttribute{
 				Optional:    true,
 				Description: "Skip the AWS Metadata API check. Used for AWS API implementations that do not have a metadata api endpoint.",
@@ -261,6 +283,11 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_opsworks_custom_layer`, that default tags are not applied to.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -357,7 +384,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -395,6 +422,13 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
 		servicePackageName := sp.ServicePackageName()
 
+		var isNotFoundError func(error) bool
+		if v, ok := sp.(conns.ServicePackageWithNotFoundErrors); ok {
+			isNotFoundError = func(err error) bool {
+				return v.IsNotFoundError(ctx, err)
+			}
+		}
+
 		for _, v := range sp.FrameworkResources(ctx) {
 			inner, err := v.Factory(ctx)
 
@@ -435,14 +469,59 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, newTagsResourceInterceptor(v.Tags))
 			}
 
+			var locationAttributes []string
+			if v.ComputedRegion {
+				locationAttributes = append(locationAttributes, names.AttrRegion)
+			}
+			if v.ComputedAccountID {
+				locationAttributes = append(locationAttributes, names.AttrAccountID)
+			}
+			if len(locationAttributes) > 0 {
+				// The resource has opted in to computed region and/or account ID attributes.
+				// Ensure that the schema look OK.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if err := validateLocationAttributes(schemaResponse.Schema, locationAttributes); err != nil {
+					errs = append(errs, fmt.Errorf("%w: %s", err, typeName))
+					continue
+				}
+
+				modifyPlanFuncs = append(modifyPlanFuncs, setLocation(locationAttributes))
+				interceptors = append(interceptors, newLocationResourceInterceptor(locationAttributes))
+			}
+
+			if v.RegionOverride {
+				// The resource has opted in to per-resource Region override.
+				// Ensure that the schema look OK.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if err := validateRegionOverrideAttribute(schemaResponse.Schema); err != nil {
+					errs = append(errs, fmt.Errorf("%w: %s", err, typeName))
+					continue
+				}
+
+				// An unconfigured Region defaults to the provider's Region.
+				modifyPlanFuncs = append(modifyPlanFuncs, setLocation([]string{names.AttrRegion}))
+				interceptors = append(interceptors, newRegionOverrideResourceInterceptor())
+			}
+
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
+					if v.RegionOverride {
+						ctx, diags = regionOverrideContext(ctx, getAttribute, c)
+						if diags.HasError() {
+							return ctx, diags
+						}
+					}
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+						ctx = conns.NewResourceTimeoutsContext(ctx, c.ResourceTimeouts(ctx))
 						ctx = c.RegisterLogger(ctx)
 						ctx = flex.RegisterLogger(ctx)
 					}
@@ -450,6 +529,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 					return ctx, diags
 				},
 				interceptors:    interceptors,
+				isNotFoundError: isNotFoundError,
 				modifyPlanFuncs: modifyPlanFuncs,
 				typeName:        typeName,
 			}
@@ -498,7 +578,7 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 					bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 						var diags diag.Diagnostics
 
-						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name, v.TypeName)
 						if c != nil {
 							ctx = c.RegisterLogger(ctx)
 							ctx = flex.RegisterLogger(ctx)
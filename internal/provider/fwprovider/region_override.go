@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// validateRegionOverrideAttribute checks that the `region` attribute is an Optional and Computed string attribute.
+func validateRegionOverrideAttribute(s schema.Schema) error {
+	v, ok := s.Attributes[names.AttrRegion]
+	if !ok {
+		return fmt.Errorf("no `%s` attribute defined in schema", names.AttrRegion)
+	}
+
+	if _, ok := v.(schema.StringAttribute); !ok || !v.IsOptional() || !v.IsComputed() {
+		return fmt.Errorf("`%s` attribute must be an Optional and Computed string", names.AttrRegion)
+	}
+
+	return nil
+}
+
+// regionOverrideContext returns a Context in which the Region configured in the resource's `region` attribute,
+// if known, overrides the provider's Region.
+// The Region is validated against the provider's partition.
+func regionOverrideContext(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if getAttribute == nil {
+		return ctx, diags
+	}
+
+	var region types.String
+	diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	if region.IsNull() || region.IsUnknown() {
+		return ctx, diags
+	}
+
+	if c != nil {
+		if err := c.ValidateRegionOverride(ctx, region.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region", err.Error())
+
+			return ctx, diags
+		}
+	}
+
+	return conns.NewRegionOverrideContext(ctx, region.ValueString()), diags
+}
+
+// regionOverrideResourceInterceptor populates an unset `region` attribute with the provider's Region.
+// It is only invoked for Read.
+type regionOverrideResourceInterceptor struct{}
+
+func newRegionOverrideResourceInterceptor() resourceInterceptor {
+	return &regionOverrideResourceInterceptor{}
+}
+
+func (r regionOverrideResourceInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return diags
+		}
+
+		var region types.String
+		diags.Append(response.State.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// Populates the attribute for imported resources and for resources created before the attribute was added,
+		// so that the provider's default Region does not force a replacement.
+		if region.IsNull() {
+			diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), c.Region(ctx))...)
+		}
+	}
+
+	return diags
+}
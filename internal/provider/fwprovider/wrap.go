@@ -69,6 +69,9 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
+	if w.opts.isNotFoundError != nil {
+		f = notFoundOnReadHandler(f, w.opts.isNotFoundError)
+	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.read(), f, w.meta)(ctx, request, response)...)
 }
 
@@ -249,8 +252,10 @@ type wrappedResourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     resourceInterceptors
-	modifyPlanFuncs  []modifyPlanFunc
-	typeName         string
+	// isNotFoundError returns whether an API error indicates the resource does not exist.
+	isNotFoundError func(error) bool
+	modifyPlanFuncs []modifyPlanFunc
+	typeName        string
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
@@ -335,6 +340,9 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		w.inner.Delete(ctx, request, response)
 		return response.Diagnostics
 	}
+	if w.opts.isNotFoundError != nil {
+		f = notFoundOnDeleteHandler(f, w.opts.isNotFoundError)
+	}
 	response.Diagnostics.Append(interceptedHandler(w.opts.interceptors.delete(), f, w.meta)(ctx, request, response)...)
 }
 
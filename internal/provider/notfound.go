@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// notFoundOnDeleteHandler returns a Delete handler that treats a failure whose most recent AWS API call
+// returned an error for which isNotFoundError returns true as a successful delete.
+// The resource no longer exists, so there is nothing left to delete.
+func notFoundOnDeleteHandler(f schema.DeleteContextFunc, isNotFoundError func(error) bool) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		ctx, apiErrors := conns.NewAPIErrorsContext(ctx)
+		diags := f(ctx, d, meta)
+
+		if !diags.HasError() {
+			return diags
+		}
+
+		err, ok := apiErrors.LastError(isNotFoundError)
+		if !ok {
+			return diags
+		}
+
+		tflog.Debug(ctx, "resource not found during delete", map[string]any{
+			"id":    d.Id(),
+			"error": err.Error(),
+		})
+
+		return sdkdiag.Warnings(diags)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func TestNotFoundOnDeleteHandler(t *testing.T) {
+	t.Parallel()
+
+	isNotFoundError := func(err error) bool {
+		var apiErr smithy.APIError
+		return errors.As(err, &apiErr) && apiErr.ErrorCode() == "ResourceNotFoundException"
+	}
+
+	notFoundErr := &smithy.GenericAPIError{Code: "ResourceNotFoundException", Message: "Unable to find thing with ID 1234"}
+	otherErr := &smithy.GenericAPIError{Code: "ValidationException", Message: "invalid"}
+
+	testCases := map[string]struct {
+		apiErrors    []error
+		diags        func([]error) diag.Diagnostics
+		wantErrors   int
+		wantWarnings int
+	}{
+		"no diagnostics": {
+			diags: func([]error) diag.Diagnostics { return nil },
+		},
+		"not found error": {
+			apiErrors: []error{notFoundErr},
+			diags: func(errs []error) diag.Diagnostics {
+				return sdkdiag.AppendErrorf(nil, "deleting Thing (1234): %s", errs[0])
+			},
+		},
+		"not found error and warning": {
+			apiErrors: []error{notFoundErr},
+			diags: func(errs []error) diag.Diagnostics {
+				return sdkdiag.AppendWarningf(sdkdiag.AppendErrorf(nil, "deleting Thing (1234): %s", errs[0]), "a warning")
+			},
+			wantWarnings: 1,
+		},
+		"other error": {
+			apiErrors: []error{otherErr},
+			diags: func(errs []error) diag.Diagnostics {
+				return sdkdiag.AppendErrorf(nil, "deleting Thing (1234): %s", errs[0])
+			},
+			wantErrors: 1,
+		},
+		"not found and other error": {
+			apiErrors: []error{notFoundErr, otherErr},
+			diags: func(errs []error) diag.Diagnostics {
+				return sdkdiag.AppendErrorf(nil, "deleting Thing (1234): %s", errs[1])
+			},
+			wantErrors: 1,
+		},
+		"other and not found error": {
+			apiErrors: []error{otherErr, notFoundErr},
+			diags: func(errs []error) diag.Diagnostics {
+				return sdkdiag.AppendErrorf(nil, "deleting Thing (1234): %s", errs[1])
+			},
+		},
+		"not found error not from an API call": {
+			diags: func([]error) diag.Diagnostics {
+				return sdkdiag.AppendErrorf(nil, "deleting Thing (1234): %s", notFoundErr)
+			},
+			wantErrors: 1,
+		},
+		"not found API error and later failure": {
+			apiErrors: []error{notFoundErr, nil},
+			diags: func([]error) diag.Diagnostics {
+				return sdkdiag.AppendErrorf(nil, "waiting for Thing (1234) delete: timeout")
+			},
+			wantErrors: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f := notFoundOnDeleteHandler(func(ctx context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+				// Simulate the AWS API calls made by the Delete.
+				if v, ok := conns.APIErrorsFromContext(ctx); ok {
+					for _, err := range testCase.apiErrors {
+						v.Record(err)
+					}
+				}
+
+				return testCase.diags(testCase.apiErrors)
+			}, isNotFoundError)
+
+			diags := f(context.Background(), &schema.ResourceData{}, nil)
+
+			if got, want := len(sdkdiag.Errors(diags)), testCase.wantErrors; got != want {
+				t.Errorf("length of Errors = %v, want %v", got, want)
+			}
+			if got, want := len(sdkdiag.Warnings(diags)), testCase.wantWarnings; got != want {
+				t.Errorf("length of Warnings = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -55,6 +55,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which a JSON Lines audit record is appended for every AWS API call " +
+					"that may modify resources. Request parameters are recorded only as an HMAC-SHA256 keyed per provider run.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -69,6 +75,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. `aws_opsworks_custom_layer`, that default tags are not applied to.",
+						},
 						"tags": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -79,6 +91,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"disable_mutating_operation_retries": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether to disable automatic retries of failed AWS API calls that may modify resources. " +
+					"Calls to read-only operations are still retried.",
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -147,6 +165,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"mutating_operation_retries": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+				Description: "Per-resource overrides of `disable_mutating_operation_retries`. " +
+					"Keys are a resource type name or a prefix ending in `*`. Values specify whether failed AWS API calls that may modify resources are retried.",
+			},
 			"no_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -165,6 +190,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"resource_timeouts": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Default operation timeouts for resources that support the `timeouts` block, used when a resource does not configure its own. " +
+					"Keys are a resource type name or a prefix ending in `*`, optionally followed by `.` and one of `create`, `read`, `update` or `delete`. " +
+					"Values are durations such as `60m`. Only resources implemented with the Terraform Plugin Framework use these defaults.",
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -210,6 +243,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Skip the credentials validation via STS API. " +
 					"Used for AWS API implementations that do not have STS available/implemented.",
 			},
+			"skip_iam_role_inline_policy_read": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Skip reading IAM role inline policies when `inline_policy` is not configured. " +
+					"Reduces the number of IAM API calls made when refreshing many roles.",
+			},
 			"skip_metadata_api_check": {
 				Type:         nullable.TypeNullableBool,
 				Optional:     true,
@@ -276,6 +315,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		servicePackageName := sp.ServicePackageName()
 		servicePackageMap[servicePackageName] = sp
 
+		var isNotFoundError func(error) bool
+		if v, ok := sp.(conns.ServicePackageWithNotFoundErrors); ok {
+			isNotFoundError = func(err error) bool {
+				return v.IsNotFoundError(ctx, err)
+			}
+		}
+
 		for _, v := range sp.SDKDataSources(ctx) {
 			typeName := v.TypeName
 
@@ -319,7 +365,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 					if v, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
@@ -401,7 +447,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 					if v, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
@@ -411,6 +457,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				},
 				customizeDiffFuncs: customizeDiffFuncs,
 				interceptors:       interceptors,
+				isNotFoundError:    isNotFoundError,
 				typeName:           typeName,
 			}
 			wrapResource(r, opts)
@@ -450,6 +497,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogPath:                   d.Get("audit_log_path").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -461,6 +509,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
+		SkipIAMRoleInlinePolicyRead:    d.Get("skip_iam_role_inline_policy_read").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
@@ -471,6 +520,20 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	mutatingOperationRetries, err := conns.NewMutatingOperationRetries(d.Get("disable_mutating_operation_retries").(bool), flex.ExpandBoolValueMap(d.Get("mutating_operation_retries").(map[string]any)))
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+	config.MutatingOperationRetries = mutatingOperationRetries
+
+	if v, ok := d.GetOk("resource_timeouts"); ok {
+		rt, err := conns.NewResourceTimeouts(flex.ExpandStringValueMap(v.(map[string]any)))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.ResourceTimeouts = rt
+	}
+
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
 		mode, err := aws.ParseRetryMode(v)
 		if err != nil {
@@ -853,9 +916,15 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 	}
 
 	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
+		config := &tftags.DefaultConfig{
 			Tags: tftags.New(ctx, tags),
 		}
+
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			config.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+		}
+
+		return config
 	}
 
 	return nil
@@ -235,6 +235,7 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := map[string]struct {
 		tags                  map[string]any
+		excludeResourceTypes  []any
 		envvars               map[string]string
 		expectedDefaultConfig *tftags.DefaultConfig
 	}{
@@ -280,6 +281,19 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 				}),
 			},
 		},
+		"exclude resource types": {
+			tags: map[string]any{
+				"Owner": "my-team",
+			},
+			excludeResourceTypes: []any{"aws_opsworks_custom_layer"},
+			envvars:              map[string]string{},
+			expectedDefaultConfig: &tftags.DefaultConfig{
+				ExcludeResourceTypes: []string{"aws_opsworks_custom_layer"},
+				Tags: tftags.New(ctx, map[string]string{
+					"Owner": "my-team",
+				}),
+			},
+		},
 	}
 
 	for name, testcase := range testcases { //nolint:paralleltest
@@ -292,7 +306,8 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 			}
 
 			results := expandDefaultTags(ctx, map[string]any{
-				"tags": testcase.tags,
+				"exclude_resource_types": schema.NewSet(schema.HashString, testcase.excludeResourceTypes),
+				"tags":                   testcase.tags,
 			})
 
 			if results == nil {
@@ -303,6 +318,8 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 				}
 			} else if !testcase.expectedDefaultConfig.TagsEqual(results.Tags) {
 				t.Errorf("Expected default tags config to be %v, got %v", testcase.expectedDefaultConfig, results)
+			} else if diff := cmp.Diff(results.ExcludeResourceTypes, testcase.expectedDefaultConfig.ExcludeResourceTypes); diff != "" {
+				t.Errorf("unexpected exclude_resource_types diff (+wanted, -got): %s", diff)
 			}
 		})
 	}
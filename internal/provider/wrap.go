@@ -50,7 +50,9 @@ type wrappedResourceOptions struct {
 	bootstrapContext   contextFunc
 	customizeDiffFuncs []schema.CustomizeDiffFunc
 	interceptors       interceptorItems
-	typeName           string
+	// isNotFoundError returns whether an API error indicates the resource does not exist.
+	isNotFoundError func(error) bool
+	typeName        string
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin SDK v2 resource.
@@ -105,6 +107,10 @@ func (w *wrappedResource) delete(f schema.DeleteContextFunc) schema.DeleteContex
 		return nil
 	}
 
+	if w.opts.isNotFoundError != nil {
+		f = notFoundOnDeleteHandler(f, w.opts.isNotFoundError)
+	}
+
 	return interceptedHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Delete)
 }
 
@@ -610,6 +610,27 @@ func waitCertificateAuthorityCreated(ctx context.Context, conn *acmpca.Client, a
 	return nil, err
 }
 
+func waitCertificateAuthorityActive(ctx context.Context, conn *acmpca.Client, arn string, timeout time.Duration) (*types.CertificateAuthority, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.CertificateAuthorityStatusPendingCertificate),
+		Target:  enum.Slice(types.CertificateAuthorityStatusActive, types.CertificateAuthorityStatusDisabled),
+		Refresh: statusCertificateAuthority(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.CertificateAuthority); ok {
+		if output.Status == types.CertificateAuthorityStatusFailed {
+			tfresource.SetLastError(err, errors.New(string(output.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	certificateAuthorityActiveTimeout = 1 * time.Minute
 )
@@ -78,6 +78,12 @@ func resourceCertificateAuthorityCertificateCreate(ctx context.Context, d *schem
 
 	d.SetId(certificateAuthorityARN)
 
+	// Importing the certificate moves a CA out of PENDING_CERTIFICATE.
+	// Wait for that so that the CA can issue certificates as soon as this resource is created.
+	if _, err := waitCertificateAuthorityActive(ctx, conn, d.Id(), certificateAuthorityActiveTimeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ACM PCA Certificate Authority (%s) activate: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceCertificateAuthorityCertificateRead(ctx, d, meta)...)
 }
 
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -48,6 +49,50 @@ func resourceDataIntegration() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
+			"file_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filters": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrKey: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrValues: {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+									},
+								},
+							},
+						},
+						"folders": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+						},
+					},
+				},
+			},
 			names.AttrKMSKey: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -127,6 +172,10 @@ func resourceDataIntegrationCreate(ctx context.Context, d *schema.ResourceData,
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("file_configuration"); ok {
+		input.FileConfiguration = expandFileConfiguration(v.([]any))
+	}
+
 	output, err := conn.CreateDataIntegration(ctx, input)
 
 	if err != nil {
@@ -160,6 +209,9 @@ func resourceDataIntegrationRead(ctx context.Context, d *schema.ResourceData, me
 
 	d.Set(names.AttrARN, output.Arn)
 	d.Set(names.AttrDescription, output.Description)
+	if err := d.Set("file_configuration", flattenFileConfiguration(output.FileConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting file_configuration: %s", err)
+	}
 	d.Set(names.AttrKMSKey, output.KmsKey)
 	d.Set(names.AttrName, output.Name)
 	if err := d.Set("schedule_config", flattenScheduleConfig(output.ScheduleConfiguration)); err != nil {
@@ -242,3 +294,58 @@ func flattenScheduleConfig(scheduleConfig *awstypes.ScheduleConfiguration) []any
 
 	return []any{values}
 }
+
+func expandFileConfiguration(tfList []any) *awstypes.FileConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	apiObject := &awstypes.FileConfiguration{
+		Folders: flex.ExpandStringValueSet(tfMap["folders"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["filters"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Filters = make(map[string][]string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			apiObject.Filters[tfMap[names.AttrKey].(string)] = flex.ExpandStringValueSet(tfMap[names.AttrValues].(*schema.Set))
+		}
+	}
+
+	return apiObject
+}
+
+func flattenFileConfiguration(apiObject *awstypes.FileConfiguration) []any {
+	if apiObject == nil {
+		return []any{}
+	}
+
+	tfMap := map[string]any{
+		"folders": apiObject.Folders,
+	}
+
+	if v := apiObject.Filters; len(v) > 0 {
+		var filters []any
+
+		for key, values := range v {
+			filters = append(filters, map[string]any{
+				names.AttrKey:    key,
+				names.AttrValues: values,
+			})
+		}
+
+		tfMap["filters"] = filters
+	}
+
+	return []any{tfMap}
+}
@@ -49,6 +49,7 @@ func TestAccAppIntegrationsDataIntegration_basic(t *testing.T) {
 					testAccCheckDataIntegrationExists(ctx, resourceName, &dataIntegration),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN), // nosemgrep:ci.semgrep.acctest.checks.arn-resourceattrset // TODO: need TFC Org for testing
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, description),
+					resource.TestCheckResourceAttr(resourceName, "file_configuration.#", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKey, "aws_kms_key.test", names.AttrARN),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, "source_uri", sourceUri),
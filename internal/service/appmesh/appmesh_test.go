@@ -70,6 +70,10 @@ func TestAccAppMesh_serial(t *testing.T) {
 			"dataSourceTCPRoute":               testAccRouteDataSource_tcpRoute,
 			"dataSource_tags":                  testAccAppMeshRouteDataSource_tagsSerial,
 		},
+		"ServiceConnectConfiguration": {
+			"dataSourceVirtualNode":   testAccServiceConnectConfigurationDataSource_virtualNode,
+			"dataSourceVirtualRouter": testAccServiceConnectConfigurationDataSource_virtualRouter,
+		},
 		"VirtualGateway": {
 			acctest.CtBasic:              testAccVirtualGateway_basic,
 			acctest.CtDisappears:         testAccVirtualGateway_disappears,
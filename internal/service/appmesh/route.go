@@ -935,3 +935,27 @@ func findRoute(ctx context.Context, conn *appmesh.Client, input *appmesh.Describ
 
 	return output.Route, nil
 }
+
+func findRoutes(ctx context.Context, conn *appmesh.Client, input *appmesh.ListRoutesInput) ([]awstypes.RouteRef, error) {
+	var output []awstypes.RouteRef
+
+	pages := appmesh.NewListRoutesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Routes...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appmesh
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appmesh/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_appmesh_service_connect_configuration", name="Service Connect Configuration")
+func dataSourceServiceConnectConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceConnectConfigurationRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"mesh_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"mesh_owner": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				names.AttrNamespace: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"service": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"client_alias": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrDNSName: {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+										names.AttrPort: {
+											Type:     schema.TypeInt,
+											Computed: true,
+										},
+									},
+								},
+							},
+							"discovery_name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"port_name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrTimeout: {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"idle_timeout_seconds": {
+											Type:     schema.TypeInt,
+											Computed: true,
+										},
+										"per_request_timeout_seconds": {
+											Type:     schema.TypeInt,
+											Computed: true,
+										},
+									},
+								},
+							},
+							"virtual_node_name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"unsupported_features": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"virtual_service_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			}
+		},
+	}
+}
+
+// serviceConnectTarget is a virtual node that receives traffic for the virtual service.
+type serviceConnectTarget struct {
+	virtualNodeName string
+	// port is the virtual node listener port targeted, or 0 for every listener.
+	port int32
+	// clientPort is the port that clients use to reach the virtual service, or 0 for the listener port.
+	clientPort int32
+}
+
+func dataSourceServiceConnectConfigurationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppMeshClient(ctx)
+
+	meshName, meshOwner := d.Get("mesh_name").(string), d.Get("mesh_owner").(string)
+	virtualServiceName := d.Get("virtual_service_name").(string)
+	vs, err := findVirtualServiceByThreePartKey(ctx, conn, meshName, meshOwner, virtualServiceName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading App Mesh Virtual Service (%s): %s", virtualServiceName, err)
+	}
+
+	var (
+		targets     []serviceConnectTarget
+		unsupported []string
+	)
+
+	switch v := vs.Spec.Provider.(type) {
+	case *awstypes.VirtualServiceProviderMemberVirtualNode:
+		targets = append(targets, serviceConnectTarget{
+			virtualNodeName: aws.ToString(v.Value.VirtualNodeName),
+		})
+	case *awstypes.VirtualServiceProviderMemberVirtualRouter:
+		virtualRouterName := aws.ToString(v.Value.VirtualRouterName)
+		targets, unsupported, err = findServiceConnectTargetsForVirtualRouter(ctx, conn, meshName, meshOwner, virtualRouterName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading App Mesh Virtual Router (%s) routes: %s", virtualRouterName, err)
+		}
+	default:
+		return sdkdiag.AppendErrorf(diags, "App Mesh Virtual Service (%s) has no provider", virtualServiceName)
+	}
+
+	var (
+		namespaces []string
+		services   []any
+	)
+
+	for _, target := range targets {
+		vn, err := findVirtualNodeByThreePartKey(ctx, conn, meshName, meshOwner, target.virtualNodeName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading App Mesh Virtual Node (%s): %s", target.virtualNodeName, err)
+		}
+
+		discoveryName := target.virtualNodeName
+		if v, ok := vn.Spec.ServiceDiscovery.(*awstypes.ServiceDiscoveryMemberAwsCloudMap); ok {
+			discoveryName = aws.ToString(v.Value.ServiceName)
+			if namespace := aws.ToString(v.Value.NamespaceName); !slices.Contains(namespaces, namespace) {
+				namespaces = append(namespaces, namespace)
+			}
+		}
+
+		for _, listener := range vn.Spec.Listeners {
+			if listener.PortMapping == nil {
+				continue
+			}
+
+			port := aws.ToInt32(listener.PortMapping.Port)
+			if target.port != 0 && port != target.port {
+				continue
+			}
+
+			if listener.Tls != nil {
+				unsupported = append(unsupported, fmt.Sprintf("listener TLS on virtual node %s port %d", target.virtualNodeName, port))
+			}
+
+			clientPort := port
+			if target.clientPort != 0 {
+				clientPort = target.clientPort
+			}
+
+			services = append(services, map[string]any{
+				"client_alias": []any{
+					map[string]any{
+						names.AttrDNSName: virtualServiceName,
+						names.AttrPort:    clientPort,
+					},
+				},
+				"discovery_name":    discoveryName,
+				"port_name":         fmt.Sprintf("%s-%d", listener.PortMapping.Protocol, port),
+				names.AttrTimeout:   flattenServiceConnectTimeout(listener.Timeout),
+				"virtual_node_name": target.virtualNodeName,
+			})
+		}
+	}
+
+	if len(namespaces) > 1 {
+		unsupported = append(unsupported, fmt.Sprintf("virtual nodes in multiple Cloud Map namespaces (%v)", namespaces))
+	}
+
+	d.SetId(aws.ToString(vs.Metadata.Arn))
+	d.Set("mesh_owner", vs.Metadata.MeshOwner)
+	if len(namespaces) > 0 {
+		d.Set(names.AttrNamespace, namespaces[0])
+	} else {
+		d.Set(names.AttrNamespace, nil)
+	}
+	if err := d.Set("service", services); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting service: %s", err)
+	}
+	d.Set("unsupported_features", unsupported)
+
+	return diags
+}
+
+// findServiceConnectTargetsForVirtualRouter returns the virtual nodes targeted by the virtual router's routes
+// along with any routing features that ECS Service Connect can't represent.
+func findServiceConnectTargetsForVirtualRouter(ctx context.Context, conn *appmesh.Client, meshName, meshOwner, virtualRouterName string) ([]serviceConnectTarget, []string, error) {
+	vr, err := findVirtualRouterByThreePartKey(ctx, conn, meshName, meshOwner, virtualRouterName)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var routerPort int32
+	if listeners := vr.Spec.Listeners; len(listeners) == 1 && listeners[0].PortMapping != nil {
+		routerPort = aws.ToInt32(listeners[0].PortMapping.Port)
+	}
+
+	input := &appmesh.ListRoutesInput{
+		MeshName:          aws.String(meshName),
+		VirtualRouterName: aws.String(virtualRouterName),
+	}
+	if meshOwner != "" {
+		input.MeshOwner = aws.String(meshOwner)
+	}
+
+	routeRefs, err := findRoutes(ctx, conn, input)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		targets     []serviceConnectTarget
+		unsupported []string
+	)
+
+	for _, routeRef := range routeRefs {
+		routeName := aws.ToString(routeRef.RouteName)
+		route, err := findRouteByFourPartKey(ctx, conn, meshName, meshOwner, virtualRouterName, routeName)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var (
+			weightedTargets []awstypes.WeightedTarget
+			matchPort       int32
+		)
+
+		switch spec := route.Spec; {
+		case spec.HttpRoute != nil || spec.Http2Route != nil:
+			httpRoute := spec.HttpRoute
+			if httpRoute == nil {
+				httpRoute = spec.Http2Route
+			}
+
+			if action := httpRoute.Action; action != nil {
+				weightedTargets = action.WeightedTargets
+			}
+			if match := httpRoute.Match; match != nil {
+				matchPort = aws.ToInt32(match.Port)
+				if aws.ToString(match.Prefix) != "/" || match.Path != nil || len(match.Headers) > 0 || len(match.QueryParameters) > 0 || match.Method != "" {
+					unsupported = append(unsupported, fmt.Sprintf("request matching on route %s", routeName))
+				}
+			}
+			if httpRoute.RetryPolicy != nil {
+				unsupported = append(unsupported, fmt.Sprintf("retry policy on route %s", routeName))
+			}
+		case spec.GrpcRoute != nil:
+			if action := spec.GrpcRoute.Action; action != nil {
+				weightedTargets = action.WeightedTargets
+			}
+			if match := spec.GrpcRoute.Match; match != nil {
+				matchPort = aws.ToInt32(match.Port)
+				if match.ServiceName != nil || match.MethodName != nil || len(match.Metadata) > 0 {
+					unsupported = append(unsupported, fmt.Sprintf("request matching on route %s", routeName))
+				}
+			}
+			if spec.GrpcRoute.RetryPolicy != nil {
+				unsupported = append(unsupported, fmt.Sprintf("retry policy on route %s", routeName))
+			}
+		case spec.TcpRoute != nil:
+			if action := spec.TcpRoute.Action; action != nil {
+				weightedTargets = action.WeightedTargets
+			}
+			if match := spec.TcpRoute.Match; match != nil {
+				matchPort = aws.ToInt32(match.Port)
+			}
+		}
+
+		if len(weightedTargets) > 1 {
+			unsupported = append(unsupported, fmt.Sprintf("weighted routing on route %s", routeName))
+		}
+
+		clientPort := routerPort
+		if matchPort != 0 {
+			clientPort = matchPort
+		}
+
+		for _, weightedTarget := range weightedTargets {
+			target := serviceConnectTarget{
+				virtualNodeName: aws.ToString(weightedTarget.VirtualNode),
+				port:            aws.ToInt32(weightedTarget.Port),
+				clientPort:      clientPort,
+			}
+
+			if !slices.Contains(targets, target) {
+				targets = append(targets, target)
+			}
+		}
+	}
+
+	return targets, unsupported, nil
+}
+
+func flattenServiceConnectTimeout(apiObject awstypes.ListenerTimeout) []any {
+	var idle, perRequest *awstypes.Duration
+
+	switch v := apiObject.(type) {
+	case *awstypes.ListenerTimeoutMemberGrpc:
+		idle, perRequest = v.Value.Idle, v.Value.PerRequest
+	case *awstypes.ListenerTimeoutMemberHttp:
+		idle, perRequest = v.Value.Idle, v.Value.PerRequest
+	case *awstypes.ListenerTimeoutMemberHttp2:
+		idle, perRequest = v.Value.Idle, v.Value.PerRequest
+	case *awstypes.ListenerTimeoutMemberTcp:
+		idle = v.Value.Idle
+	}
+
+	if idle == nil && perRequest == nil {
+		return []any{}
+	}
+
+	return []any{
+		map[string]any{
+			"idle_timeout_seconds":        durationSeconds(idle),
+			"per_request_timeout_seconds": durationSeconds(perRequest),
+		},
+	}
+}
+
+// durationSeconds returns the duration in whole seconds, rounding up, as ECS Service Connect timeouts have a granularity of one second.
+func durationSeconds(apiObject *awstypes.Duration) int64 {
+	if apiObject == nil {
+		return 0
+	}
+
+	v := aws.ToInt64(apiObject.Value)
+	if apiObject.Unit == awstypes.DurationUnitMs {
+		return (v + 999) / 1000
+	}
+
+	return v
+}
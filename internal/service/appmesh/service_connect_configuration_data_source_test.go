@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appmesh_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccServiceConnectConfigurationDataSource_virtualNode(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_appmesh_service_connect_configuration.test"
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppMeshEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppMeshServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConnectConfigurationDataSourceConfig_virtualNode(rName, vsName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, "aws_appmesh_virtual_service.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrNamespace, "aws_service_discovery_http_namespace.test", names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "service.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.client_alias.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.client_alias.0.dns_name", vsName),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.client_alias.0.port", "8080"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.discovery_name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.port_name", "http-8080"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.timeout.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.timeout.0.idle_timeout_seconds", "10"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.timeout.0.per_request_timeout_seconds", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.virtual_node_name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported_features.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceConnectConfigurationDataSource_virtualRouter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_appmesh_service_connect_configuration.test"
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppMeshEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppMeshServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConnectConfigurationDataSourceConfig_virtualRouter(rName, vsName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrNamespace, ""),
+					resource.TestCheckResourceAttr(dataSourceName, "service.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.client_alias.0.dns_name", vsName),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.client_alias.0.port", "80"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.0.port_name", "http-8080"),
+					resource.TestCheckResourceAttr(dataSourceName, "service.1.client_alias.0.port", "80"),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported_features.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported_features.0", fmt.Sprintf("weighted routing on route %s", rName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceConnectConfigurationDataSourceConfig_virtualNode(rName, vsName string) string {
+	return fmt.Sprintf(`
+resource "aws_service_discovery_http_namespace" "test" {
+  name = %[1]q
+}
+
+resource "aws_appmesh_mesh" "test" {
+  name = %[1]q
+}
+
+resource "aws_appmesh_virtual_node" "test" {
+  name      = %[1]q
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    listener {
+      port_mapping {
+        port     = 8080
+        protocol = "http"
+      }
+
+      timeout {
+        http {
+          idle {
+            unit  = "s"
+            value = 10
+          }
+
+          per_request {
+            unit  = "ms"
+            value = 250
+          }
+        }
+      }
+    }
+
+    service_discovery {
+      aws_cloud_map {
+        namespace_name = aws_service_discovery_http_namespace.test.name
+        service_name   = %[1]q
+      }
+    }
+  }
+}
+
+resource "aws_appmesh_virtual_service" "test" {
+  name      = %[2]q
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    provider {
+      virtual_node {
+        virtual_node_name = aws_appmesh_virtual_node.test.name
+      }
+    }
+  }
+}
+
+data "aws_appmesh_service_connect_configuration" "test" {
+  mesh_name            = aws_appmesh_mesh.test.name
+  virtual_service_name = aws_appmesh_virtual_service.test.name
+}
+`, rName, vsName)
+}
+
+func testAccServiceConnectConfigurationDataSourceConfig_virtualRouter(rName, vsName string) string {
+	return fmt.Sprintf(`
+resource "aws_appmesh_mesh" "test" {
+  name = %[1]q
+}
+
+resource "aws_appmesh_virtual_node" "test" {
+  count = 2
+
+  name      = "%[1]s-${count.index}"
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    listener {
+      port_mapping {
+        port     = 8080
+        protocol = "http"
+      }
+    }
+
+    service_discovery {
+      dns {
+        hostname = "%[1]s-${count.index}.mesh.local"
+      }
+    }
+  }
+}
+
+resource "aws_appmesh_virtual_router" "test" {
+  name      = %[1]q
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    listener {
+      port_mapping {
+        port     = 80
+        protocol = "http"
+      }
+    }
+  }
+}
+
+resource "aws_appmesh_route" "test" {
+  name                = %[1]q
+  mesh_name           = aws_appmesh_mesh.test.id
+  virtual_router_name = aws_appmesh_virtual_router.test.name
+
+  spec {
+    http_route {
+      match {
+        prefix = "/"
+      }
+
+      action {
+        weighted_target {
+          virtual_node = aws_appmesh_virtual_node.test[0].name
+          weight       = 90
+        }
+
+        weighted_target {
+          virtual_node = aws_appmesh_virtual_node.test[1].name
+          weight       = 10
+        }
+      }
+    }
+  }
+}
+
+resource "aws_appmesh_virtual_service" "test" {
+  name      = %[2]q
+  mesh_name = aws_appmesh_mesh.test.id
+
+  spec {
+    provider {
+      virtual_router {
+        virtual_router_name = aws_appmesh_virtual_router.test.name
+      }
+    }
+  }
+}
+
+data "aws_appmesh_service_connect_configuration" "test" {
+  mesh_name            = aws_appmesh_mesh.test.name
+  virtual_service_name = aws_appmesh_virtual_service.test.name
+
+  depends_on = [aws_appmesh_route.test]
+}
+`, rName, vsName)
+}
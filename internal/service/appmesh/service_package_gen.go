@@ -42,6 +42,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Route",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceServiceConnectConfiguration,
+			TypeName: "aws_appmesh_service_connect_configuration",
+			Name:     "Service Connect Configuration",
+		},
 		{
 			Factory:  dataSourceVirtualGateway,
 			TypeName: "aws_appmesh_virtual_gateway",
@@ -13,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -34,6 +33,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func init() {
+	flex.RegisterUnion[awstypes.InferenceProfileModelSource](
+		&awstypes.InferenceProfileModelSourceMemberCopyFrom{},
+	)
+}
+
 // @FrameworkResource("aws_bedrock_inference_profile", name="Inference Profile")
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bedrock;bedrock.GetInferenceProfileOutput")
@@ -350,25 +355,3 @@ type inferenceProfileModelModelSource struct {
 type resourceInferenceProfileModelModel struct {
 	ModelARN types.String `tfsdk:"model_arn"`
 }
-
-var (
-	_ flex.Expander  = inferenceProfileModelModelSource{}
-	_ flex.Flattener = &inferenceProfileModelModelSource{}
-)
-
-func (m inferenceProfileModelModelSource) Expand(ctx context.Context) (any, diag.Diagnostics) {
-	return &awstypes.InferenceProfileModelSourceMemberCopyFrom{
-		Value: flex.StringValueFromFramework(ctx, m.CopyFrom),
-	}, nil
-}
-
-func (m *inferenceProfileModelModelSource) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
-	switch val := v.(type) {
-	case *awstypes.InferenceProfileModelSourceMemberCopyFrom:
-		m.CopyFrom = flex.StringValueToFramework(ctx, val.Value)
-		return diags
-
-	default:
-		return diags
-	}
-}
@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billing
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/billing"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/billing/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameView = "View"
+)
+
+// @FrameworkResource("aws_billing_view", name="View")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newViewResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &viewResource{}, nil
+}
+
+type viewResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *viewResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"billing_view_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BillingViewType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrOwnerAccountID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_views": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfARNType,
+				ElementType: fwtypes.ARNType,
+				Required:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"data_filter_expression": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[viewExpressionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"dimensions": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[viewDimensionValuesModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName(names.AttrTags)),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrKey: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Dimension](),
+										Required:   true,
+									},
+									names.AttrValues: schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtLeast(1),
+										},
+									},
+								},
+							},
+						},
+						names.AttrTags: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[viewTagValuesModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrKey: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrValues: schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *viewResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data viewResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingClient(ctx)
+
+	input := &billing.CreateBillingViewInput{}
+	// The data filter expression has a nested "tags" block, so resource tags cannot be skipped by name.
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, fwflex.WithNoIgnoredFieldNames())...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.ResourceTags = getTagsIn(ctx)
+
+	output, err := conn.CreateBillingView(ctx, input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Billing, create.ErrActionCreating, ResNameView, data.Name.ValueString(), err)
+
+		return
+	}
+
+	arn := aws.ToString(output.Arn)
+	view, err := findViewByARN(ctx, conn, arn)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Billing, create.ErrActionReading, ResNameView, arn, err)
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringValueToFramework(ctx, arn)
+	data.BillingViewType = fwtypes.StringEnumValue(view.BillingViewType)
+	data.OwnerAccountID = fwflex.StringToFramework(ctx, view.OwnerAccountId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *viewResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data viewResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingClient(ctx)
+
+	output, err := findViewByARN(ctx, conn, data.ARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Billing, create.ErrActionReading, ResNameView, data.ARN.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithNoIgnoredFieldNames())...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *viewResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new viewResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		input := &billing.UpdateBillingViewInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, fwflex.WithNoIgnoredFieldNames())...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Removing the filter requires an explicit empty expression.
+		if input.DataFilterExpression == nil {
+			input.DataFilterExpression = &awstypes.Expression{}
+		}
+
+		_, err := conn.UpdateBillingView(ctx, input)
+
+		if err != nil {
+			create.AddError(&response.Diagnostics, names.Billing, create.ErrActionUpdating, ResNameView, new.ARN.ValueString(), err)
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *viewResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data viewResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BillingClient(ctx)
+
+	tflog.Debug(ctx, "deleting Billing View", map[string]any{
+		names.AttrARN: data.ARN.ValueString(),
+	})
+
+	input := &billing.DeleteBillingViewInput{
+		Arn: data.ARN.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteBillingView(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Billing, create.ErrActionDeleting, ResNameView, data.ARN.ValueString(), err)
+
+		return
+	}
+}
+
+func (r *viewResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func findViewByARN(ctx context.Context, conn *billing.Client, arn string) (*awstypes.BillingViewElement, error) {
+	input := &billing.GetBillingViewInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetBillingView(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.BillingView == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.BillingView, nil
+}
+
+type viewResourceModel struct {
+	ARN                  types.String                                         `tfsdk:"arn"`
+	BillingViewType      fwtypes.StringEnum[awstypes.BillingViewType]         `tfsdk:"billing_view_type"`
+	DataFilterExpression fwtypes.ListNestedObjectValueOf[viewExpressionModel] `tfsdk:"data_filter_expression"`
+	Description          types.String                                         `tfsdk:"description"`
+	Name                 types.String                                         `tfsdk:"name"`
+	OwnerAccountID       types.String                                         `tfsdk:"owner_account_id"`
+	SourceViews          fwtypes.ListOfARN                                    `tfsdk:"source_views"`
+	Tags                 tftags.Map                                           `tfsdk:"tags"`
+	TagsAll              tftags.Map                                           `tfsdk:"tags_all"`
+}
+
+type viewExpressionModel struct {
+	Dimensions fwtypes.ListNestedObjectValueOf[viewDimensionValuesModel] `tfsdk:"dimensions"`
+	Tags       fwtypes.ListNestedObjectValueOf[viewTagValuesModel]       `tfsdk:"tags"`
+}
+
+type viewDimensionValuesModel struct {
+	Key    fwtypes.StringEnum[awstypes.Dimension] `tfsdk:"key"`
+	Values fwtypes.ListOfString                   `tfsdk:"values"`
+}
+
+type viewTagValuesModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billing
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/billing/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_billing_view", name="View")
+func newViewDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &viewDataSource{}, nil
+}
+
+type viewDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *viewDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"billing_view_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BillingViewType](),
+				Computed:   true,
+			},
+			"data_filter_expression": framework.DataSourceComputedListOfObjectAttribute[viewExpressionModel](ctx),
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrOwnerAccountID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *viewDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data viewDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().BillingClient(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig(ctx)
+
+	arn := data.ARN.ValueString()
+	output, err := findViewByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Billing View ("+arn+")", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithNoIgnoredFieldNames())...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := listTags(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError("listing tags for Billing View ("+arn+")", err.Error())
+
+		return
+	}
+
+	data.Tags = tftags.FlattenStringValueMap(ctx, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type viewDataSourceModel struct {
+	ARN                  fwtypes.ARN                                          `tfsdk:"arn"`
+	BillingViewType      fwtypes.StringEnum[awstypes.BillingViewType]         `tfsdk:"billing_view_type"`
+	DataFilterExpression fwtypes.ListNestedObjectValueOf[viewExpressionModel] `tfsdk:"data_filter_expression"`
+	Description          types.String                                         `tfsdk:"description"`
+	Name                 types.String                                         `tfsdk:"name"`
+	OwnerAccountID       types.String                                         `tfsdk:"owner_account_id"`
+	Tags                 tftags.Map                                           `tfsdk:"tags"`
+}
@@ -226,6 +226,45 @@ func TestAccCEAnomalySubscription_Subscriber(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalySubscription_thresholdExpressionPercentage(t *testing.T) {
+	ctx := acctest.Context(t)
+	var subscription awstypes.AnomalySubscription
+	resourceName := "aws_ce_anomaly_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckPayerAccount(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig_thresholdExpressionPercentage(rName, address),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.and.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "threshold_expression.0.and.*.dimension.*", map[string]string{
+						names.AttrKey: "ANOMALY_TOTAL_IMPACT_ABSOLUTE",
+						"values.#":    "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "threshold_expression.0.and.*.dimension.*", map[string]string{
+						names.AttrKey: "ANOMALY_TOTAL_IMPACT_PERCENTAGE",
+						"values.#":    "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCEAnomalySubscription_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var subscription awstypes.AnomalySubscription
@@ -580,6 +619,42 @@ resource "aws_ce_anomaly_subscription" "test" {
 `, rName))
 }
 
+func testAccAnomalySubscriptionConfig_thresholdExpressionPercentage(rName, address string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    and {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+        values        = ["100.0"]
+        match_options = ["GREATER_THAN_OR_EQUAL"]
+      }
+    }
+
+    and {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_PERCENTAGE"
+        values        = ["50.0"]
+        match_options = ["GREATER_THAN_OR_EQUAL"]
+      }
+    }
+  }
+}
+`, rName, address))
+}
+
 func testAccAnomalySubscriptionConfig_tags1(rName, address, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
 resource "aws_ce_anomaly_subscription" "test" {
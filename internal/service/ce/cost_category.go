@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
@@ -41,6 +42,8 @@ func resourceCostCategory() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: costCategoryEffectiveStartCustomizeDiff,
+
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				names.AttrARN: {
@@ -57,9 +60,10 @@ func resourceCostCategory() *schema.Resource {
 					Computed: true,
 				},
 				"effective_start": {
-					Type:     schema.TypeString,
-					Optional: true,
-					Computed: true,
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^\d{4}-\d{2}-01T00:00:00Z$`), "must be the first day of a month, e.g. 2024-01-01T00:00:00Z"),
 				},
 				names.AttrName: {
 					Type:         schema.TypeString,
@@ -370,11 +374,17 @@ func resourceCostCategoryUpdate(ctx context.Context, d *schema.ResourceData, met
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
 		input := &costexplorer.UpdateCostCategoryDefinitionInput{
 			CostCategoryArn: aws.String(d.Id()),
-			EffectiveStart:  aws.String(d.Get("effective_start").(string)),
 			Rules:           expandCostCategoryRules(d.Get(names.AttrRule).([]any)),
 			RuleVersion:     awstypes.CostCategoryRuleVersion(d.Get("rule_version").(string)),
 		}
 
+		// Only send the effective start date when it is configured. Otherwise the new
+		// version of the rules takes effect from the start of the current month, rather
+		// than rewriting history from the (possibly no longer permitted) previous start date.
+		if v := d.GetRawConfig().GetAttr("effective_start"); v.IsKnown() && !v.IsNull() {
+			input.EffectiveStart = aws.String(v.AsString())
+		}
+
 		if d.HasChange(names.AttrDefaultValue) {
 			input.DefaultValue = aws.String(d.Get(names.AttrDefaultValue).(string))
 		}
@@ -393,6 +403,24 @@ func resourceCostCategoryUpdate(ctx context.Context, d *schema.ResourceData, met
 	return append(diags, resourceCostCategoryRead(ctx, d, meta)...)
 }
 
+// costCategoryEffectiveStartCustomizeDiff marks effective_start as unknown when a new version of the
+// Cost Category definition will be created without an explicitly configured effective start date.
+func costCategoryEffectiveStartCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if v := d.GetRawConfig().GetAttr("effective_start"); !v.IsKnown() || !v.IsNull() {
+		return nil
+	}
+
+	if d.HasChanges(names.AttrDefaultValue, names.AttrRule, "rule_version", "split_charge_rule") {
+		return d.SetNewComputed("effective_start")
+	}
+
+	return nil
+}
+
 func resourceCostCategoryDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -4,6 +4,8 @@
 package chatbot
 
 const (
+	ResNameCustomAction              = "Custom Action"
+	ResNameCustomActionAssociation   = "Custom Action Association"
 	ResNameSlackChannelConfiguration = "Slack Channel Configuration"
 	ResNameTeamsChannelConfiguration = "Teams Channel Configuration"
 )
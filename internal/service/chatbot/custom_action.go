@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_chatbot_custom_action", name="Custom Action")
+// @Tags(identifierAttribute="custom_action_arn")
+// @Testing(tagsTest=false)
+func newCustomActionResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &customActionResource{}, nil
+}
+
+type customActionResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *customActionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"action_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alias_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"custom_action_arn": framework.ARNAttributeComputedOnly(),
+			names.AttrTags:      tftags.TagsAttribute(),
+			names.AttrTagsAll:   tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"attachments": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customActionAttachmentModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"button_text": schema.StringAttribute{
+							Optional: true,
+						},
+						"notification_type": schema.StringAttribute{
+							Optional: true,
+						},
+						"variables": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"criteria": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customActionAttachmentCriteriaModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"operator": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.CustomActionAttachmentCriteriaOperator](),
+										Required:   true,
+									},
+									names.AttrValue: schema.StringAttribute{
+										Optional: true,
+									},
+									"variable_name": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customActionDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command_text": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *customActionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data customActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	input := &chatbot.CreateCustomActionInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCustomAction(ctx, input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Chatbot, create.ErrActionCreating, ResNameCustomAction, data.ActionName.ValueString(), err)
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CustomActionARN = fwflex.StringToFramework(ctx, output.CustomActionArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customActionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data customActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	output, err := findCustomActionByARN(ctx, conn, data.CustomActionARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Chatbot, create.ErrActionReading, ResNameCustomAction, data.CustomActionARN.ValueString(), err)
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customActionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new customActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		input := &chatbot.UpdateCustomActionInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateCustomAction(ctx, input)
+
+		if err != nil {
+			create.AddError(&response.Diagnostics, names.Chatbot, create.ErrActionUpdating, ResNameCustomAction, new.CustomActionARN.ValueString(), err)
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *customActionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data customActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	tflog.Debug(ctx, "deleting Chatbot Custom Action", map[string]any{
+		"custom_action_arn": data.CustomActionARN.ValueString(),
+	})
+
+	input := &chatbot.DeleteCustomActionInput{
+		CustomActionArn: data.CustomActionARN.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteCustomAction(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Chatbot, create.ErrActionDeleting, ResNameCustomAction, data.CustomActionARN.ValueString(), err)
+
+		return
+	}
+}
+
+func (r *customActionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("custom_action_arn"), request, response)
+}
+
+func findCustomActionByARN(ctx context.Context, conn *chatbot.Client, arn string) (*awstypes.CustomAction, error) {
+	input := &chatbot.GetCustomActionInput{
+		CustomActionArn: aws.String(arn),
+	}
+
+	output, err := conn.GetCustomAction(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CustomAction == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CustomAction, nil
+}
+
+type customActionResourceModel struct {
+	ActionName      types.String                                                 `tfsdk:"action_name"`
+	AliasName       types.String                                                 `tfsdk:"alias_name"`
+	Attachments     fwtypes.ListNestedObjectValueOf[customActionAttachmentModel] `tfsdk:"attachments"`
+	CustomActionARN types.String                                                 `tfsdk:"custom_action_arn"`
+	Definition      fwtypes.ListNestedObjectValueOf[customActionDefinitionModel] `tfsdk:"definition"`
+	Tags            tftags.Map                                                   `tfsdk:"tags"`
+	TagsAll         tftags.Map                                                   `tfsdk:"tags_all"`
+}
+
+type customActionAttachmentModel struct {
+	ButtonText       types.String                                                         `tfsdk:"button_text"`
+	Criteria         fwtypes.ListNestedObjectValueOf[customActionAttachmentCriteriaModel] `tfsdk:"criteria"`
+	NotificationType types.String                                                         `tfsdk:"notification_type"`
+	Variables        fwtypes.MapOfString                                                  `tfsdk:"variables"`
+}
+
+type customActionAttachmentCriteriaModel struct {
+	Operator     fwtypes.StringEnum[awstypes.CustomActionAttachmentCriteriaOperator] `tfsdk:"operator"`
+	Value        types.String                                                        `tfsdk:"value"`
+	VariableName types.String                                                        `tfsdk:"variable_name"`
+}
+
+type customActionDefinitionModel struct {
+	CommandText types.String `tfsdk:"command_text"`
+}
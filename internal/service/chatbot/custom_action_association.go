@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_chatbot_custom_action_association", name="Custom Action Association")
+func newCustomActionAssociationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &customActionAssociationResource{}, nil
+}
+
+const (
+	customActionAssociationIDPartCount = 2
+)
+
+type customActionAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (r *customActionAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"chat_configuration_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_action_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *customActionAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data customActionAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	chatConfigurationARN, customActionARN := data.ChatConfigurationARN.ValueString(), data.CustomActionARN.ValueString()
+	id, _ := intflex.FlattenResourceId([]string{chatConfigurationARN, customActionARN}, customActionAssociationIDPartCount, false)
+	input := &chatbot.AssociateToConfigurationInput{
+		ChatConfiguration: aws.String(chatConfigurationARN),
+		Resource:          aws.String(customActionARN),
+	}
+
+	_, err := conn.AssociateToConfiguration(ctx, input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Chatbot, create.ErrActionCreating, ResNameCustomActionAssociation, id, err)
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customActionAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data customActionAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), customActionAssociationIDPartCount, false)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Chatbot, create.ErrActionExpandingResourceId, ResNameCustomActionAssociation, data.ID.ValueString(), err)
+
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	chatConfigurationARN, customActionARN := parts[0], parts[1]
+	_, err = findCustomActionAssociationByTwoPartKey(ctx, conn, chatConfigurationARN, customActionARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Chatbot, create.ErrActionReading, ResNameCustomActionAssociation, data.ID.ValueString(), err)
+
+		return
+	}
+
+	// Set attributes for import.
+	data.ChatConfigurationARN = fwtypes.ARNValue(chatConfigurationARN)
+	data.CustomActionARN = fwtypes.ARNValue(customActionARN)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customActionAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data customActionAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	input := &chatbot.DisassociateFromConfigurationInput{
+		ChatConfiguration: fwflex.StringFromFramework(ctx, data.ChatConfigurationARN),
+		Resource:          fwflex.StringFromFramework(ctx, data.CustomActionARN),
+	}
+
+	_, err := conn.DisassociateFromConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Chatbot, create.ErrActionDeleting, ResNameCustomActionAssociation, data.ID.ValueString(), err)
+
+		return
+	}
+}
+
+func findCustomActionAssociationByTwoPartKey(ctx context.Context, conn *chatbot.Client, chatConfigurationARN, customActionARN string) (*awstypes.AssociationListing, error) {
+	input := &chatbot.ListAssociationsInput{
+		ChatConfiguration: aws.String(chatConfigurationARN),
+	}
+
+	return findAssociation(ctx, conn, input, func(v *awstypes.AssociationListing) bool {
+		return aws.ToString(v.Resource) == customActionARN
+	})
+}
+
+func findAssociation(ctx context.Context, conn *chatbot.Client, input *chatbot.ListAssociationsInput, filter tfslices.Predicate[*awstypes.AssociationListing]) (*awstypes.AssociationListing, error) {
+	output, err := findAssociations(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findAssociations(ctx context.Context, conn *chatbot.Client, input *chatbot.ListAssociationsInput, filter tfslices.Predicate[*awstypes.AssociationListing]) ([]awstypes.AssociationListing, error) {
+	var output []awstypes.AssociationListing
+
+	pages := chatbot.NewListAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Associations {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type customActionAssociationResourceModel struct {
+	ChatConfigurationARN fwtypes.ARN  `tfsdk:"chat_configuration_arn"`
+	CustomActionARN      fwtypes.ARN  `tfsdk:"custom_action_arn"`
+	ID                   types.String `tfsdk:"id"`
+}
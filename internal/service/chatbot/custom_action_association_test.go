@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfchatbot "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChatbotCustomActionAssociation_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic:      testAccCustomActionAssociation_basic,
+		acctest.CtDisappears: testAccCustomActionAssociation_disappears,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccCustomActionAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_custom_action_association.test"
+
+	// The slack workspace must be created via the AWS Console. It cannot be created via APIs or Terraform.
+	// Once it is created, export the name of the workspace in the env variable for this test
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomActionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomActionAssociationConfig_basic(rName, channelID, teamID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomActionAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "chat_configuration_arn", testResourceSlackChannelConfiguration, "chat_configuration_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "custom_action_arn", "aws_chatbot_custom_action.test", "custom_action_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCustomActionAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_custom_action_association.test"
+
+	// The slack workspace must be created via the AWS Console. It cannot be created via APIs or Terraform.
+	// Once it is created, export the name of the workspace in the env variable for this test
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomActionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomActionAssociationConfig_basic(rName, channelID, teamID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomActionAssociationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfchatbot.ResourceCustomActionAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomActionAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chatbot_custom_action_association" {
+				continue
+			}
+
+			_, err := tfchatbot.FindCustomActionAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["chat_configuration_arn"], rs.Primary.Attributes["custom_action_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Chatbot, create.ErrActionCheckingDestroyed, tfchatbot.ResNameCustomActionAssociation, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomActionAssociationExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Chatbot, create.ErrActionCheckingExistence, tfchatbot.ResNameCustomActionAssociation, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		_, err := tfchatbot.FindCustomActionAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["chat_configuration_arn"], rs.Primary.Attributes["custom_action_arn"])
+
+		return err
+	}
+}
+
+func testAccCustomActionAssociationConfig_basic(rName, channelID, teamID string) string {
+	return acctest.ConfigCompose(testAccSlackChannelConfigurationConfig_basic(rName, channelID, teamID), fmt.Sprintf(`
+resource "aws_chatbot_custom_action" "test" {
+  action_name = %[1]q
+
+  definition {
+    command_text = "sts get-caller-identity"
+  }
+}
+
+resource "aws_chatbot_custom_action_association" "test" {
+  chat_configuration_arn = aws_chatbot_slack_channel_configuration.test.chat_configuration_arn
+  custom_action_arn      = aws_chatbot_custom_action.test.custom_action_arn
+}
+`, sdkacctest.RandString(20)))
+}
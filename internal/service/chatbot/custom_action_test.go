@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfchatbot "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChatbotCustomAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.CustomAction
+	rName := sdkacctest.RandString(20)
+	resourceName := "aws_chatbot_custom_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomActionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomActionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action_name", rName),
+					resource.TestCheckNoResourceAttr(resourceName, "alias_name"),
+					resource.TestCheckResourceAttr(resourceName, "attachments.#", "0"),
+					acctest.MatchResourceAttrGlobalARN(ctx, resourceName, "custom_action_arn", "chatbot", regexache.MustCompile(`custom-action/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.command_text", "sts get-caller-identity"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "custom_action_arn"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "custom_action_arn",
+			},
+		},
+	})
+}
+
+func TestAccChatbotCustomAction_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.CustomAction
+	rName := sdkacctest.RandString(20)
+	resourceName := "aws_chatbot_custom_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomActionExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfchatbot.ResourceCustomAction, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccChatbotCustomAction_attachments(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.CustomAction
+	rName := sdkacctest.RandString(20)
+	resourceName := "aws_chatbot_custom_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomActionConfig_attachments(rName, "Describe instance"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomActionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alias_name", "describe"),
+					resource.TestCheckResourceAttr(resourceName, "attachments.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attachments.0.button_text", "Describe instance"),
+					resource.TestCheckResourceAttr(resourceName, "attachments.0.criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attachments.0.criteria.0.operator", "HAS_VALUE"),
+					resource.TestCheckResourceAttr(resourceName, "attachments.0.criteria.0.variable_name", "InstanceId"),
+					resource.TestCheckResourceAttr(resourceName, "attachments.0.notification_type", "CloudWatch"),
+					resource.TestCheckResourceAttr(resourceName, "attachments.0.variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attachments.0.variables.InstanceId", "event.detail.instance-id"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.command_text", "ec2 describe-instances --instance-ids $InstanceId"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "custom_action_arn"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "custom_action_arn",
+			},
+			{
+				Config: testAccCustomActionConfig_attachments(rName, "Show instance"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomActionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "attachments.0.button_text", "Show instance"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomActionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chatbot_custom_action" {
+				continue
+			}
+
+			_, err := tfchatbot.FindCustomActionByARN(ctx, conn, rs.Primary.Attributes["custom_action_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Chatbot, create.ErrActionCheckingDestroyed, tfchatbot.ResNameCustomAction, rs.Primary.Attributes["custom_action_arn"], errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomActionExists(ctx context.Context, name string, v *types.CustomAction) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Chatbot, create.ErrActionCheckingExistence, tfchatbot.ResNameCustomAction, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		output, err := tfchatbot.FindCustomActionByARN(ctx, conn, rs.Primary.Attributes["custom_action_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCustomActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_chatbot_custom_action" "test" {
+  action_name = %[1]q
+
+  definition {
+    command_text = "sts get-caller-identity"
+  }
+}
+`, rName)
+}
+
+func testAccCustomActionConfig_attachments(rName, buttonText string) string {
+	return fmt.Sprintf(`
+resource "aws_chatbot_custom_action" "test" {
+  action_name = %[1]q
+  alias_name  = "describe"
+
+  definition {
+    command_text = "ec2 describe-instances --instance-ids $InstanceId"
+  }
+
+  attachments {
+    button_text       = %[2]q
+    notification_type = "CloudWatch"
+
+    variables = {
+      InstanceId = "event.detail.instance-id"
+    }
+
+    criteria {
+      operator      = "HAS_VALUE"
+      variable_name = "InstanceId"
+    }
+  }
+}
+`, rName, buttonText)
+}
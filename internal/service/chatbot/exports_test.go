@@ -5,9 +5,13 @@ package chatbot
 
 // Exports for use in tests only.
 var (
+	ResourceCustomAction              = newCustomActionResource
+	ResourceCustomActionAssociation   = newCustomActionAssociationResource
 	ResourceSlackChannelConfiguration = newSlackChannelConfigurationResource
 	ResourceTeamsChannelConfiguration = newTeamsChannelConfigurationResource
 
-	FindSlackChannelConfigurationByARN    = findSlackChannelConfigurationByARN
-	FindTeamsChannelConfigurationByTeamID = findTeamsChannelConfigurationByTeamID
+	FindCustomActionAssociationByTwoPartKey = findCustomActionAssociationByTwoPartKey
+	FindCustomActionByARN                   = findCustomActionByARN
+	FindSlackChannelConfigurationByARN      = findSlackChannelConfigurationByARN
+	FindTeamsChannelConfigurationByTeamID   = findTeamsChannelConfigurationByTeamID
 )
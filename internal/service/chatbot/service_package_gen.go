@@ -26,6 +26,19 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newCustomActionResource,
+			TypeName: "aws_chatbot_custom_action",
+			Name:     "Custom Action",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "custom_action_arn",
+			},
+		},
+		{
+			Factory:  newCustomActionAssociationResource,
+			TypeName: "aws_chatbot_custom_action_association",
+			Name:     "Custom Action Association",
+		},
 		{
 			Factory:  newSlackChannelConfigurationResource,
 			TypeName: "aws_chatbot_slack_channel_configuration",
@@ -9,10 +9,12 @@ var (
 	ResourceStackSet         = resourceStackSet
 	ResourceStackSetInstance = resourceStackSetInstance
 	ResourceStackInstances   = resourceStackInstances
+	ResourceStackRefactor    = newStackRefactorResource
 	ResourceType             = resourceType
 
 	FindStackInstanceByFourPartKey          = findStackInstanceByFourPartKey
 	FindStackInstanceSummariesByFourPartKey = findStackInstanceSummariesByFourPartKey
+	FindStackRefactorByID                   = findStackRefactorByID
 	FindStackSetByName                      = findStackSetByName
 	FindTypeByARN                           = findTypeByARN
 	FindStackInstancesByNameCallAs          = findStackInstancesByNameCallAs
@@ -19,7 +19,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newStackRefactorResource,
+			TypeName: "aws_cloudformation_stack_refactor",
+			Name:     "Stack Refactor",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -35,6 +41,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Stack",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceStackSetInstances,
+			TypeName: "aws_cloudformation_stack_set_instances",
+			Name:     "Stack Set Instances",
+		},
 		{
 			Factory:  dataSourceType,
 			TypeName: "aws_cloudformation_type",
@@ -0,0 +1,390 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cloudformation_stack_refactor", name="Stack Refactor")
+func newStackRefactorResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &stackRefactorResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type stackRefactorResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *stackRefactorResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	resourceLocationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[resourceLocationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"logical_resource_id": schema.StringAttribute{
+					Required: true,
+				},
+				"stack_name": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enable_stack_creation": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"execution_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.StackRefactorExecutionStatus](),
+				Computed:   true,
+			},
+			"execution_status_reason": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"stack_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.StackRefactorStatus](),
+				Computed:   true,
+			},
+			names.AttrStatusReason: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_mapping": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[resourceMappingModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrDestination: resourceLocationBlock,
+						names.AttrSource:      resourceLocationBlock,
+					},
+				},
+			},
+			"stack_definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[stackDefinitionModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"stack_name": schema.StringAttribute{
+							Required: true,
+						},
+						"template_body": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("template_url")),
+							},
+						},
+						"template_url": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *stackRefactorResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data stackRefactorResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFormationClient(ctx)
+
+	var input cloudformation.CreateStackRefactorInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateStackRefactor(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating CloudFormation Stack Refactor", err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.StackRefactorId)
+	data.ID = types.StringValue(id)
+
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	if _, err := waitStackRefactorCreated(ctx, conn, id, timeout); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFormation Stack Refactor (%s) create", id), err.Error())
+
+		return
+	}
+
+	executeInput := cloudformation.ExecuteStackRefactorInput{
+		StackRefactorId: aws.String(id),
+	}
+	_, err = conn.ExecuteStackRefactor(ctx, &executeInput)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("executing CloudFormation Stack Refactor (%s)", id), err.Error())
+
+		return
+	}
+
+	refactor, err := waitStackRefactorExecuted(ctx, conn, id, timeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFormation Stack Refactor (%s) execute", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, refactor, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *stackRefactorResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data stackRefactorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFormationClient(ctx)
+
+	output, err := findStackRefactorByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFormation Stack Refactor (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The stack definitions and resource mappings are not returned by the API.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *stackRefactorResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data stackRefactorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// An executed stack refactor cannot be undone. The refactored resources remain in their destination stacks.
+	response.Diagnostics.AddWarning(
+		"CloudFormation Stack Refactor removed from state",
+		fmt.Sprintf("Stack refactor %s has been removed from Terraform state. Resources moved by the refactor remain in their destination stacks.", data.ID.ValueString()),
+	)
+}
+
+func findStackRefactorByID(ctx context.Context, conn *cloudformation.Client, id string) (*cloudformation.DescribeStackRefactorOutput, error) {
+	input := cloudformation.DescribeStackRefactorInput{
+		StackRefactorId: aws.String(id),
+	}
+
+	output, err := conn.DescribeStackRefactor(ctx, &input)
+
+	if errs.IsA[*awstypes.StackRefactorNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusStackRefactor(ctx context.Context, conn *cloudformation.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findStackRefactorByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusStackRefactorExecution(ctx context.Context, conn *cloudformation.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findStackRefactorByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ExecutionStatus), nil
+	}
+}
+
+func waitStackRefactorCreated(ctx context.Context, conn *cloudformation.Client, id string, timeout time.Duration) (*cloudformation.DescribeStackRefactorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StackRefactorStatusCreateInProgress),
+		Target:  enum.Slice(awstypes.StackRefactorStatusCreateComplete),
+		Refresh: statusStackRefactor(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudformation.DescribeStackRefactorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitStackRefactorExecuted(ctx context.Context, conn *cloudformation.Client, id string, timeout time.Duration) (*cloudformation.DescribeStackRefactorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StackRefactorExecutionStatusAvailable, awstypes.StackRefactorExecutionStatusExecuteInProgress),
+		Target:  enum.Slice(awstypes.StackRefactorExecutionStatusExecuteComplete),
+		Refresh: statusStackRefactorExecution(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudformation.DescribeStackRefactorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ExecutionStatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type stackRefactorResourceModel struct {
+	Description           types.String                                              `tfsdk:"description"`
+	EnableStackCreation   types.Bool                                                `tfsdk:"enable_stack_creation"`
+	ExecutionStatus       fwtypes.StringEnum[awstypes.StackRefactorExecutionStatus] `tfsdk:"execution_status"`
+	ExecutionStatusReason types.String                                              `tfsdk:"execution_status_reason"`
+	ID                    types.String                                              `tfsdk:"id"`
+	ResourceMappings      fwtypes.ListNestedObjectValueOf[resourceMappingModel]     `tfsdk:"resource_mapping"`
+	StackDefinitions      fwtypes.ListNestedObjectValueOf[stackDefinitionModel]     `tfsdk:"stack_definition"`
+	StackIDs              fwtypes.ListValueOf[types.String]                         `tfsdk:"stack_ids"`
+	Status                fwtypes.StringEnum[awstypes.StackRefactorStatus]          `tfsdk:"status"`
+	StatusReason          types.String                                              `tfsdk:"status_reason"`
+	Timeouts              timeouts.Value                                            `tfsdk:"timeouts"`
+}
+
+type resourceMappingModel struct {
+	Destination fwtypes.ListNestedObjectValueOf[resourceLocationModel] `tfsdk:"destination"`
+	Source      fwtypes.ListNestedObjectValueOf[resourceLocationModel] `tfsdk:"source"`
+}
+
+type resourceLocationModel struct {
+	LogicalResourceID types.String `tfsdk:"logical_resource_id"`
+	StackName         types.String `tfsdk:"stack_name"`
+}
+
+type stackDefinitionModel struct {
+	StackName    types.String `tfsdk:"stack_name"`
+	TemplateBody types.String `tfsdk:"template_body"`
+	TemplateURL  types.String `tfsdk:"template_url"`
+}
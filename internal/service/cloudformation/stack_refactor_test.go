@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFormationStackRefactor_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_refactor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackRefactorConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackRefactorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+					resource.TestCheckResourceAttr(resourceName, "execution_status", string(awstypes.StackRefactorExecutionStatusExecuteComplete)),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stack_definition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "stack_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.StackRefactorStatusCreateComplete)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"enable_stack_creation", "resource_mapping", "stack_definition", names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccCheckStackRefactorExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationClient(ctx)
+
+		_, err := tfcloudformation.FindStackRefactorByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccStackRefactorConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "source" {
+  name = "%[1]s-source"
+
+  template_body = jsonencode({
+    Resources = {
+      Queue = {
+        Type = "AWS::SQS::Queue"
+      }
+      Topic = {
+        Type = "AWS::SNS::Topic"
+      }
+    }
+  })
+
+  lifecycle {
+    ignore_changes = [template_body]
+  }
+}
+
+resource "aws_cloudformation_stack" "destination" {
+  name = "%[1]s-destination"
+
+  template_body = jsonencode({
+    Resources = {
+      Bucket = {
+        Type = "AWS::S3::Bucket"
+      }
+    }
+  })
+
+  lifecycle {
+    ignore_changes = [template_body]
+  }
+}
+
+resource "aws_cloudformation_stack_refactor" "test" {
+  description = %[1]q
+
+  stack_definition {
+    stack_name = aws_cloudformation_stack.source.name
+
+    template_body = jsonencode({
+      Resources = {
+        Queue = {
+          Type = "AWS::SQS::Queue"
+        }
+      }
+    })
+  }
+
+  stack_definition {
+    stack_name = aws_cloudformation_stack.destination.name
+
+    template_body = jsonencode({
+      Resources = {
+        Bucket = {
+          Type = "AWS::S3::Bucket"
+        }
+        MovedTopic = {
+          Type = "AWS::SNS::Topic"
+        }
+      }
+    })
+  }
+
+  resource_mapping {
+    source {
+      stack_name          = aws_cloudformation_stack.source.name
+      logical_resource_id = "Topic"
+    }
+
+    destination {
+      stack_name          = aws_cloudformation_stack.destination.name
+      logical_resource_id = "MovedTopic"
+    }
+  }
+}
+`, rName)
+}
@@ -47,6 +47,7 @@ func resourceStackSet() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -163,6 +164,11 @@ func resourceStackSet() *schema.Resource {
 							ValidateFunc:  validation.IntBetween(1, 100),
 							ConflictsWith: []string{"operation_preferences.0.max_concurrent_count"},
 						},
+						"concurrency_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ConcurrencyMode](),
+						},
 						"region_concurrency_type": {
 							Type:             schema.TypeString,
 							Optional:         true,
@@ -413,13 +419,16 @@ func resourceStackSetUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		input.AutoDeployment = expandAutoDeployment(v.([]any))
 	}
 
-	output, err := conn.UpdateStackSet(ctx, input)
+	// Retry while another operation, such as an automatic deployment, is active on the StackSet.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.OperationInProgressException](ctx, d.Timeout(schema.TimeoutUpdate), func() (any, error) {
+		return conn.UpdateStackSet(ctx, input)
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating CloudFormation StackSet (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitStackSetOperationSucceeded(ctx, conn, d.Id(), aws.ToString(output.OperationId), callAs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if _, err := waitStackSetOperationSucceeded(ctx, conn, d.Id(), aws.ToString(outputRaw.(*cloudformation.UpdateStackSetOutput).OperationId), callAs, d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for CloudFormation StackSet (%s) update: %s", d.Id(), err)
 	}
 
@@ -439,7 +448,9 @@ func resourceStackSetDelete(ctx context.Context, d *schema.ResourceData, meta an
 	}
 
 	log.Printf("[DEBUG] Deleting CloudFormation StackSet: %s", d.Id())
-	_, err := conn.DeleteStackSet(ctx, input)
+	_, err := tfresource.RetryWhenIsA[*awstypes.OperationInProgressException](ctx, d.Timeout(schema.TimeoutDelete), func() (any, error) {
+		return conn.DeleteStackSet(ctx, input)
+	})
 
 	if errs.IsA[*awstypes.StackSetNotFoundException](err) {
 		return diags
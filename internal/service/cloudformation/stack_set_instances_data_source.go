@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudformation_stack_set_instances", name="Stack Set Instances")
+func dataSourceStackSetInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStackSetInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"call_as": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.CallAs](),
+			},
+			"drift_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.StackDriftStatus](),
+			},
+			"stack_instance_account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"stack_instance_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"stack_instance_summaries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAccountID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"detailed_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"drift_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organizational_unit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stack_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stack_set_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatusReason: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"stack_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceStackSetInstancesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	stackSetName := d.Get("stack_set_name").(string)
+	input := &cloudformation.ListStackInstancesInput{
+		StackSetName: aws.String(stackSetName),
+	}
+
+	if v, ok := d.GetOk("call_as"); ok {
+		input.CallAs = awstypes.CallAs(v.(string))
+	}
+
+	if v, ok := d.GetOk("drift_status"); ok {
+		input.Filters = []awstypes.StackInstanceFilter{
+			{
+				Name:   awstypes.StackInstanceFilterNameDriftStatus,
+				Values: aws.String(v.(string)),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("stack_instance_account"); ok {
+		input.StackInstanceAccount = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("stack_instance_region"); ok {
+		input.StackInstanceRegion = aws.String(v.(string))
+	}
+
+	summaries, err := findStackInstanceSummaries(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudFormation StackSet (%s) instances: %s", stackSetName, err)
+	}
+
+	d.SetId(stackSetName)
+	if err := d.Set("stack_instance_summaries", flattenStackInstancesSummaries(summaries)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting stack_instance_summaries: %s", err)
+	}
+
+	return diags
+}
+
+func findStackInstanceSummaries(ctx context.Context, conn *cloudformation.Client, input *cloudformation.ListStackInstancesInput) ([]awstypes.StackInstanceSummary, error) {
+	var output []awstypes.StackInstanceSummary
+
+	pages := cloudformation.NewListStackInstancesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.StackSetNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Summaries...)
+	}
+
+	return output, nil
+}
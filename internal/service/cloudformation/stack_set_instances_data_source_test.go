@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFormationStackSetInstancesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudformation_stack_set_instances.test"
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckStackSet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetInstancesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "stack_instance_summaries.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stack_instance_summaries.0.account_id", resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "stack_instance_summaries.0.drift_status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stack_instance_summaries.0.region", resourceName, names.AttrRegion),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stack_instance_summaries.0.stack_id", resourceName, "stack_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stack_set_name", resourceName, "stack_set_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStackSetInstancesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceConfig_basic(rName), `
+data "aws_cloudformation_stack_set_instances" "test" {
+  stack_set_name        = aws_cloudformation_stack_set_instance.test.stack_set_name
+  stack_instance_region = aws_cloudformation_stack_set_instance.test.region
+}
+`)
+}
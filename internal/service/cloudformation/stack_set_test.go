@@ -416,6 +416,40 @@ func TestAccCloudFormationStackSet_operationPreferences(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStackSet_operationPreferencesConcurrencyMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stackSet awstypes.StackSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckStackSet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetConfig_operationPreferencesConcurrencyMode(rName, string(awstypes.ConcurrencyModeSoftFailureTolerance)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(ctx, resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.concurrency_mode", string(awstypes.ConcurrencyModeSoftFailureTolerance)),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.failure_tolerance_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.max_concurrent_count", "10"),
+				),
+			},
+			{
+				Config: testAccStackSetConfig_operationPreferencesConcurrencyMode(rName, string(awstypes.ConcurrencyModeStrictFailureTolerance)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(ctx, resourceName, &stackSet),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.concurrency_mode", string(awstypes.ConcurrencyModeStrictFailureTolerance)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudFormationStackSet_parameters(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stackSet1, stackSet2 awstypes.StackSet
@@ -1466,6 +1500,25 @@ TEMPLATE
 `, rName, failureTolerancePercentage, maxConcurrentPercentage, testAccStackSetTemplateBodyVPC(rName)))
 }
 
+func testAccStackSetConfig_operationPreferencesConcurrencyMode(rName, concurrencyMode string) string {
+	return acctest.ConfigCompose(testAccStackSetConfig_baseAdministrationRoleARNs(rName, 1), fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  administration_role_arn = aws_iam_role.test[0].arn
+  name                    = %[1]q
+
+  operation_preferences {
+    concurrency_mode        = %[2]q
+    failure_tolerance_count = 1
+    max_concurrent_count    = 10
+  }
+
+  template_body = <<TEMPLATE
+%[3]s
+TEMPLATE
+}
+`, rName, concurrencyMode, testAccStackSetTemplateBodyVPC(rName)))
+}
+
 func testAccStackSetConfig_autoDeployment(rName string, enabled, retainStacksOnAccountRemoval bool) string {
 	return fmt.Sprintf(`
 resource "aws_cloudformation_stack_set" "test" {
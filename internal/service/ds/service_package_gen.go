@@ -20,6 +20,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newSettingsResource,
+			TypeName: "aws_directory_service_settings",
+			Name:     "Settings",
+		},
 		{
 			Factory:  newTrustResource,
 			TypeName: "aws_directory_service_trust",
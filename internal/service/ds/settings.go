@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_directory_service_settings", name="Settings")
+func newSettingsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &settingsResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type settingsResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	// Settings are not reset to their defaults on resource deletion; there's no API to restore a default.
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *settingsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"directory_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"setting": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[settingModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *settingsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data settingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSClient(ctx)
+
+	var settings []awstypes.Setting
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.Settings, &settings)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	directoryID := data.DirectoryID.ValueString()
+	if err := updateSettings(ctx, conn, directoryID, settings, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Directory Service Directory (%s) Settings", directoryID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(directoryID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *settingsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data settingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSClient(ctx)
+
+	entries, err := findSettingsByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Directory Service Directory (%s) Settings", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Only settings managed by this resource are read back. On import, every setting that has been changed from its default is.
+	var managed []awstypes.Setting
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.Settings, &managed)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	managedNames := make(map[string]bool, len(managed))
+	for _, v := range managed {
+		managedNames[aws.ToString(v.Name)] = true
+	}
+
+	var settings []awstypes.Setting
+	for _, entry := range entries {
+		name := aws.ToString(entry.Name)
+
+		if len(managedNames) > 0 && !managedNames[name] {
+			continue
+		}
+		if len(managedNames) == 0 && entry.RequestStatus == awstypes.DirectoryConfigurationStatusDefault {
+			continue
+		}
+
+		value := entry.AppliedValue
+		if aws.ToString(value) == "" {
+			value = entry.RequestedValue
+		}
+
+		settings = append(settings, awstypes.Setting{
+			Name:  entry.Name,
+			Value: value,
+		})
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, settings, &data.Settings)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.DirectoryID = data.ID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *settingsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new settingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSClient(ctx)
+
+	if !new.Settings.Equal(old.Settings) {
+		var os, ns []awstypes.Setting
+		response.Diagnostics.Append(fwflex.Expand(ctx, old.Settings, &os)...)
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.Settings, &ns)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Settings removed from the configuration keep their current values; there's no API to restore a default.
+		oldValues := make(map[string]string, len(os))
+		for _, v := range os {
+			oldValues[aws.ToString(v.Name)] = aws.ToString(v.Value)
+		}
+		settings := tfslices.Filter(ns, func(v awstypes.Setting) bool {
+			value, ok := oldValues[aws.ToString(v.Name)]
+			return !ok || value != aws.ToString(v.Value)
+		})
+
+		if len(settings) > 0 {
+			if err := updateSettings(ctx, conn, new.ID.ValueString(), settings, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Directory Service Directory (%s) Settings", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func updateSettings(ctx context.Context, conn *directoryservice.Client, directoryID string, settings []awstypes.Setting, timeout time.Duration) error {
+	input := &directoryservice.UpdateSettingsInput{
+		DirectoryId: aws.String(directoryID),
+		Settings:    settings,
+	}
+
+	_, err := conn.UpdateSettings(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	settingNames := make([]string, 0, len(settings))
+	for _, v := range settings {
+		settingNames = append(settingNames, aws.ToString(v.Name))
+	}
+
+	if _, err := waitSettingsUpdated(ctx, conn, directoryID, settingNames, timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return nil
+}
+
+func findSettingsByID(ctx context.Context, conn *directoryservice.Client, directoryID string) ([]awstypes.SettingEntry, error) {
+	input := &directoryservice.DescribeSettingsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+	var output []awstypes.SettingEntry
+
+	for {
+		page, err := conn.DescribeSettings(ctx, input)
+
+		if errs.IsA[*awstypes.DirectoryDoesNotExistException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SettingEntries...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+// statusSettings returns the least advanced request status of the named settings.
+func statusSettings(ctx context.Context, conn *directoryservice.Client, directoryID string, settingNames []string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findSettingsByID(ctx, conn, directoryID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		entries := make(map[string]awstypes.SettingEntry, len(output))
+		for _, v := range output {
+			entries[aws.ToString(v.Name)] = v
+		}
+
+		status := awstypes.DirectoryConfigurationStatusUpdated
+		for _, name := range settingNames {
+			entry, ok := entries[name]
+			if !ok {
+				return nil, "", fmt.Errorf("setting %s not found", name)
+			}
+
+			switch entry.RequestStatus {
+			case awstypes.DirectoryConfigurationStatusFailed:
+				return output, string(entry.RequestStatus), fmt.Errorf("setting %s: %s", name, aws.ToString(entry.RequestStatusMessage))
+			case awstypes.DirectoryConfigurationStatusRequested, awstypes.DirectoryConfigurationStatusUpdating:
+				status = entry.RequestStatus
+			}
+		}
+
+		return output, string(status), nil
+	}
+}
+
+func waitSettingsUpdated(ctx context.Context, conn *directoryservice.Client, directoryID string, settingNames []string, timeout time.Duration) ([]awstypes.SettingEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DirectoryConfigurationStatusRequested, awstypes.DirectoryConfigurationStatusUpdating),
+		Target:  enum.Slice(awstypes.DirectoryConfigurationStatusUpdated),
+		Refresh: statusSettings(ctx, conn, directoryID, settingNames),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]awstypes.SettingEntry); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type settingsResourceModel struct {
+	DirectoryID types.String                                 `tfsdk:"directory_id"`
+	ID          types.String                                 `tfsdk:"id"`
+	Settings    fwtypes.SetNestedObjectValueOf[settingModel] `tfsdk:"setting"`
+	Timeouts    timeouts.Value                               `tfsdk:"timeouts"`
+}
+
+type settingModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
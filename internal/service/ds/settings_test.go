@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_directory_service_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSettingsConfig_basic(rName, domainName, "Disable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSettingValue(ctx, resourceName, "TLS_1_0", "Disable"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "setting.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						names.AttrName:  "TLS_1_0",
+						names.AttrValue: "Disable",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSettingsConfig_basic(rName, domainName, "Enable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSettingValue(ctx, resourceName, "TLS_1_0", "Enable"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						names.AttrName:  "TLS_1_0",
+						names.AttrValue: "Enable",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSettingValue(ctx context.Context, n, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSClient(ctx)
+
+		output, err := tfds.FindSettingsByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output {
+			if aws.ToString(v.Name) != name {
+				continue
+			}
+
+			if v.RequestStatus != awstypes.DirectoryConfigurationStatusUpdated {
+				return fmt.Errorf("Directory Service Directory (%s) setting %s status: %s", rs.Primary.ID, name, v.RequestStatus)
+			}
+
+			if got := aws.ToString(v.AppliedValue); got != value {
+				return fmt.Errorf("Directory Service Directory (%s) setting %s = %s, want %s", rs.Primary.ID, name, got, value)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Directory Service Directory (%s) setting %s not found", rs.Primary.ID, name)
+	}
+}
+
+func testAccSettingsConfig_basic(rName, domain, value string) string {
+	return acctest.ConfigCompose(testAccDirectoryConfig_microsoft(rName, domain), fmt.Sprintf(`
+resource "aws_directory_service_settings" "test" {
+  directory_id = aws_directory_service_directory.test.id
+
+  setting {
+    name  = "TLS_1_0"
+    value = %[1]q
+  }
+}
+`, value))
+}
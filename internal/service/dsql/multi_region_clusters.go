@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/dsql"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dsql/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	multiRegionClustersIDSeparator = ","
+)
+
+// @FrameworkResource("aws_dsql_multi_region_clusters", name="Multi-Region Clusters")
+func newMultiRegionClustersResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &multiRegionClustersResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type multiRegionClustersResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *multiRegionClustersResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"deletion_protection_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"linked_cluster_arns": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"linked_region_list": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(2),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"witness_region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *multiRegionClustersResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data multiRegionClustersResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	var input dsql.CreateMultiRegionClustersInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	if !data.DeletionProtectionEnabled.IsUnknown() && !data.DeletionProtectionEnabled.IsNull() {
+		input.ClusterProperties = make(map[string]awstypes.LinkedClusterProperties)
+		for _, region := range input.LinkedRegionList {
+			input.ClusterProperties[region] = awstypes.LinkedClusterProperties{
+				DeletionProtectionEnabled: fwflex.BoolFromFramework(ctx, data.DeletionProtectionEnabled),
+			}
+		}
+	}
+
+	output, err := conn.CreateMultiRegionClusters(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating DSQL Multi-Region Clusters", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	arns := output.LinkedClusterArns
+	data.ID = fwflex.StringValueToFramework(ctx, strings.Join(arns, multiRegionClustersIDSeparator))
+	data.LinkedClusterARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, arns)
+
+	for _, v := range arns {
+		id, region, err := clusterIDAndRegionFromARN(v)
+
+		if err != nil {
+			response.Diagnostics.AddError("creating DSQL Multi-Region Clusters", err.Error())
+
+			return
+		}
+
+		cluster, err := waitClusterCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts), withRegion(region))
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for DSQL Cluster (%s) create", v), err.Error())
+
+			return
+		}
+
+		data.DeletionProtectionEnabled = fwflex.BoolToFramework(ctx, cluster.DeletionProtectionEnabled)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *multiRegionClustersResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data multiRegionClustersResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	arns := strings.Split(data.ID.ValueString(), multiRegionClustersIDSeparator)
+	var regions []string
+	for _, v := range arns {
+		id, region, err := clusterIDAndRegionFromARN(v)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DSQL Multi-Region Clusters (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findClusterByID(ctx, conn, id, withRegion(region))
+
+		// Any missing linked cluster means the multi-Region clusters must be recreated.
+		if tfresource.NotFound(err) {
+			response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+			response.State.RemoveResource(ctx)
+
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DSQL Cluster (%s)", v), err.Error())
+
+			return
+		}
+
+		data.DeletionProtectionEnabled = fwflex.BoolToFramework(ctx, output.DeletionProtectionEnabled)
+		data.WitnessRegion = fwflex.StringToFramework(ctx, output.WitnessRegion)
+		regions = append(regions, region)
+	}
+
+	data.LinkedClusterARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, arns)
+	data.LinkedRegionList = fwflex.FlattenFrameworkStringValueListOfString(ctx, regions)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *multiRegionClustersResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new multiRegionClustersResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	if !new.DeletionProtectionEnabled.Equal(old.DeletionProtectionEnabled) {
+		for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, new.LinkedClusterARNs) {
+			id, region, err := clusterIDAndRegionFromARN(v)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating DSQL Cluster (%s)", v), err.Error())
+
+				return
+			}
+
+			input := dsql.UpdateClusterInput{
+				ClientToken:               aws.String(sdkid.UniqueId()),
+				DeletionProtectionEnabled: fwflex.BoolFromFramework(ctx, new.DeletionProtectionEnabled),
+				Identifier:                aws.String(id),
+			}
+
+			_, err = conn.UpdateCluster(ctx, &input, withRegion(region))
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating DSQL Cluster (%s)", v), err.Error())
+
+				return
+			}
+
+			if _, err := waitClusterUpdated(ctx, conn, id, r.UpdateTimeout(ctx, new.Timeouts), withRegion(region)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for DSQL Cluster (%s) update", v), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *multiRegionClustersResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data multiRegionClustersResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	arns := fwflex.ExpandFrameworkStringValueList(ctx, data.LinkedClusterARNs)
+	input := dsql.DeleteMultiRegionClustersInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		LinkedClusterArns: arns,
+	}
+	_, err := conn.DeleteMultiRegionClusters(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DSQL Multi-Region Clusters (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	for _, v := range arns {
+		id, region, err := clusterIDAndRegionFromARN(v)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting DSQL Multi-Region Clusters (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitClusterDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts), withRegion(region)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for DSQL Cluster (%s) delete", v), err.Error())
+
+			return
+		}
+	}
+}
+
+type multiRegionClustersResourceModel struct {
+	DeletionProtectionEnabled types.Bool                        `tfsdk:"deletion_protection_enabled" autoflex:"-"`
+	ID                        types.String                      `tfsdk:"id"`
+	LinkedClusterARNs         fwtypes.ListValueOf[types.String] `tfsdk:"linked_cluster_arns"`
+	LinkedRegionList          fwtypes.ListValueOf[types.String] `tfsdk:"linked_region_list"`
+	Timeouts                  timeouts.Value                    `tfsdk:"timeouts"`
+	WitnessRegion             types.String                      `tfsdk:"witness_region"`
+}
+
+// clusterIDAndRegionFromARN returns the cluster identifier and Region from a DSQL cluster ARN.
+func clusterIDAndRegionFromARN(s string) (string, string, error) {
+	arn, err := arn.Parse(s)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	id, ok := strings.CutPrefix(arn.Resource, "cluster/")
+
+	if !ok || id == "" {
+		return "", "", fmt.Errorf("unexpected format for DSQL cluster ARN (%s)", s)
+	}
+
+	return id, arn.Region, nil
+}
+
+func withRegion(region string) func(*dsql.Options) {
+	return func(o *dsql.Options) {
+		o.Region = region
+	}
+}
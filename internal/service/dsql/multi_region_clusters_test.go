@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdsql "github.com/hashicorp/terraform-provider-aws/internal/service/dsql"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestClusterIDAndRegionFromARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName       string
+		input          string
+		expectedID     string
+		expectedRegion string
+		expectError    bool
+	}{
+		{
+			testName:    "empty ARN",
+			input:       "",
+			expectError: true,
+		},
+		{
+			testName:    "not a cluster ARN",
+			input:       "arn:aws:dsql:us-east-1:123456789012:other/abcdef", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			testName:    "missing identifier",
+			input:       "arn:aws:dsql:us-east-1:123456789012:cluster/", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		{
+			testName:       "valid ARN",
+			input:          "arn:aws:dsql:us-east-2:123456789012:cluster/abcdefghijklmnopqrst", //lintignore:AWSAT003,AWSAT005
+			expectedID:     "abcdefghijklmnopqrst",
+			expectedRegion: "us-east-2", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotRegion, err := tfdsql.ClusterIDAndRegionFromARN(testCase.input)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expectError = %t", err, want)
+			}
+
+			if got, want := gotID, testCase.expectedID; got != want {
+				t.Errorf("id = %q, want %q", got, want)
+			}
+
+			if got, want := gotRegion, testCase.expectedRegion; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccDSQLMultiRegionClusters_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dsql_multi_region_clusters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 3)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSQLServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMultiRegionClustersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionClustersConfig_basic(false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("deletion_protection_enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("linked_cluster_arns"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("linked_region_list"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact(acctest.Region()),
+						knownvalue.StringExact(acctest.AlternateRegion()),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("witness_region"), knownvalue.StringExact(acctest.ThirdRegion())),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionClustersExists(ctx, resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMultiRegionClustersConfig_basic(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("deletion_protection_enabled"), knownvalue.Bool(true)),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionClustersExists(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccMultiRegionClustersConfig_basic(false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("deletion_protection_enabled"), knownvalue.Bool(false)),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMultiRegionClustersExists(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMultiRegionClustersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSQLClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dsql_multi_region_clusters" {
+				continue
+			}
+
+			for _, v := range strings.Split(rs.Primary.ID, ",") {
+				id, region, err := tfdsql.ClusterIDAndRegionFromARN(v)
+
+				if err != nil {
+					return err
+				}
+
+				_, err = tfdsql.FindClusterByID(ctx, conn, id, tfdsql.WithRegion(region))
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("DSQL Cluster %s still exists", v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMultiRegionClustersExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSQLClient(ctx)
+
+		for _, v := range strings.Split(rs.Primary.ID, ",") {
+			id, region, err := tfdsql.ClusterIDAndRegionFromARN(v)
+
+			if err != nil {
+				return err
+			}
+
+			if _, err := tfdsql.FindClusterByID(ctx, conn, id, tfdsql.WithRegion(region)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccMultiRegionClustersConfig_basic(deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "aws_dsql_multi_region_clusters" "test" {
+  linked_region_list          = [%[1]q, %[2]q]
+  witness_region              = %[3]q
+  deletion_protection_enabled = %[4]t
+}
+`, acctest.Region(), acctest.AlternateRegion(), acctest.ThirdRegion(), deletionProtection)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_eip_address_transfer_accepter", name="EIP Address Transfer Accepter")
+func newEIPAddressTransferAccepterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &eipAddressTransferAccepterResource{}, nil
+}
+
+type eipAddressTransferAccepterResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (r *eipAddressTransferAccepterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAddress: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allocation_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (r *eipAddressTransferAccepterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data eipAddressTransferAccepterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	address := data.Address.ValueString()
+	input := ec2.AcceptAddressTransferInput{
+		Address: aws.String(address),
+	}
+
+	_, err := conn.AcceptAddressTransfer(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating EC2 EIP Address Transfer Accepter (%s)", address), err.Error())
+
+		return
+	}
+
+	output, err := tfresource.RetryWhenNotFound(ctx, ec2PropagationTimeout, func() (any, error) {
+		return findEIPByPublicIP(ctx, conn, address)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 EIP (%s)", address), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AllocationID = fwflex.StringToFramework(ctx, output.(*awstypes.Address).AllocationId)
+	data.ID = types.StringValue(address)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *eipAddressTransferAccepterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data eipAddressTransferAccepterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findEIPByPublicIP(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 EIP Address Transfer Accepter (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Address = fwflex.StringToFramework(ctx, output.PublicIp)
+	data.AllocationID = fwflex.StringToFramework(ctx, output.AllocationId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *eipAddressTransferAccepterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data eipAddressTransferAccepterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// An accepted transfer cannot be undone. The Elastic IP address remains allocated to this account.
+	response.Diagnostics.AddWarning(
+		"EC2 EIP Address Transfer Accepter removed from state",
+		fmt.Sprintf("Elastic IP address %s has been removed from Terraform state but remains allocated to this account. Use the aws_eip resource to manage or release it.", data.ID.ValueString()),
+	)
+}
+
+type eipAddressTransferAccepterResourceModel struct {
+	Address      types.String `tfsdk:"address"`
+	AllocationID types.String `tfsdk:"allocation_id"`
+	ID           types.String `tfsdk:"id"`
+}
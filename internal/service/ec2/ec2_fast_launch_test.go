@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2FastLaunch_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_fast_launch.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFastLaunchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastLaunchConfig_basic(rName, 6, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastLaunchExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", "aws_ami_copy.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_parallel_launches", "6"),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, names.AttrOwnerID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "snapshot"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_configuration.0.target_resource_count", "5"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFastLaunchConfig_basic(rName, 7, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastLaunchExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_parallel_launches", "7"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_configuration.0.target_resource_count", "10"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2FastLaunch_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_fast_launch.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFastLaunchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastLaunchConfig_basic(rName, 6, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastLaunchExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceFastLaunch, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2FastLaunch_launchTemplate(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_fast_launch.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFastLaunchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastLaunchConfig_launchTemplate(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastLaunchExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.launch_template_id", "aws_launch_template.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.version", "aws_launch_template.test", "latest_version"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFastLaunchDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_fast_launch" {
+				continue
+			}
+
+			_, err := tfec2.FindFastLaunchImageByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Fast Launch %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFastLaunchExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindFastLaunchImageByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccFastLaunchConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "windows" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["Windows_Server-2022-English-Full-Base-*"]
+  }
+
+  filter {
+    name   = "virtualization-type"
+    values = ["hvm"]
+  }
+}
+
+data "aws_region" "current" {}
+
+resource "aws_ami_copy" "test" {
+  name              = %[1]q
+  source_ami_id     = data.aws_ami.windows.id
+  source_ami_region = data.aws_region.current.name
+}
+`, rName)
+}
+
+func testAccFastLaunchConfig_basic(rName string, maxParallelLaunches, targetResourceCount int) string {
+	return acctest.ConfigCompose(testAccFastLaunchConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_fast_launch" "test" {
+  image_id              = aws_ami_copy.test.id
+  max_parallel_launches = %[1]d
+  resource_type         = "snapshot"
+
+  snapshot_configuration {
+    target_resource_count = %[2]d
+  }
+}
+`, maxParallelLaunches, targetResourceCount))
+}
+
+func testAccFastLaunchConfig_launchTemplate(rName string) string {
+	return acctest.ConfigCompose(testAccFastLaunchConfig_base(rName), acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = "t3.xlarge"
+
+  network_interfaces {
+    subnet_id = aws_subnet.test[0].id
+  }
+}
+
+resource "aws_ec2_fast_launch" "test" {
+  image_id = aws_ami_copy.test.id
+
+  launch_template {
+    launch_template_id = aws_launch_template.test.id
+    version            = aws_launch_template.test.latest_version
+  }
+}
+`, rName))
+}
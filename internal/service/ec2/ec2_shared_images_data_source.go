@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_shared_images", name="Shared Images")
+func dataSourceSharedImages() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSharedImagesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrFilter: customFiltersSchema(),
+			"image_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"image_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organization_arns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"organizational_unit_arns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"public": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSharedImagesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := ec2.DescribeImagesInput{
+		Owners: []string{"self"},
+	}
+
+	if v, ok := d.GetOk("image_ids"); ok && len(v.([]any)) > 0 {
+		input.ImageIds = flex.ExpandStringValueList(v.([]any))
+	}
+
+	if v, ok := d.GetOk(names.AttrFilter); ok {
+		input.Filters = newCustomFilterList(v.(*schema.Set))
+	}
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	images, err := findImages(ctx, conn, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 AMIs: %s", err)
+	}
+
+	tfList := make([]any, 0)
+
+	for _, image := range images {
+		imageID := aws.ToString(image.ImageId)
+		launchPermissions, err := findImageLaunchPermissionsByID(ctx, conn, imageID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 AMI (%s) launch permissions: %s", imageID, err)
+		}
+
+		tfList = append(tfList, flattenSharedImage(&image, launchPermissions))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	if err := d.Set("images", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting images: %s", err)
+	}
+
+	return diags
+}
+
+func flattenSharedImage(apiObject *awstypes.Image, launchPermissions []awstypes.LaunchPermission) map[string]any {
+	public := aws.ToBool(apiObject.Public)
+	accountIDs := make([]string, 0)
+	organizationARNs := make([]string, 0)
+	organizationalUnitARNs := make([]string, 0)
+
+	for _, v := range launchPermissions {
+		if v.Group == awstypes.PermissionGroupAll {
+			public = true
+		}
+
+		if v := aws.ToString(v.UserId); v != "" {
+			accountIDs = append(accountIDs, v)
+		}
+
+		if v := aws.ToString(v.OrganizationArn); v != "" {
+			organizationARNs = append(organizationARNs, v)
+		}
+
+		if v := aws.ToString(v.OrganizationalUnitArn); v != "" {
+			organizationalUnitARNs = append(organizationalUnitARNs, v)
+		}
+	}
+
+	tfMap := map[string]any{
+		"account_ids":              accountIDs,
+		"image_id":                 aws.ToString(apiObject.ImageId),
+		names.AttrName:             aws.ToString(apiObject.Name),
+		"organization_arns":        organizationARNs,
+		"organizational_unit_arns": organizationalUnitARNs,
+		"public":                   public,
+	}
+
+	return tfMap
+}
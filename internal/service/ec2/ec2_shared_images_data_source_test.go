@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2SharedImagesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_shared_images.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSharedImagesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.image_id", "aws_ami_copy.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.public", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.account_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.account_ids.0", "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.organization_arns.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.organizational_unit_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SharedImagesDataSource_notShared(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_shared_images.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSharedImagesDataSourceConfig_notShared(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSharedImagesDataSourceConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(), fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_ami_copy" "test" {
+  description       = %[1]q
+  name              = %[1]q
+  source_ami_id     = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  source_ami_region = data.aws_region.current.name
+}
+`, rName))
+}
+
+func testAccSharedImagesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSharedImagesDataSourceConfig_base(rName), `
+data "aws_caller_identity" "current" {}
+
+resource "aws_ami_launch_permission" "test" {
+  account_id = data.aws_caller_identity.current.account_id
+  image_id   = aws_ami_copy.test.id
+}
+
+data "aws_ec2_shared_images" "test" {
+  image_ids = [aws_ami_copy.test.id]
+
+  depends_on = [aws_ami_launch_permission.test]
+}
+`)
+}
+
+func testAccSharedImagesDataSourceConfig_notShared(rName string) string {
+	return acctest.ConfigCompose(testAccSharedImagesDataSourceConfig_base(rName), `
+data "aws_ec2_shared_images" "test" {
+  image_ids = [aws_ami_copy.test.id]
+}
+`)
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_ec2_spot_placement_scores", name="Spot Placement Scores")
+func newSpotPlacementScoresDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &spotPlacementScoresDataSource{}, nil
+}
+
+type spotPlacementScoresDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *spotPlacementScoresDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"instance_types": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ExactlyOneOf(path.MatchRoot("instance_types"), path.MatchRoot("instance_requirements_with_metadata")),
+				},
+			},
+			"region_names": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"single_availability_zone": schema.BoolAttribute{
+				Optional: true,
+			},
+			"spot_placement_scores": framework.DataSourceComputedListOfObjectAttribute[spotPlacementScoreModel](ctx),
+			"target_capacity": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"target_capacity_unit_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TargetCapacityUnitType](),
+				Optional:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"instance_requirements_with_metadata": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[instanceRequirementsWithMetadataRequestModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"architecture_types": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringEnumType[awstypes.ArchitectureType](),
+							ElementType: fwtypes.StringEnumType[awstypes.ArchitectureType](),
+							Optional:    true,
+						},
+						"virtualization_types": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringEnumType[awstypes.VirtualizationType](),
+							ElementType: fwtypes.StringEnumType[awstypes.VirtualizationType](),
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"instance_requirements": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[instanceRequirementsRequestModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_instance_types": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"bare_metal": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.BareMetal](),
+										Optional:   true,
+									},
+									"burstable_performance": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.BurstablePerformance](),
+										Optional:   true,
+									},
+									"cpu_manufacturers": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringEnumType[awstypes.CpuManufacturer](),
+										ElementType: fwtypes.StringEnumType[awstypes.CpuManufacturer](),
+										Optional:    true,
+									},
+									"excluded_instance_types": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"instance_generations": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringEnumType[awstypes.InstanceGeneration](),
+										ElementType: fwtypes.StringEnumType[awstypes.InstanceGeneration](),
+										Optional:    true,
+									},
+									"local_storage": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.LocalStorage](),
+										Optional:   true,
+									},
+									"require_hibernate_support": schema.BoolAttribute{
+										Optional: true,
+									},
+									"spot_max_price_percentage_over_lowest_price": schema.Int32Attribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"memory_mib": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[minMaxRequestModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"max": schema.Int32Attribute{
+													Optional: true,
+												},
+												"min": schema.Int32Attribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"vcpu_count": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[minMaxRequestModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"max": schema.Int32Attribute{
+													Optional: true,
+												},
+												"min": schema.Int32Attribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *spotPlacementScoresDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data spotPlacementScoresDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Client(ctx)
+
+	var input ec2.GetSpotPlacementScoresInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findSpotPlacementScores(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading EC2 Spot Placement Scores", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.SpotPlacementScores)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type spotPlacementScoresDataSourceModel struct {
+	InstanceRequirementsWithMetadata fwtypes.ListNestedObjectValueOf[instanceRequirementsWithMetadataRequestModel] `tfsdk:"instance_requirements_with_metadata"`
+	InstanceTypes                    fwtypes.SetOfString                                                           `tfsdk:"instance_types"`
+	RegionNames                      fwtypes.SetOfString                                                           `tfsdk:"region_names"`
+	SingleAvailabilityZone           types.Bool                                                                    `tfsdk:"single_availability_zone"`
+	SpotPlacementScores              fwtypes.ListNestedObjectValueOf[spotPlacementScoreModel]                      `tfsdk:"spot_placement_scores"`
+	TargetCapacity                   types.Int32                                                                   `tfsdk:"target_capacity"`
+	TargetCapacityUnitType           fwtypes.StringEnum[awstypes.TargetCapacityUnitType]                           `tfsdk:"target_capacity_unit_type"`
+}
+
+type instanceRequirementsWithMetadataRequestModel struct {
+	ArchitectureTypes    fwtypes.SetOfStringEnum[awstypes.ArchitectureType]                `tfsdk:"architecture_types"`
+	InstanceRequirements fwtypes.ListNestedObjectValueOf[instanceRequirementsRequestModel] `tfsdk:"instance_requirements"`
+	VirtualizationTypes  fwtypes.SetOfStringEnum[awstypes.VirtualizationType]              `tfsdk:"virtualization_types"`
+}
+
+type instanceRequirementsRequestModel struct {
+	AllowedInstanceTypes                  fwtypes.SetOfString                                  `tfsdk:"allowed_instance_types"`
+	BareMetal                             fwtypes.StringEnum[awstypes.BareMetal]               `tfsdk:"bare_metal"`
+	BurstablePerformance                  fwtypes.StringEnum[awstypes.BurstablePerformance]    `tfsdk:"burstable_performance"`
+	CpuManufacturers                      fwtypes.SetOfStringEnum[awstypes.CpuManufacturer]    `tfsdk:"cpu_manufacturers"`
+	ExcludedInstanceTypes                 fwtypes.SetOfString                                  `tfsdk:"excluded_instance_types"`
+	InstanceGenerations                   fwtypes.SetOfStringEnum[awstypes.InstanceGeneration] `tfsdk:"instance_generations"`
+	LocalStorage                          fwtypes.StringEnum[awstypes.LocalStorage]            `tfsdk:"local_storage"`
+	MemoryMiB                             fwtypes.ListNestedObjectValueOf[minMaxRequestModel]  `tfsdk:"memory_mib"`
+	RequireHibernateSupport               types.Bool                                           `tfsdk:"require_hibernate_support"`
+	SpotMaxPricePercentageOverLowestPrice types.Int32                                          `tfsdk:"spot_max_price_percentage_over_lowest_price"`
+	VCpuCount                             fwtypes.ListNestedObjectValueOf[minMaxRequestModel]  `tfsdk:"vcpu_count"`
+}
+
+type minMaxRequestModel struct {
+	Max types.Int32 `tfsdk:"max"`
+	Min types.Int32 `tfsdk:"min"`
+}
+
+type spotPlacementScoreModel struct {
+	AvailabilityZoneID types.String `tfsdk:"availability_zone_id"`
+	Region             types.String `tfsdk:"region"`
+	Score              types.Int32  `tfsdk:"score"`
+}
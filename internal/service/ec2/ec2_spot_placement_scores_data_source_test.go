@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2SpotPlacementScoresDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_spot_placement_scores.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoresDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_capacity", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotPlacementScoresDataSource_instanceRequirements(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_spot_placement_scores.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoresDataSourceConfig_instanceRequirements(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.availability_zone_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spot_placement_scores.0.score"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpotPlacementScoresDataSourceConfig_basic() string {
+	return `
+data "aws_ec2_spot_placement_scores" "test" {
+  instance_types  = ["t3.micro", "t3.small"]
+  target_capacity = 1
+}
+`
+}
+
+func testAccSpotPlacementScoresDataSourceConfig_instanceRequirements() string {
+	return `
+data "aws_region" "current" {}
+
+data "aws_ec2_spot_placement_scores" "test" {
+  target_capacity           = 4
+  target_capacity_unit_type = "vcpu"
+  single_availability_zone  = true
+  region_names              = [data.aws_region.current.name]
+
+  instance_requirements_with_metadata {
+    architecture_types = ["x86_64"]
+
+    instance_requirements {
+      burstable_performance = "excluded"
+
+      memory_mib {
+        min = 1024
+      }
+
+      vcpu_count {
+        min = 2
+        max = 4
+      }
+    }
+  }
+}
+`
+}
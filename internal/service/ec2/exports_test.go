@@ -26,8 +26,10 @@ var (
 	ResourceEBSSnapshotImport                             = resourceEBSSnapshotImport
 	ResourceEBSVolume                                     = resourceEBSVolume
 	ResourceEIP                                           = resourceEIP
+	ResourceEIPAddressTransfer                            = newEIPAddressTransferResource
 	ResourceEIPAssociation                                = resourceEIPAssociation
 	ResourceEIPDomainName                                 = newEIPDomainNameResource
+	ResourceFastLaunch                                    = newFastLaunchResource
 	ResourceFleet                                         = resourceFleet
 	ResourceFlowLog                                       = resourceFlowLog
 	ResourceHost                                          = resourceHost
@@ -133,6 +135,7 @@ var (
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone        = errCodeDefaultSubnetAlreadyExistsInAvailabilityZone
 	ErrCodeInvalidSpotDatafeedNotFound                         = errCodeInvalidSpotDatafeedNotFound
 	ExpandIPPerms                                              = expandIPPerms
+	FindAddressTransferByAllocationID                          = findAddressTransferByAllocationID
 	FindAvailabilityZones                                      = findAvailabilityZones
 	FindCapacityReservationByID                                = findCapacityReservationByID
 	FindCarrierGatewayByID                                     = findCarrierGatewayByID
@@ -149,6 +152,7 @@ var (
 	FindEIPByAssociationID                                     = findEIPByAssociationID
 	FindEIPDomainNameAttributeByAllocationID                   = findEIPDomainNameAttributeByAllocationID
 	FindEgressOnlyInternetGatewayByID                          = findEgressOnlyInternetGatewayByID
+	FindFastLaunchImageByID                                    = findFastLaunchImageByID
 	FindFastSnapshotRestoreByTwoPartKey                        = findFastSnapshotRestoreByTwoPartKey
 	FindFleetByID                                              = findFleetByID
 	FindFlowLogByID                                            = findFlowLogByID
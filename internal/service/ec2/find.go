@@ -5477,6 +5477,89 @@ func findEIPByAssociationID(ctx context.Context, conn *ec2.Client, id string) (*
 	return output, nil
 }
 
+func findEIPByPublicIP(ctx context.Context, conn *ec2.Client, ip string) (*awstypes.Address, error) {
+	input := ec2.DescribeAddressesInput{
+		PublicIps: []string{ip},
+	}
+
+	output, err := findEIP(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.PublicIp) != ip {
+		return nil, &retry.NotFoundError{
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
+func findAddressTransfers(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressTransfersInput) ([]awstypes.AddressTransfer, error) {
+	var output []awstypes.AddressTransfer
+
+	pages := ec2.NewDescribeAddressTransfersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AddressTransfers...)
+	}
+
+	return output, nil
+}
+
+func findAddressTransfer(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressTransfersInput) (*awstypes.AddressTransfer, error) {
+	output, err := findAddressTransfers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findAddressTransferByAllocationID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.AddressTransfer, error) {
+	input := ec2.DescribeAddressTransfersInput{
+		AllocationIds: []string{id},
+	}
+
+	output, err := findAddressTransfer(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.AddressTransferStatus; status == awstypes.AddressTransferStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: &input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.AllocationId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
 func findEIPAttributes(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressesAttributeInput) ([]awstypes.AddressAttribute, error) {
 	var output []awstypes.AddressAttribute
 
@@ -6316,6 +6399,61 @@ func findFastSnapshotRestoreByTwoPartKey(ctx context.Context, conn *ec2.Client,
 	return output, nil
 }
 
+func findFastLaunchImage(ctx context.Context, conn *ec2.Client, input *ec2.DescribeFastLaunchImagesInput) (*awstypes.DescribeFastLaunchImagesSuccessItem, error) {
+	output, err := findFastLaunchImages(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findFastLaunchImages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeFastLaunchImagesInput) ([]awstypes.DescribeFastLaunchImagesSuccessItem, error) {
+	var output []awstypes.DescribeFastLaunchImagesSuccessItem
+
+	pages := ec2.NewDescribeFastLaunchImagesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidAMIIDNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.FastLaunchImages...)
+	}
+
+	return output, nil
+}
+
+func findFastLaunchImageByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.DescribeFastLaunchImagesSuccessItem, error) {
+	input := ec2.DescribeFastLaunchImagesInput{
+		ImageIds: []string{id},
+	}
+
+	output, err := findFastLaunchImage(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.ImageId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
 func findTrafficMirrorFilter(ctx context.Context, conn *ec2.Client, input *ec2.DescribeTrafficMirrorFiltersInput) (*awstypes.TrafficMirrorFilter, error) {
 	output, err := findTrafficMirrorFilters(ctx, conn, input)
 
@@ -6637,6 +6775,23 @@ func findCapacityBlockOfferings(ctx context.Context, conn *ec2.Client, input *ec
 	return output, nil
 }
 
+func findSpotPlacementScores(ctx context.Context, conn *ec2.Client, input *ec2.GetSpotPlacementScoresInput) ([]awstypes.SpotPlacementScore, error) {
+	var output []awstypes.SpotPlacementScore
+
+	pages := ec2.NewGetSpotPlacementScoresPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SpotPlacementScores...)
+	}
+
+	return output, nil
+}
+
 func findVPCBlockPublicAccessOptions(ctx context.Context, conn *ec2.Client) (*awstypes.VpcBlockPublicAccessOptions, error) {
 	input := ec2.DescribeVpcBlockPublicAccessOptionsInput{}
 
@@ -21,6 +21,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			TypeName: "aws_ec2_capacity_block_offering",
 			Name:     "Capacity Block Offering",
 		},
+		{
+			Factory:  newSpotPlacementScoresDataSource,
+			TypeName: "aws_ec2_spot_placement_scores",
+			Name:     "Spot Placement Scores",
+		},
 		{
 			Factory:  newDataSourceSpotDataFeedSubscription,
 			TypeName: "aws_spot_datafeed_subscription",
@@ -65,6 +70,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  newFastLaunchResource,
+			TypeName: "aws_ec2_fast_launch",
+			Name:     "Fast Launch",
+		},
 		{
 			Factory:  newInstanceConnectEndpointResource,
 			TypeName: "aws_ec2_instance_connect_endpoint",
@@ -88,6 +98,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_ec2_transit_gateway_default_route_table_propagation",
 			Name:     "Transit Gateway Default Route Table Propagation",
 		},
+		{
+			Factory:  newEIPAddressTransferResource,
+			TypeName: "aws_eip_address_transfer",
+			Name:     "EIP Address Transfer",
+		},
+		{
+			Factory:  newEIPAddressTransferAccepterResource,
+			TypeName: "aws_eip_address_transfer_accepter",
+			Name:     "EIP Address Transfer Accepter",
+		},
 		{
 			Factory:  newEIPDomainNameResource,
 			TypeName: "aws_eip_domain_name",
@@ -325,6 +345,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ec2_serial_console_access",
 			Name:     "Serial Console Access",
 		},
+		{
+			Factory:  dataSourceSharedImages,
+			TypeName: "aws_ec2_shared_images",
+			Name:     "Shared Images",
+		},
 		{
 			Factory:  dataSourceSpotPrice,
 			TypeName: "aws_ec2_spot_price",
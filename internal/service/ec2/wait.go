@@ -506,6 +506,46 @@ func waitFastSnapshotRestoreDeleted(ctx context.Context, conn *ec2.Client, avail
 	return nil, err
 }
 
+func waitFastLaunchEnabled(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.DescribeFastLaunchImagesSuccessItem, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FastLaunchStateCodeEnabling),
+		Target:  enum.Slice(awstypes.FastLaunchStateCodeEnabled),
+		Refresh: statusFastLaunchImage(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DescribeFastLaunchImagesSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFastLaunchDisabled(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.DescribeFastLaunchImagesSuccessItem, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FastLaunchStateCodeDisabling, awstypes.FastLaunchStateCodeEnabled),
+		Target:  []string{},
+		Refresh: statusFastLaunchImage(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DescribeFastLaunchImagesSuccessItem); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateTransitionReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitFleet(ctx context.Context, conn *ec2.Client, id string, pending, target []string, timeout, delay time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    pending,
@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_eks_access_policy_associations_exclusive", name="Access Policy Associations Exclusive")
+func newAccessPolicyAssociationsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &accessPolicyAssociationsExclusiveResource{}
+
+	return r, nil
+}
+
+const (
+	ResNameAccessPolicyAssociationsExclusive = "Access Policy Associations Exclusive"
+)
+
+type accessPolicyAssociationsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *accessPolicyAssociationsExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"policy_association": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[policyAssociationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"access_scope": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[accessScopeModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"namespaces": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AccessScopeType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *accessPolicyAssociationsExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan accessPolicyAssociationsExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncAssociations(ctx, plan, create.ErrActionCreating)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *accessPolicyAssociationsExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state accessPolicyAssociationsExclusiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EKSClient(ctx)
+
+	output, err := findAccessPolicyAssociationsByTwoPartKey(ctx, conn, state.ClusterName.ValueString(), state.PrincipalARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionReading, ResNameAccessPolicyAssociationsExclusive, state.id(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// No policy_association blocks is an empty set, not null.
+	if output == nil {
+		output = []awstypes.AssociatedAccessPolicy{}
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(
+		ctx,
+		struct {
+			PolicyAssociations []awstypes.AssociatedAccessPolicy
+		}{
+			PolicyAssociations: output,
+		},
+		&state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *accessPolicyAssociationsExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state accessPolicyAssociationsExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.PolicyAssociations.Equal(state.PolicyAssociations) {
+		resp.Diagnostics.Append(r.syncAssociations(ctx, plan, create.ErrActionUpdating)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *accessPolicyAssociationsExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterName, principalARN, err := accessEntryParseResourceID(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("importing EKS Access Policy Associations Exclusive", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrClusterName), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_arn"), principalARN)...)
+}
+
+// syncAssociations handles keeping the configured access policy associations
+// in sync with the remote access entry.
+//
+// Access policies defined on this resource but not associated with the access
+// entry will be associated. Access policies whose access scope differs will be
+// re-associated with the configured access scope. Access policies associated with
+// the access entry but not configured on this resource will be disassociated.
+func (r *accessPolicyAssociationsExclusiveResource) syncAssociations(ctx context.Context, plan accessPolicyAssociationsExclusiveResourceModel, action string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := r.Meta().EKSClient(ctx)
+
+	clusterName, principalARN := plan.ClusterName.ValueString(), plan.PrincipalARN.ValueString()
+
+	have, err := findAccessPolicyAssociationsByTwoPartKey(ctx, conn, clusterName, principalARN)
+	if err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.EKS, action, ResNameAccessPolicyAssociationsExclusive, plan.id(), err),
+			err.Error(),
+		)
+		return diags
+	}
+
+	var want []awstypes.AssociatedAccessPolicy
+	diags.Append(fwflex.Expand(ctx, plan.PolicyAssociations, &want)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// An access policy can be associated with an access entry only once.
+	// Associating it again replaces its access scope.
+	add, remove, modify, _ := intflex.DiffSlicesWithModify(have, want, associatedAccessPolicyEqual, associatedAccessPolicyARNEqual)
+
+	for _, v := range slices.Concat(add, modify) {
+		input := &eks.AssociateAccessPolicyInput{
+			AccessScope:  v.AccessScope,
+			ClusterName:  aws.String(clusterName),
+			PolicyArn:    v.PolicyArn,
+			PrincipalArn: aws.String(principalARN),
+		}
+
+		_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ResourceNotFoundException](ctx, propagationTimeout, func() (any, error) {
+			return conn.AssociateAccessPolicy(ctx, input)
+		}, "The specified principalArn could not be found")
+
+		if err != nil {
+			diags.AddError(
+				create.ProblemStandardMessage(names.EKS, action, ResNameAccessPolicyAssociationsExclusive, plan.id(), err),
+				err.Error(),
+			)
+			return diags
+		}
+	}
+
+	for _, v := range remove {
+		input := &eks.DisassociateAccessPolicyInput{
+			ClusterName:  aws.String(clusterName),
+			PolicyArn:    v.PolicyArn,
+			PrincipalArn: aws.String(principalARN),
+		}
+
+		_, err := conn.DisassociateAccessPolicy(ctx, input)
+
+		if err != nil {
+			diags.AddError(
+				create.ProblemStandardMessage(names.EKS, action, ResNameAccessPolicyAssociationsExclusive, plan.id(), err),
+				err.Error(),
+			)
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func associatedAccessPolicyARNEqual(v1, v2 awstypes.AssociatedAccessPolicy) bool {
+	return aws.ToString(v1.PolicyArn) == aws.ToString(v2.PolicyArn)
+}
+
+func associatedAccessPolicyEqual(v1, v2 awstypes.AssociatedAccessPolicy) bool {
+	if !associatedAccessPolicyARNEqual(v1, v2) {
+		return false
+	}
+
+	s1, s2 := v1.AccessScope, v2.AccessScope
+	if s1 == nil || s2 == nil {
+		return s1 == s2
+	}
+
+	if s1.Type != s2.Type {
+		return false
+	}
+
+	n1, n2 := slices.Clone(s1.Namespaces), slices.Clone(s2.Namespaces)
+	slices.Sort(n1)
+	slices.Sort(n2)
+
+	return slices.Equal(n1, n2)
+}
+
+func findAccessPolicyAssociationsByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, principalARN string) ([]awstypes.AssociatedAccessPolicy, error) {
+	input := &eks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	output, err := findAssociatedAccessPolicies(ctx, conn, input, tfslices.PredicateTrue[*awstypes.AssociatedAccessPolicy]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range output {
+		// Cluster-scoped access policies are returned with an empty list of namespaces.
+		if v.AccessScope != nil && len(v.AccessScope.Namespaces) == 0 {
+			output[i].AccessScope.Namespaces = nil
+		}
+	}
+
+	return output, nil
+}
+
+type accessPolicyAssociationsExclusiveResourceModel struct {
+	ClusterName        types.String                                           `tfsdk:"cluster_name"`
+	PolicyAssociations fwtypes.SetNestedObjectValueOf[policyAssociationModel] `tfsdk:"policy_association"`
+	PrincipalARN       fwtypes.ARN                                            `tfsdk:"principal_arn"`
+}
+
+func (model *accessPolicyAssociationsExclusiveResourceModel) id() string {
+	return accessEntryCreateResourceID(model.ClusterName.ValueString(), model.PrincipalARN.ValueString())
+}
+
+type policyAssociationModel struct {
+	AccessScope fwtypes.ListNestedObjectValueOf[accessScopeModel] `tfsdk:"access_scope"`
+	PolicyARN   fwtypes.ARN                                       `tfsdk:"policy_arn"`
+}
+
+type accessScopeModel struct {
+	Namespaces fwtypes.SetOfString                          `tfsdk:"namespaces"`
+	Type       fwtypes.StringEnum[awstypes.AccessScopeType] `tfsdk:"type"`
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSAccessPolicyAssociationsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_policy_associations_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyAssociationsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrClusterName, "aws_eks_cluster.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_arn", "aws_iam_user.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "policy_association.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "policy_association.*", map[string]string{
+						"access_scope.#":      "1",
+						"access_scope.0.type": "cluster",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "policy_association.*", map[string]string{
+						"access_scope.#":              "1",
+						"access_scope.0.namespaces.#": "2",
+						"access_scope.0.type":         "namespace",
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccAccessPolicyAssociationsExclusiveImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrClusterName,
+			},
+		},
+	})
+}
+
+func TestAccEKSAccessPolicyAssociationsExclusive_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_policy_associations_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyAssociationsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "policy_association.#", "2"),
+				),
+			},
+			{
+				Config: testAccAccessPolicyAssociationsExclusiveConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_association.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_association.0.access_scope.0.namespaces.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_association.0.access_scope.0.type", "namespace"),
+				),
+			},
+			{
+				Config: testAccAccessPolicyAssociationsExclusiveConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationsExclusiveCount(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "policy_association.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// An access policy associated out of band should be disassociated.
+func TestAccEKSAccessPolicyAssociationsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_access_policy_associations_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPolicyAssociationsExclusiveConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationsExclusiveCount(ctx, resourceName, 1),
+					testAccCheckAccessPolicyAssociationsExclusiveAssociateClusterAdmin(ctx, resourceName),
+					testAccCheckAccessPolicyAssociationsExclusiveCount(ctx, resourceName, 2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAccessPolicyAssociationsExclusiveConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPolicyAssociationsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_association.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessPolicyAssociationsExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		output, err := tfeks.FindAccessPolicyAssociationsByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrClusterName], rs.Primary.Attributes["principal_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("EKS Access Entry (%s) has %d associated access policies, want %d", rs.Primary.Attributes["principal_arn"], got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccessPolicyAssociationsExclusiveAssociateClusterAdmin(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		_, err := conn.AssociateAccessPolicy(ctx, &eks.AssociateAccessPolicyInput{
+			AccessScope: &types.AccessScope{
+				Type: types.AccessScopeTypeCluster,
+			},
+			ClusterName:  aws.String(rs.Primary.Attributes[names.AttrClusterName]),
+			PolicyArn:    aws.String(fmt.Sprintf("arn:%s:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy", acctest.Partition())),
+			PrincipalArn: aws.String(rs.Primary.Attributes["principal_arn"]),
+		})
+
+		return err
+	}
+}
+
+func testAccAccessPolicyAssociationsExclusiveImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes[names.AttrClusterName] + ":" + rs.Primary.Attributes["principal_arn"], nil
+	}
+}
+
+func testAccAccessPolicyAssociationsExclusiveConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPolicyAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_eks_access_entry" "test" {
+  cluster_name  = aws_eks_cluster.test.name
+  principal_arn = aws_iam_user.test.arn
+}
+`, rName))
+}
+
+func testAccAccessPolicyAssociationsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPolicyAssociationsExclusiveConfig_base(rName), `
+resource "aws_eks_access_policy_associations_exclusive" "test" {
+  cluster_name  = aws_eks_access_entry.test.cluster_name
+  principal_arn = aws_eks_access_entry.test.principal_arn
+
+  policy_association {
+    policy_arn = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+
+    access_scope {
+      type = "cluster"
+    }
+  }
+
+  policy_association {
+    policy_arn = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSEditPolicy"
+
+    access_scope {
+      type       = "namespace"
+      namespaces = ["test1", "test2"]
+    }
+  }
+}
+`)
+}
+
+func testAccAccessPolicyAssociationsExclusiveConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPolicyAssociationsExclusiveConfig_base(rName), `
+resource "aws_eks_access_policy_associations_exclusive" "test" {
+  cluster_name  = aws_eks_access_entry.test.cluster_name
+  principal_arn = aws_eks_access_entry.test.principal_arn
+
+  policy_association {
+    policy_arn = "arn:${data.aws_partition.current.partition}:eks::aws:cluster-access-policy/AmazonEKSEditPolicy"
+
+    access_scope {
+      type       = "namespace"
+      namespaces = ["test3"]
+    }
+  }
+}
+`)
+}
+
+func testAccAccessPolicyAssociationsExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccAccessPolicyAssociationsExclusiveConfig_base(rName), `
+resource "aws_eks_access_policy_associations_exclusive" "test" {
+  cluster_name  = aws_eks_access_entry.test.cluster_name
+  principal_arn = aws_eks_access_entry.test.principal_arn
+}
+`)
+}
@@ -17,6 +17,7 @@ var (
 	ClusterStateUpgradeV0                      = clusterStateUpgradeV0
 	FindAccessEntryByTwoPartKey                = findAccessEntryByTwoPartKey
 	FindAccessPolicyAssociationByThreePartKey  = findAccessPolicyAssociationByThreePartKey
+	FindAccessPolicyAssociationsByTwoPartKey   = findAccessPolicyAssociationsByTwoPartKey
 	FindAddonByTwoPartKey                      = findAddonByTwoPartKey
 	FindClusterByName                          = findClusterByName
 	FindFargateProfileByTwoPartKey             = findFargateProfileByTwoPartKey
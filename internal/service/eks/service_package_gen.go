@@ -36,6 +36,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newAccessPolicyAssociationsExclusiveResource,
+			TypeName: "aws_eks_access_policy_associations_exclusive",
+			Name:     "Access Policy Associations Exclusive",
+		},
 		{
 			Factory:  newPodIdentityAssociationResource,
 			TypeName: "aws_eks_pod_identity_association",
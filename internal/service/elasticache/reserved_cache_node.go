@@ -67,6 +67,9 @@ func (r *resourceReservedCacheNode) Schema(ctx context.Context, request resource
 			"cache_node_type": schema.StringAttribute{
 				Computed: true,
 			},
+			"confirm_purchase": schema.BoolAttribute{
+				Optional: true,
+			},
 			names.AttrDuration: schema.StringAttribute{
 				CustomType: fwtypes.RFC3339DurationType,
 				Computed:   true,
@@ -125,6 +128,45 @@ func (r *resourceReservedCacheNode) Schema(ctx context.Context, request resource
 	}
 }
 
+func (r *resourceReservedCacheNode) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceReservedCacheNodeModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only a new purchase, on create or on replacement, needs to be confirmed.
+	if !request.State.Raw.IsNull() {
+		var state resourceReservedCacheNodeModel
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.CacheNodeCount.Equal(state.CacheNodeCount) && plan.ID.Equal(state.ID) && plan.ReservedCacheNodesOfferingID.Equal(state.ReservedCacheNodesOfferingID) {
+			return
+		}
+	}
+
+	// The value may not be known until apply.
+	if plan.ConfirmPurchase.IsUnknown() {
+		return
+	}
+
+	if !plan.ConfirmPurchase.ValueBool() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("confirm_purchase"),
+			"Purchase Not Confirmed",
+			"Purchasing an ElastiCache Reserved Cache Node is a financial commitment that can't be cancelled. Set confirm_purchase to true to confirm the purchase.",
+		)
+	}
+}
+
 // Create is called when the provider must create a new resource.
 // Config and planned state values should be read from the CreateRequest and new state values set on the CreateResponse.
 func (r *resourceReservedCacheNode) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -219,6 +261,7 @@ type resourceReservedCacheNodeModel struct {
 	ReservationARN               types.String                                          `tfsdk:"arn"`
 	CacheNodeCount               types.Int32                                           `tfsdk:"cache_node_count"`
 	CacheNodeType                types.String                                          `tfsdk:"cache_node_type"`
+	ConfirmPurchase              types.Bool                                            `tfsdk:"confirm_purchase" autoflex:"-"`
 	Duration                     fwtypes.RFC3339Duration                               `tfsdk:"duration" autoflex:",noflatten"`
 	FixedPrice                   types.Float64                                         `tfsdk:"fixed_price"`
 	ID                           types.String                                          `tfsdk:"id"`
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReservedCacheNodeModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &resourceReservedCacheNode{}
+	var schemaResponse resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	s := schemaResponse.Schema
+
+	existing := map[string]tftypes.Value{
+		"cache_node_count":                 tftypes.NewValue(tftypes.Number, 1),
+		"id":                               tftypes.NewValue(tftypes.String, "reservation-1"),
+		"reserved_cache_nodes_offering_id": tftypes.NewValue(tftypes.String, "offering-1"),
+	}
+
+	testCases := map[string]struct {
+		state       map[string]tftypes.Value
+		plan        map[string]tftypes.Value
+		expectError bool
+	}{
+		"create not confirmed": {
+			plan: map[string]tftypes.Value{
+				"reserved_cache_nodes_offering_id": tftypes.NewValue(tftypes.String, "offering-1"),
+			},
+			expectError: true,
+		},
+		"create confirmed": {
+			plan: map[string]tftypes.Value{
+				"confirm_purchase":                 tftypes.NewValue(tftypes.Bool, true),
+				"reserved_cache_nodes_offering_id": tftypes.NewValue(tftypes.String, "offering-1"),
+			},
+		},
+		"create confirmation unknown": {
+			plan: map[string]tftypes.Value{
+				"confirm_purchase":                 tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+				"reserved_cache_nodes_offering_id": tftypes.NewValue(tftypes.String, "offering-1"),
+			},
+		},
+		"replace not confirmed": {
+			state: existing,
+			plan: map[string]tftypes.Value{
+				"cache_node_count":                 tftypes.NewValue(tftypes.Number, 1),
+				"id":                               tftypes.NewValue(tftypes.String, "reservation-1"),
+				"reserved_cache_nodes_offering_id": tftypes.NewValue(tftypes.String, "offering-2"),
+			},
+			expectError: true,
+		},
+		"replace confirmed": {
+			state: existing,
+			plan: map[string]tftypes.Value{
+				"cache_node_count":                 tftypes.NewValue(tftypes.Number, 2),
+				"confirm_purchase":                 tftypes.NewValue(tftypes.Bool, true),
+				"id":                               tftypes.NewValue(tftypes.String, "reservation-1"),
+				"reserved_cache_nodes_offering_id": tftypes.NewValue(tftypes.String, "offering-1"),
+			},
+		},
+		"existing not confirmed": {
+			state: existing,
+			plan:  existing,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: s, Raw: testReservedCacheNodeValue(ctx, s, testCase.plan)},
+				State: tfsdk.State{Schema: s, Raw: testReservedCacheNodeValue(ctx, s, testCase.state)},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: request.Plan,
+			}
+
+			r.ModifyPlan(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
+
+// testReservedCacheNodeValue returns a resource value with the specified attribute values and all other attributes null.
+// A nil map returns a null resource value.
+func testReservedCacheNodeValue(ctx context.Context, s schema.Schema, values map[string]tftypes.Value) tftypes.Value {
+	typ := s.Type().TerraformType(ctx).(tftypes.Object)
+
+	if values == nil {
+		return tftypes.NewValue(typ, nil)
+	}
+
+	attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for k, v := range typ.AttributeTypes {
+		if value, ok := values[k]; ok {
+			attributes[k] = value
+		} else {
+			attributes[k] = tftypes.NewValue(v, nil)
+		}
+	}
+
+	return tftypes.NewValue(typ, attributes)
+}
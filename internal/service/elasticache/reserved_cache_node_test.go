@@ -165,7 +165,8 @@ func testAccReservedInstanceExists(ctx context.Context, n string, reservation *a
 func testAccReservedInstanceConfig_Redis_basic() string {
 	return `
 resource "aws_elasticache_reserved_cache_node" "test" {
-  offering_id = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
+  offering_id      = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
+  confirm_purchase = true
 }
 
 data "aws_elasticache_reserved_cache_node_offering" "test" {
@@ -180,7 +181,8 @@ data "aws_elasticache_reserved_cache_node_offering" "test" {
 func testAccReservedInstanceConfig_Valkey_basic() string {
 	return `
 resource "aws_elasticache_reserved_cache_node" "test" {
-  offering_id = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
+  offering_id      = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
+  confirm_purchase = true
 }
 
 data "aws_elasticache_reserved_cache_node_offering" "test" {
@@ -195,8 +197,9 @@ data "aws_elasticache_reserved_cache_node_offering" "test" {
 func testAccReservedInstanceConfig_ID(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_elasticache_reserved_cache_node" "test" {
-  offering_id = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
-  id          = %[1]q
+  offering_id      = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
+  confirm_purchase = true
+  id               = %[1]q
 }
 
 data "aws_elasticache_reserved_cache_node_offering" "test" {
@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elastictranscoder
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elastictranscoder"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elastictranscoder/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_elastictranscoder_media_convert_settings", name="MediaConvert Settings")
+func dataSourceMediaConvertSettings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMediaConvertSettingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"pipeline_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"preset_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"settings_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unsupported_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceMediaConvertSettingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticTranscoderClient(ctx)
+
+	presetID := d.Get("preset_id").(string)
+	presetOutput, err := conn.ReadPreset(ctx, &elastictranscoder.ReadPresetInput{
+		Id: aws.String(presetID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Transcoder Preset (%s): %s", presetID, err)
+	}
+
+	var destination string
+	if v, ok := d.GetOk("pipeline_id"); ok {
+		pipelineID := v.(string)
+		pipelineOutput, err := conn.ReadPipeline(ctx, &elastictranscoder.ReadPipelineInput{
+			Id: aws.String(pipelineID),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Elastic Transcoder Pipeline (%s): %s", pipelineID, err)
+		}
+
+		bucket := aws.ToString(pipelineOutput.Pipeline.OutputBucket)
+		if bucket == "" && pipelineOutput.Pipeline.ContentConfig != nil {
+			bucket = aws.ToString(pipelineOutput.Pipeline.ContentConfig.Bucket)
+		}
+		if bucket != "" {
+			destination = "s3://" + bucket + "/"
+		}
+	}
+
+	settings, unsupported := presetToMediaConvertSettings(presetOutput.Preset, destination)
+
+	settingsJSON, err := json.Marshal(settings)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "encoding MediaConvert settings for Elastic Transcoder Preset (%s): %s", presetID, err)
+	}
+
+	d.SetId(presetID)
+	d.Set("settings_json", string(settingsJSON))
+	d.Set("unsupported_settings", unsupported)
+
+	return diags
+}
+
+// presetToMediaConvertSettings translates an Elastic Transcoder preset into the Settings of an
+// equivalent MediaConvert job template. Preset settings that have no MediaConvert equivalent
+// are returned as human-readable descriptions.
+func presetToMediaConvertSettings(preset *awstypes.Preset, destination string) (map[string]any, []string) {
+	var unsupported []string
+
+	output := map[string]any{}
+
+	container := aws.ToString(preset.Container)
+	switch container {
+	case "mp4":
+		output["ContainerSettings"] = map[string]any{"Container": "MP4"}
+	case "ts":
+		output["ContainerSettings"] = map[string]any{"Container": "M2TS"}
+	case "webm":
+		output["ContainerSettings"] = map[string]any{"Container": "WEBM"}
+	case "mpg":
+		output["ContainerSettings"] = map[string]any{"Container": "MPG"}
+	case "mxf":
+		output["ContainerSettings"] = map[string]any{"Container": "MXF"}
+	case "mp2", "mp3", "wav":
+		output["ContainerSettings"] = map[string]any{"Container": "RAW"}
+	default:
+		unsupported = append(unsupported, "container: "+container)
+	}
+
+	if v := preset.Video; v != nil {
+		videoDescription, u := videoParametersToMediaConvert(v)
+		unsupported = append(unsupported, u...)
+
+		if videoDescription != nil {
+			output["VideoDescription"] = videoDescription
+		}
+	}
+
+	if v := preset.Audio; v != nil && aws.ToString(v.Channels) != "0" {
+		audioDescription, u := audioParametersToMediaConvert(v)
+		unsupported = append(unsupported, u...)
+
+		if audioDescription != nil {
+			output["AudioDescriptions"] = []any{audioDescription}
+		}
+	}
+
+	if preset.Thumbnails != nil {
+		unsupported = append(unsupported, "thumbnails: use a separate MediaConvert frame capture output")
+	}
+
+	fileGroupSettings := map[string]any{}
+	if destination != "" {
+		fileGroupSettings["Destination"] = destination
+	}
+
+	inputs := []any{
+		map[string]any{
+			"AudioSelectors": map[string]any{
+				"Audio Selector 1": map[string]any{"DefaultSelection": "DEFAULT"},
+			},
+			"VideoSelector": map[string]any{},
+		},
+	}
+
+	settings := map[string]any{
+		"Inputs": inputs,
+		"OutputGroups": []any{
+			map[string]any{
+				"Name": "File Group",
+				"OutputGroupSettings": map[string]any{
+					"FileGroupSettings": fileGroupSettings,
+					"Type":              "FILE_GROUP_SETTINGS",
+				},
+				"Outputs": []any{output},
+			},
+		},
+	}
+
+	return settings, unsupported
+}
+
+func videoParametersToMediaConvert(v *awstypes.VideoParameters) (map[string]any, []string) {
+	var unsupported []string
+
+	codecSettings := map[string]any{}
+
+	switch codec := aws.ToString(v.Codec); codec {
+	case "H.264":
+		codecSettings["Codec"] = "H_264"
+		codecSettings["H264Settings"] = videoCodecSettingsToMediaConvert(v, &unsupported)
+	case "mpeg2":
+		codecSettings["Codec"] = "MPEG2"
+		codecSettings["Mpeg2Settings"] = videoCodecSettingsToMediaConvert(v, &unsupported)
+	case "vp8":
+		codecSettings["Codec"] = "VP8"
+		codecSettings["Vp8Settings"] = videoCodecSettingsToMediaConvert(v, &unsupported)
+	case "vp9":
+		codecSettings["Codec"] = "VP9"
+		codecSettings["Vp9Settings"] = videoCodecSettingsToMediaConvert(v, &unsupported)
+	default:
+		return nil, append(unsupported, "video.codec: "+codec)
+	}
+
+	videoDescription := map[string]any{
+		"CodecSettings": codecSettings,
+	}
+
+	if v, ok := parseIntValue(aws.ToString(v.MaxWidth)); ok {
+		videoDescription["Width"] = v
+	}
+
+	if v, ok := parseIntValue(aws.ToString(v.MaxHeight)); ok {
+		videoDescription["Height"] = v
+	}
+
+	if len(v.Watermarks) > 0 {
+		unsupported = append(unsupported, "video.watermarks: use MediaConvert image inserter")
+	}
+
+	return videoDescription, unsupported
+}
+
+func videoCodecSettingsToMediaConvert(v *awstypes.VideoParameters, unsupported *[]string) map[string]any {
+	codecSettings := map[string]any{
+		"RateControlMode": "VBR",
+	}
+
+	if bitRate, ok := parseIntValue(aws.ToString(v.BitRate)); ok {
+		codecSettings["Bitrate"] = bitRate * 1000
+	} else {
+		*unsupported = append(*unsupported, "video.bit_rate: auto has no MediaConvert equivalent; set Bitrate explicitly")
+	}
+
+	switch frameRate := aws.ToString(v.FrameRate); frameRate {
+	case "", "auto":
+		codecSettings["FramerateControl"] = "INITIALIZE_FROM_SOURCE"
+	default:
+		if numerator, denominator, ok := parseFrameRate(frameRate); ok {
+			codecSettings["FramerateControl"] = "SPECIFIED"
+			codecSettings["FramerateNumerator"] = numerator
+			codecSettings["FramerateDenominator"] = denominator
+		} else {
+			*unsupported = append(*unsupported, "video.frame_rate: "+frameRate)
+		}
+	}
+
+	if gopSize, ok := parseIntValue(aws.ToString(v.KeyframesMaxDist)); ok {
+		codecSettings["GopSize"] = gopSize
+	}
+
+	return codecSettings
+}
+
+func audioParametersToMediaConvert(v *awstypes.AudioParameters) (map[string]any, []string) {
+	var unsupported []string
+
+	settings := map[string]any{}
+
+	if bitRate, ok := parseIntValue(aws.ToString(v.BitRate)); ok {
+		settings["Bitrate"] = bitRate * 1000
+	}
+
+	if sampleRate, ok := parseIntValue(aws.ToString(v.SampleRate)); ok {
+		settings["SampleRate"] = sampleRate
+	}
+
+	channels, channelsOK := parseIntValue(aws.ToString(v.Channels))
+
+	codecSettings := map[string]any{}
+
+	switch codec := aws.ToString(v.Codec); codec {
+	case "AAC":
+		codingMode := "CODING_MODE_2_0"
+		if channelsOK && channels == 1 {
+			codingMode = "CODING_MODE_1_0"
+		}
+		settings["CodingMode"] = codingMode
+		codecSettings["Codec"] = "AAC"
+		codecSettings["AacSettings"] = settings
+	case "mp2":
+		if channelsOK {
+			settings["Channels"] = channels
+		}
+		codecSettings["Codec"] = "MP2"
+		codecSettings["Mp2Settings"] = settings
+	case "mp3":
+		if channelsOK {
+			settings["Channels"] = channels
+		}
+		settings["RateControlMode"] = "CBR"
+		codecSettings["Codec"] = "MP3"
+		codecSettings["Mp3Settings"] = settings
+	case "pcm":
+		delete(settings, "Bitrate")
+		if channelsOK {
+			settings["Channels"] = channels
+		}
+		if v := v.CodecOptions; v != nil {
+			if bitDepth, ok := parseIntValue(aws.ToString(v.BitDepth)); ok {
+				settings["BitDepth"] = bitDepth
+			}
+		}
+		codecSettings["Codec"] = "WAV"
+		codecSettings["WavSettings"] = settings
+	case "vorbis":
+		delete(settings, "Bitrate")
+		if channelsOK {
+			settings["Channels"] = channels
+		}
+		codecSettings["Codec"] = "VORBIS"
+		codecSettings["VorbisSettings"] = settings
+	default:
+		return nil, append(unsupported, "audio.codec: "+codec)
+	}
+
+	if !channelsOK {
+		unsupported = append(unsupported, "audio.channels: auto has no MediaConvert equivalent; set the channel layout explicitly")
+	}
+
+	audioDescription := map[string]any{
+		"AudioSourceName": "Audio Selector 1",
+		"CodecSettings":   codecSettings,
+	}
+
+	return audioDescription, unsupported
+}
+
+// parseIntValue parses an Elastic Transcoder numeric string setting, which may also be "auto".
+func parseIntValue(s string) (int, bool) {
+	v, err := strconv.Atoi(s)
+
+	if err != nil {
+		return 0, false
+	}
+
+	return v, true
+}
+
+// parseFrameRate converts an Elastic Transcoder frame rate to a MediaConvert numerator and denominator.
+func parseFrameRate(s string) (int, int, bool) {
+	switch s {
+	case "23.97":
+		return 24000, 1001, true
+	case "29.97":
+		return 30000, 1001, true
+	}
+
+	if strings.Contains(s, ".") {
+		return 0, 0, false
+	}
+
+	v, ok := parseIntValue(s)
+
+	return v, 1, ok
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elastictranscoder_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elastictranscoder/types"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfet "github.com/hashicorp/terraform-provider-aws/internal/service/elastictranscoder"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPresetToMediaConvertSettings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		preset              *awstypes.Preset
+		destination         string
+		expectedJSON        string
+		expectedUnsupported []string
+	}{
+		"H.264 AAC MP4": {
+			preset: &awstypes.Preset{
+				Container: aws.String("mp4"),
+				Video: &awstypes.VideoParameters{
+					BitRate:          aws.String("1600"),
+					Codec:            aws.String("H.264"),
+					FrameRate:        aws.String("29.97"),
+					KeyframesMaxDist: aws.String("90"),
+					MaxHeight:        aws.String("720"),
+					MaxWidth:         aws.String("1280"),
+				},
+				Audio: &awstypes.AudioParameters{
+					BitRate:    aws.String("160"),
+					Channels:   aws.String("2"),
+					Codec:      aws.String("AAC"),
+					SampleRate: aws.String("44100"),
+				},
+			},
+			destination:  "s3://example/",
+			expectedJSON: `{"Inputs":[{"AudioSelectors":{"Audio Selector 1":{"DefaultSelection":"DEFAULT"}},"VideoSelector":{}}],"OutputGroups":[{"Name":"File Group","OutputGroupSettings":{"FileGroupSettings":{"Destination":"s3://example/"},"Type":"FILE_GROUP_SETTINGS"},"Outputs":[{"AudioDescriptions":[{"AudioSourceName":"Audio Selector 1","CodecSettings":{"AacSettings":{"Bitrate":160000,"CodingMode":"CODING_MODE_2_0","SampleRate":44100},"Codec":"AAC"}}],"ContainerSettings":{"Container":"MP4"},"VideoDescription":{"CodecSettings":{"Codec":"H_264","H264Settings":{"Bitrate":1600000,"FramerateControl":"SPECIFIED","FramerateDenominator":1001,"FramerateNumerator":30000,"GopSize":90,"RateControlMode":"VBR"}},"Height":720,"Width":1280}}]}]}`,
+		},
+		"MP3 audio only": {
+			preset: &awstypes.Preset{
+				Container: aws.String("mp3"),
+				Audio: &awstypes.AudioParameters{
+					BitRate:    aws.String("320"),
+					Channels:   aws.String("2"),
+					Codec:      aws.String("mp3"),
+					SampleRate: aws.String("44100"),
+				},
+			},
+			expectedJSON: `{"Inputs":[{"AudioSelectors":{"Audio Selector 1":{"DefaultSelection":"DEFAULT"}},"VideoSelector":{}}],"OutputGroups":[{"Name":"File Group","OutputGroupSettings":{"FileGroupSettings":{},"Type":"FILE_GROUP_SETTINGS"},"Outputs":[{"AudioDescriptions":[{"AudioSourceName":"Audio Selector 1","CodecSettings":{"Codec":"MP3","Mp3Settings":{"Bitrate":320000,"Channels":2,"RateControlMode":"CBR","SampleRate":44100}}}],"ContainerSettings":{"Container":"RAW"}}]}]}`,
+		},
+		"unsupported settings": {
+			preset: &awstypes.Preset{
+				Container: aws.String("flv"),
+				Video: &awstypes.VideoParameters{
+					BitRate:   aws.String("auto"),
+					Codec:     aws.String("H.264"),
+					FrameRate: aws.String("auto"),
+					MaxHeight: aws.String("auto"),
+					MaxWidth:  aws.String("auto"),
+				},
+				Audio: &awstypes.AudioParameters{
+					Channels: aws.String("auto"),
+					Codec:    aws.String("flac"),
+				},
+				Thumbnails: &awstypes.Thumbnails{},
+			},
+			expectedJSON: `{"Inputs":[{"AudioSelectors":{"Audio Selector 1":{"DefaultSelection":"DEFAULT"}},"VideoSelector":{}}],"OutputGroups":[{"Name":"File Group","OutputGroupSettings":{"FileGroupSettings":{},"Type":"FILE_GROUP_SETTINGS"},"Outputs":[{"VideoDescription":{"CodecSettings":{"Codec":"H_264","H264Settings":{"FramerateControl":"INITIALIZE_FROM_SOURCE","RateControlMode":"VBR"}}}}]}]}`,
+			expectedUnsupported: []string{
+				"container: flv",
+				"video.bit_rate: auto has no MediaConvert equivalent; set Bitrate explicitly",
+				"audio.codec: flac",
+				"thumbnails: use a separate MediaConvert frame capture output",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			settings, unsupported := tfet.PresetToMediaConvertSettings(testCase.preset, testCase.destination)
+
+			got, err := json.Marshal(settings)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expectedJSON); diff != "" {
+				t.Errorf("unexpected settings diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(unsupported, testCase.expectedUnsupported); diff != "" {
+				t.Errorf("unexpected unsupported settings diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccElasticTranscoderMediaConvertSettingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_elastictranscoder_media_convert_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticTranscoderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPresetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertSettingsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "preset_id", "aws_elastictranscoder_preset.test", names.AttrID),
+					acctest.CheckResourceAttrJMES(dataSourceName, "settings_json", "OutputGroups[0].Outputs[0].ContainerSettings.Container", "MP4"),
+					acctest.CheckResourceAttrJMES(dataSourceName, "settings_json", "OutputGroups[0].Outputs[0].AudioDescriptions[0].CodecSettings.Codec", "MP3"),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported_settings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMediaConvertSettingsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPresetConfig_basic(rName), `
+data "aws_elastictranscoder_media_convert_settings" "test" {
+  preset_id = aws_elastictranscoder_preset.test.id
+}
+`)
+}
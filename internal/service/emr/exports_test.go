@@ -22,4 +22,6 @@ var (
 	FindSecurityConfigurationByName    = findSecurityConfigurationByName
 	FindStudioByID                     = findStudioByID
 	FindStudioSessionMappingByIDOrName = findStudioSessionMappingByIDOrName
+
+	IsIdentityID = isIdentityID
 )
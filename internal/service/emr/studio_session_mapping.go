@@ -194,7 +194,7 @@ func resourceStudioSessionMappingDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
-const identityIDPattern = `([0-9a-f]{10}-|)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}`
+const identityIDPattern = `^([0-9a-f]{10}-|)[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`
 
 var identityIDPatternRegexp = regexache.MustCompile(identityIDPattern)
 
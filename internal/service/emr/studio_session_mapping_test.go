@@ -20,6 +20,46 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestIsIdentityID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected bool
+	}{
+		"user name": {
+			input:    "jdoe",
+			expected: false,
+		},
+		"UUID": {
+			input:    "4a9f5d2e-0b3c-4d6e-8f1a-2b3c4d5e6f70",
+			expected: true,
+		},
+		"identity store ID with prefix": {
+			input:    "9067e3b2c1-4a9f5d2e-0b3c-4d6e-8f1a-2b3c4d5e6f70",
+			expected: true,
+		},
+		"name containing UUID": {
+			input:    "team-4a9f5d2e-0b3c-4d6e-8f1a-2b3c4d5e6f70",
+			expected: false,
+		},
+		"UUID with suffix": {
+			input:    "4a9f5d2e-0b3c-4d6e-8f1a-2b3c4d5e6f70-admins",
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfemr.IsIdentityID(testCase.input), testCase.expected; got != want {
+				t.Errorf("IsIdentityID(%q) = %t, want %t", testCase.input, got, want)
+			}
+		})
+	}
+}
+
 func TestAccEMRStudioSessionMapping_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var studio awstypes.SessionMappingDetail
@@ -177,6 +177,40 @@ func TestAccEMRStudio_workspaceStorageEncryption(t *testing.T) {
 	})
 }
 
+func TestAccEMRStudio_identityCenter(t *testing.T) {
+	ctx := acctest.Context(t)
+	var studio awstypes.Studio
+	resourceName := "aws_emr_studio.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStudioDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStudioConfig_identityCenter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioExists(ctx, resourceName, &studio),
+					resource.TestCheckResourceAttr(resourceName, "auth_mode", "SSO"),
+					resource.TestCheckResourceAttrPair(resourceName, "idc_instance_arn", "data.aws_ssoadmin_instances.test", "arns.0"),
+					resource.TestCheckResourceAttr(resourceName, "idc_user_assignment", "OPTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "trusted_identity_propagation_enabled", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEMRStudio_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var studio awstypes.Studio
@@ -457,6 +491,27 @@ resource "aws_emr_studio" "test" {
 `, rName))
 }
 
+func testAccStudioConfig_identityCenter(rName string) string {
+	return acctest.ConfigCompose(testAccStudioConfig_base(rName), fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_emr_studio" "test" {
+  auth_mode                            = "SSO"
+  default_s3_location                  = "s3://${aws_s3_bucket.test.bucket}/test"
+  engine_security_group_id             = aws_security_group.test.id
+  idc_instance_arn                     = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  idc_user_assignment                  = "OPTIONAL"
+  name                                 = %[1]q
+  service_role                         = aws_iam_role.test.arn
+  subnet_ids                           = aws_subnet.test[*].id
+  trusted_identity_propagation_enabled = true
+  user_role                            = aws_iam_role.test.arn
+  vpc_id                               = aws_vpc.test.id
+  workspace_security_group_id          = aws_security_group.test.id
+}
+`, rName))
+}
+
 func testAccStudioConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccStudioConfig_base(rName), fmt.Sprintf(`
 resource "aws_emr_studio" "test" {
@@ -5,6 +5,7 @@ package events
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -17,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -58,6 +61,13 @@ func resourceTarget() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			validateTargetRetryPolicy,
+			customdiff.ComputedIf("dead_letter_queue_policy", func(_ context.Context, d *schema.ResourceDiff, meta any) bool {
+				return d.HasChanges("dead_letter_config", "event_bus_name", names.AttrRule)
+			}),
+		),
+
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -125,9 +135,18 @@ func resourceTarget() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: verify.ValidARN,
 						},
+						"generate_queue_policy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
+			"dead_letter_queue_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"ecs_target": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -412,7 +431,7 @@ func resourceTarget() *schema.Resource {
 						"maximum_event_age_in_seconds": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							ValidateFunc: validation.IntBetween(0, 86400),
+							ValidateFunc: validation.IntBetween(60, 86400),
 						},
 						"maximum_retry_attempts": {
 							Type:         schema.TypeInt,
@@ -629,11 +648,29 @@ func resourceTargetRead(ctx context.Context, d *schema.ResourceData, meta any) d
 		}
 	}
 
+	var deadLetterQueuePolicy string
 	if target.DeadLetterConfig != nil {
-		if err := d.Set("dead_letter_config", flattenTargetDeadLetterConfig(target.DeadLetterConfig)); err != nil {
+		generateQueuePolicy := d.Get("dead_letter_config.0.generate_queue_policy").(bool)
+
+		if err := d.Set("dead_letter_config", flattenTargetDeadLetterConfig(target.DeadLetterConfig, generateQueuePolicy)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting dead_letter_config: %s", err)
 		}
+
+		if deadLetterARN := aws.ToString(target.DeadLetterConfig.Arn); generateQueuePolicy && deadLetterARN != "" {
+			rule, err := findRuleByTwoPartKey(ctx, conn, eventBusName, d.Get(names.AttrRule).(string))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EventBridge Rule (%s): %s", d.Get(names.AttrRule).(string), err)
+			}
+
+			deadLetterQueuePolicy, err = targetDeadLetterQueuePolicy(deadLetterARN, aws.ToString(rule.Arn))
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
 	}
+	d.Set("dead_letter_queue_policy", deadLetterQueuePolicy)
 
 	if target.AppSyncParameters != nil {
 		if err := d.Set("appsync_target", flattenAppSyncParameters(target.AppSyncParameters)); err != nil {
@@ -829,6 +866,67 @@ func removeTargetsError(apiObjects []types.RemoveTargetsResultEntry) error {
 	return errors.Join(errs...)
 }
 
+// validateTargetRetryPolicy checks retry_policy and dead_letter_config argument combinations at plan time.
+func validateTargetRetryPolicy(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if v := d.GetRawConfig().GetAttr("retry_policy"); v.IsKnown() && !v.IsNull() {
+		for _, v := range v.AsValueSlice() {
+			if !v.IsKnown() || v.IsNull() {
+				continue
+			}
+
+			if maximumEventAge, maximumRetryAttempts := v.GetAttr("maximum_event_age_in_seconds"), v.GetAttr("maximum_retry_attempts"); maximumEventAge.IsNull() && maximumRetryAttempts.IsNull() {
+				return errors.New("retry_policy: at least one of maximum_event_age_in_seconds or maximum_retry_attempts must be configured")
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("dead_letter_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tfMap := v.([]any)[0].(map[string]any)
+
+		if tfMap["generate_queue_policy"].(bool) && tfMap[names.AttrARN].(string) == "" && d.NewValueKnown("dead_letter_config.0.arn") {
+			return errors.New("dead_letter_config: arn must be configured when generate_queue_policy is true")
+		}
+	}
+
+	return nil
+}
+
+// targetDeadLetterQueuePolicy returns an SQS queue policy document that allows the specified rule to send
+// events that could not be delivered to its target to the dead-letter queue.
+func targetDeadLetterQueuePolicy(queueARN, ruleARN string) (string, error) {
+	policy := tfiam.IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*tfiam.IAMPolicyStatement{
+			{
+				Effect:    "Allow",
+				Actions:   "sqs:SendMessage",
+				Resources: queueARN,
+				Principals: tfiam.IAMPolicyStatementPrincipalSet{
+					{
+						Type:        "Service",
+						Identifiers: "events.amazonaws.com",
+					},
+				},
+				Conditions: tfiam.IAMPolicyStatementConditionSet{
+					{
+						Test:     "ArnEquals",
+						Variable: "aws:SourceArn",
+						Values:   ruleARN,
+					},
+				},
+			},
+		},
+	}
+
+	v, err := json.Marshal(policy)
+
+	if err != nil {
+		return "", fmt.Errorf("generating dead-letter queue policy: %w", err)
+	}
+
+	return string(v), nil
+}
+
 func expandPutTargetsInput(ctx context.Context, d *schema.ResourceData) *eventbridge.PutTargetsInput {
 	target := types.Target{
 		Arn: aws.String(d.Get(names.AttrARN).(string)),
@@ -1010,7 +1108,7 @@ func expandRetryPolicyParameters(rp []any) *types.RetryPolicy {
 	for _, v := range rp {
 		params := v.(map[string]any)
 
-		if val, ok := params["maximum_event_age_in_seconds"].(int); ok {
+		if val, ok := params["maximum_event_age_in_seconds"].(int); ok && val != 0 {
 			retryPolicy.MaximumEventAgeInSeconds = aws.Int32(int32(val))
 		}
 
@@ -1357,10 +1455,11 @@ func flattenTargetRetryPolicy(rp *types.RetryPolicy) []map[string]any {
 	return result
 }
 
-func flattenTargetDeadLetterConfig(dlc *types.DeadLetterConfig) []map[string]any {
+func flattenTargetDeadLetterConfig(dlc *types.DeadLetterConfig, generateQueuePolicy bool) []map[string]any {
 	config := make(map[string]any)
 
 	config[names.AttrARN] = aws.ToString(dlc.Arn)
+	config["generate_queue_policy"] = generateQueuePolicy
 
 	result := []map[string]any{config}
 	return result
@@ -416,6 +416,68 @@ func TestAccEventsTarget_RetryPolicy_deadLetter(t *testing.T) {
 	})
 }
 
+func TestAccEventsTarget_RetryPolicy_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTargetConfig_retryPolicyEmpty(rName),
+				ExpectError: regexache.MustCompile(`at least one of maximum_event_age_in_seconds or maximum_retry_attempts must be configured`),
+			},
+		},
+	})
+}
+
+func TestAccEventsTarget_DeadLetter_generateQueuePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Target
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_target.test"
+	queueResourceName := "aws_sqs_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetConfig_deadLetterGenerateQueuePolicy(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_config.0.arn", queueResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_config.0.generate_queue_policy", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "dead_letter_queue_policy"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.maximum_event_age_in_seconds", "0"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.maximum_retry_attempts", "3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dead_letter_config.0.generate_queue_policy", "dead_letter_queue_policy", names.AttrForceDestroy},
+			},
+			{
+				Config: testAccTargetConfig_deadLetterGenerateQueuePolicy(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_config.0.generate_queue_policy", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_queue_policy", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEventsTarget_full(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.Target
@@ -1525,6 +1587,61 @@ data "aws_partition" "current" {}
 `, rName)
 }
 
+func testAccTargetConfig_retryPolicyEmpty(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  rule      = aws_cloudwatch_event_rule.test.name
+  target_id = %[1]q
+  arn       = aws_sns_topic.test.arn
+
+  retry_policy {}
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccTargetConfig_deadLetterGenerateQueuePolicy(rName string, generateQueuePolicy bool) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_rule" "test" {
+  name                = %[1]q
+  schedule_expression = "rate(1 hour)"
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  rule      = aws_cloudwatch_event_rule.test.name
+  target_id = %[1]q
+  arn       = aws_sns_topic.test.arn
+
+  retry_policy {
+    maximum_retry_attempts = 3
+  }
+
+  dead_letter_config {
+    arn                   = aws_sqs_queue.test.arn
+    generate_queue_policy = %[2]t
+  }
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  sqs_managed_sse_enabled = true
+}
+`, rName, generateQueuePolicy)
+}
+
 func testAccTargetConfig_full(ruleName, targetName, rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "test" {
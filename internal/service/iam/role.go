@@ -10,6 +10,7 @@ import (
 	"log"
 	"net/url"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -37,6 +38,9 @@ import (
 const (
 	roleNameMaxLen       = 64
 	roleNamePrefixMaxLen = roleNameMaxLen - id.UniqueIDSuffixLength
+
+	// roleInlinePolicyReadConcurrency bounds the number of concurrent GetRolePolicy calls made when reading a role.
+	roleInlinePolicyReadConcurrency = 4
 )
 
 // @SDKResource("aws_iam_role", name="Role")
@@ -87,6 +91,12 @@ func resourceRole() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"ignore_inline_policies": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"inline_policy"},
+			},
 			"inline_policy": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -319,19 +329,27 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 
 	d.Set("assume_role_policy", policyToSet)
 
-	inlinePolicies, err := readRoleInlinePolicies(ctx, conn, aws.ToString(role.RoleName))
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading inline policies for IAM role %s, error: %s", d.Id(), err)
-	}
+	switch v := d.Get("inline_policy").(*schema.Set); {
+	case d.Get("ignore_inline_policies").(bool):
+		// Inline policies are managed outside of this resource, e.g. with aws_iam_role_policy.
+		d.Set("inline_policy", nil)
+	case v.Len() == 0 && meta.(*conns.AWSClient).SkipIAMRoleInlinePolicyRead(ctx):
+		// Skip the per-policy reads for roles that don't manage inline policies.
+	default:
+		inlinePolicies, err := readRoleInlinePolicies(ctx, conn, aws.ToString(role.RoleName))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading inline policies for IAM role %s, error: %s", d.Id(), err)
+		}
 
-	var configPoliciesList []*iam.PutRolePolicyInput
-	if v := d.Get("inline_policy").(*schema.Set); v.Len() > 0 {
-		configPoliciesList = expandRoleInlinePolicies(aws.ToString(role.RoleName), v.List())
-	}
+		var configPoliciesList []*iam.PutRolePolicyInput
+		if v.Len() > 0 {
+			configPoliciesList = expandRoleInlinePolicies(aws.ToString(role.RoleName), v.List())
+		}
 
-	if !inlinePoliciesEquivalent(inlinePolicies, configPoliciesList) {
-		if err := d.Set("inline_policy", flattenRoleInlinePolicies(inlinePolicies)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting inline_policy: %s", err)
+		if !inlinePoliciesEquivalent(inlinePolicies, configPoliciesList) {
+			if err := d.Set("inline_policy", flattenRoleInlinePolicies(inlinePolicies)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting inline_policy: %s", err)
+			}
 		}
 	}
 
@@ -431,7 +449,7 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 		}
 	}
 
-	if d.HasChange("inline_policy") && inlinePoliciesActualDiff(d) {
+	if !d.Get("ignore_inline_policies").(bool) && d.HasChange("inline_policy") && inlinePoliciesActualDiff(d) {
 		roleName := d.Get(names.AttrName).(string)
 
 		o, n := d.GetChange("inline_policy")
@@ -514,6 +532,7 @@ func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta any) d
 
 func resourceRoleImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	d.Set("force_detach_policies", false)
+	d.Set("ignore_inline_policies", false)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -887,40 +906,81 @@ func readRoleInlinePolicies(ctx context.Context, conn *iam.Client, roleName stri
 		return nil, err
 	}
 
-	var apiObjects []*iam.PutRolePolicyInput
+	if len(policyNames) == 0 {
+		return nil, nil
+	}
 
-	for _, policyName := range policyNames {
-		output, err := conn.GetRolePolicy(ctx, &iam.GetRolePolicyInput{
-			RoleName:   aws.String(roleName),
-			PolicyName: aws.String(policyName),
-		})
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		errList []error
+	)
 
-		if err != nil {
-			return nil, err
-		}
+	// Results are stored by index so that the order returned by ListRolePolicies is preserved.
+	apiObjects := make([]*iam.PutRolePolicyInput, len(policyNames))
+	sem := make(chan struct{}, roleInlinePolicyReadConcurrency)
+	for i, policyName := range policyNames {
+		wg.Add(1)
+		sem <- struct{}{}
 
-		policy, err := url.QueryUnescape(aws.ToString(output.PolicyDocument))
-		if err != nil {
-			return nil, err
-		}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
 
-		p, err := verify.LegacyPolicyNormalize(policy)
-		if err != nil {
-			return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", p, err)
-		}
+			apiObject, err := readRoleInlinePolicy(ctx, conn, roleName, policyName)
 
-		apiObject := &iam.PutRolePolicyInput{
-			RoleName:       aws.String(roleName),
-			PolicyDocument: aws.String(p),
-			PolicyName:     aws.String(policyName),
-		}
+			if err != nil {
+				mu.Lock()
+				errList = append(errList, err)
+				mu.Unlock()
 
-		apiObjects = append(apiObjects, apiObject)
+				return
+			}
+
+			apiObjects[i] = apiObject
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errList...); err != nil {
+		return nil, err
 	}
 
 	return apiObjects, nil
 }
 
+func readRoleInlinePolicy(ctx context.Context, conn *iam.Client, roleName, policyName string) (*iam.PutRolePolicyInput, error) {
+	output, err := conn.GetRolePolicy(ctx, &iam.GetRolePolicyInput{
+		RoleName:   aws.String(roleName),
+		PolicyName: aws.String(policyName),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := url.QueryUnescape(aws.ToString(output.PolicyDocument))
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := verify.LegacyPolicyNormalize(policy)
+	if err != nil {
+		return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", p, err)
+	}
+
+	apiObject := &iam.PutRolePolicyInput{
+		RoleName:       aws.String(roleName),
+		PolicyDocument: aws.String(p),
+		PolicyName:     aws.String(policyName),
+	}
+
+	return apiObject, nil
+}
+
 func inlinePoliciesActualDiff(d *schema.ResourceData) bool {
 	roleName := d.Get(names.AttrName).(string)
 	o, n := d.GetChange("inline_policy")
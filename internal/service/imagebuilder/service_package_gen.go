@@ -105,6 +105,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_imagebuilder_infrastructure_configurations",
 			Name:     "Infrastructure Configurations",
 		},
+		{
+			Factory:  dataSourceWorkflow,
+			TypeName: "aws_imagebuilder_workflow",
+			Name:     "Workflow",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 	}
 }
 
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package imagebuilder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_imagebuilder_workflow", name="Workflow")
+// @Tags
+func dataSourceWorkflow() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceWorkflowRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"change_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrKMSKeyID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrOwner: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceWorkflowRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderClient(ctx)
+
+	arn := d.Get(names.AttrARN).(string)
+	workflow, err := findWorkflowByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Image Builder Workflow (%s): %s", arn, err)
+	}
+
+	arn = aws.ToString(workflow.Arn)
+	d.SetId(arn)
+	d.Set(names.AttrARN, arn)
+	d.Set("change_description", workflow.ChangeDescription)
+	d.Set("data", workflow.Data)
+	d.Set("date_created", workflow.DateCreated)
+	d.Set(names.AttrDescription, workflow.Description)
+	d.Set(names.AttrKMSKeyID, workflow.KmsKeyId)
+	d.Set(names.AttrName, workflow.Name)
+	d.Set(names.AttrOwner, workflow.Owner)
+	d.Set(names.AttrType, workflow.Type)
+	d.Set(names.AttrVersion, workflow.Version)
+
+	setTagsOut(ctx, workflow.Tags)
+
+	return diags
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_kms_key_rotations", name="Key Rotations")
+func newKeyRotationsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &keyRotationsDataSource{}, nil
+}
+
+type keyRotationsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *keyRotationsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+			},
+			"rotations": framework.DataSourceComputedListOfObjectAttribute[rotationsListEntryModel](ctx),
+		},
+	}
+}
+
+func (d *keyRotationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data keyRotationsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().KMSClient(ctx)
+
+	keyID := data.KeyID.ValueString()
+	input := &kms.ListKeyRotationsInput{
+		KeyId: aws.String(keyID),
+	}
+	rotations, err := findKeyRotations(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing KMS Key (%s) rotations", keyID), err.Error())
+
+		return
+	}
+
+	output := &kms.ListKeyRotationsOutput{
+		Rotations: rotations,
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findKeyRotations(ctx context.Context, conn *kms.Client, input *kms.ListKeyRotationsInput) ([]awstypes.RotationsListEntry, error) {
+	var output []awstypes.RotationsListEntry
+
+	pages := kms.NewListKeyRotationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Rotations...)
+	}
+
+	return output, nil
+}
+
+type keyRotationsDataSourceModel struct {
+	KeyID     types.String                                             `tfsdk:"key_id"`
+	Rotations fwtypes.ListNestedObjectValueOf[rotationsListEntryModel] `tfsdk:"rotations"`
+}
+
+type rotationsListEntryModel struct {
+	KeyID        types.String                              `tfsdk:"key_id"`
+	RotationDate timetypes.RFC3339                         `tfsdk:"rotation_date"`
+	RotationType fwtypes.StringEnum[awstypes.RotationType] `tfsdk:"rotation_type"`
+}
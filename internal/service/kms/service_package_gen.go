@@ -25,7 +25,13 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newKeyRotationsDataSource,
+			TypeName: "aws_kms_key_rotations",
+			Name:     "Key Rotations",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
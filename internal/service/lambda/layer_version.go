@@ -41,6 +41,8 @@ func resourceLayerVersion() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: layerVersionSourceDirCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -84,7 +86,7 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version"},
+				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version", "source_dir"},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -105,19 +107,19 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -139,6 +141,12 @@ func resourceLayerVersion() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filename", names.AttrS3Bucket, "s3_key", "s3_object_version", "source_code_hash"},
+			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -157,16 +165,29 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 
 	layerName := d.Get("layer_name").(string)
 	filename, hasFilename := d.GetOk("filename")
+	sourceDir, hasSourceDir := d.GetOk("source_dir")
 	s3Bucket, bucketOk := d.GetOk(names.AttrS3Bucket)
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return sdkdiag.AppendErrorf(diags, "filename or s3_* attributes must be set")
+	if !hasFilename && !hasSourceDir && !bucketOk && !keyOk && !versionOk {
+		return sdkdiag.AppendErrorf(diags, "filename, source_dir or s3_* attributes must be set")
 	}
 
 	var layerContent *awstypes.LayerVersionContentInput
-	if hasFilename {
+	if hasSourceDir {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+
+		file, err := tfio.ZipDirectoryContents(sourceDir.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ZIP file from directory (%s): %s", sourceDir, err)
+		}
+
+		layerContent = &awstypes.LayerVersionContentInput{
+			ZipFile: file,
+		}
+	} else if hasFilename {
 		conns.GlobalMutexKV.Lock(mutexLayerKey)
 		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
 
@@ -299,6 +320,35 @@ func layerVersionParseResourceID(id string) (layerName string, version int64, er
 	return
 }
 
+// layerVersionSourceDirCustomizeDiff sets source_code_hash to the hash of the ZIP archive built from source_dir
+// so that changes to the directory's contents publish a new layer version.
+func layerVersionSourceDirCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	v, ok := d.GetOk("source_dir")
+	if !ok || !d.NewValueKnown("source_dir") {
+		return nil
+	}
+
+	conns.GlobalMutexKV.Lock(mutexLayerKey)
+	defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+
+	file, err := tfio.ZipDirectoryContents(v.(string))
+	if err != nil {
+		return fmt.Errorf("creating ZIP file from directory (%s): %w", v, err)
+	}
+
+	if hash := tfio.Base64SHA256(file); d.Get("source_code_hash").(string) != hash {
+		if err := d.SetNew("source_code_hash", hash); err != nil {
+			return err
+		}
+
+		if d.Id() != "" {
+			return d.ForceNew("source_code_hash")
+		}
+	}
+
+	return nil
+}
+
 func findLayerVersionByTwoPartKey(ctx context.Context, conn *lambda.Client, layerName string, versionNumber int64) (*lambda.GetLayerVersionOutput, error) {
 	input := &lambda.GetLayerVersionInput{
 		LayerName:     aws.String(layerName),
@@ -5,6 +5,7 @@ package meta
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -49,6 +50,15 @@ func (d *arnDataSource) Schema(ctx context.Context, request datasource.SchemaReq
 			"resource": schema.StringAttribute{
 				Computed: true,
 			},
+			names.AttrResourceID: schema.StringAttribute{
+				Computed: true,
+			},
+			"resource_qualifier": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				Computed: true,
+			},
 			"service": schema.StringAttribute{
 				Computed: true,
 			},
@@ -70,17 +80,60 @@ func (d *arnDataSource) Read(ctx context.Context, request datasource.ReadRequest
 	data.Partition = fwflex.StringValueToFrameworkLegacy(ctx, arn.Partition)
 	data.Region = fwflex.StringValueToFrameworkLegacy(ctx, arn.Region)
 	data.Resource = fwflex.StringValueToFrameworkLegacy(ctx, arn.Resource)
+	resourceType, resourceID, resourceQualifier := parseARNResource(arn.Service, arn.Resource)
+	data.ResourceID = fwflex.StringValueToFrameworkLegacy(ctx, resourceID)
+	data.ResourceQualifier = fwflex.StringValueToFrameworkLegacy(ctx, resourceQualifier)
+	data.ResourceType = fwflex.StringValueToFrameworkLegacy(ctx, resourceType)
 	data.Service = fwflex.StringValueToFrameworkLegacy(ctx, arn.Service)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+// parseARNResource splits the resource part of an ARN into resource type, resource ID and qualifier.
+// Most services use one of the formats
+//
+//	resource-type/resource-id
+//	resource-type:resource-id
+//	resource-type:resource-id:qualifier
+//	resource-type/resource-id:qualifier
+//
+// Services whose ARNs have no resource type are handled separately.
+func parseARNResource(service, resource string) (string, string, string) {
+	switch service {
+	case "s3":
+		// arn:aws:s3:::bucket, arn:aws:s3:::bucket/key.
+		// S3 Control and S3 Express ARNs use the generic format.
+		return "", resource, ""
+	case "sns", "sqs":
+		// arn:aws:sns:region:account:topic, arn:aws:sns:region:account:topic:subscription-id.
+		// arn:aws:sqs:region:account:queue.
+		resourceID, qualifier, _ := strings.Cut(resource, ":")
+		return "", resourceID, qualifier
+	}
+
+	i := strings.IndexAny(resource, ":/")
+	if i == -1 {
+		return "", resource, ""
+	}
+
+	resourceType, rest := resource[:i], resource[i+1:]
+
+	if resourceID, qualifier, ok := strings.Cut(rest, ":"); ok {
+		return resourceType, resourceID, qualifier
+	}
+
+	return resourceType, rest, ""
+}
+
 type arnDataSourceModel struct {
-	Account   types.String `tfsdk:"account"`
-	ARN       fwtypes.ARN  `tfsdk:"arn"`
-	ID        types.String `tfsdk:"id"`
-	Partition types.String `tfsdk:"partition"`
-	Region    types.String `tfsdk:"region"`
-	Resource  types.String `tfsdk:"resource"`
-	Service   types.String `tfsdk:"service"`
+	Account           types.String `tfsdk:"account"`
+	ARN               fwtypes.ARN  `tfsdk:"arn"`
+	ID                types.String `tfsdk:"id"`
+	Partition         types.String `tfsdk:"partition"`
+	Region            types.String `tfsdk:"region"`
+	Resource          types.String `tfsdk:"resource"`
+	ResourceID        types.String `tfsdk:"resource_id"`
+	ResourceQualifier types.String `tfsdk:"resource_qualifier"`
+	ResourceType      types.String `tfsdk:"resource_type"`
+	Service           types.String `tfsdk:"service"`
 }
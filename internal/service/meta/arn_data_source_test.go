@@ -13,6 +13,109 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestParseARNResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName          string
+		service           string
+		resource          string
+		expectedType      string
+		expectedID        string
+		expectedQualifier string
+	}{
+		{
+			testName:     "colon separator",
+			service:      "rds",
+			resource:     "db:mysql-db",
+			expectedType: "db",
+			expectedID:   "mysql-db",
+		},
+		{
+			testName:     "slash separator",
+			service:      "ec2",
+			resource:     "instance/i-1234567890abcdef0",
+			expectedType: "instance",
+			expectedID:   "i-1234567890abcdef0",
+		},
+		{
+			testName:     "slash separator with path",
+			service:      "iam",
+			resource:     "role/service-role/my-role",
+			expectedType: "role",
+			expectedID:   "service-role/my-role",
+		},
+		{
+			testName:          "colon separator with qualifier",
+			service:           "lambda",
+			resource:          "function:my-function:PROD",
+			expectedType:      "function",
+			expectedID:        "my-function",
+			expectedQualifier: "PROD",
+		},
+		{
+			testName:          "slash separator with qualifier",
+			service:           "ecs",
+			resource:          "task-definition/my-family:3",
+			expectedType:      "task-definition",
+			expectedID:        "my-family",
+			expectedQualifier: "3",
+		},
+		{
+			testName:     "Redshift integration",
+			service:      "redshift",
+			resource:     "integration:a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			expectedType: "integration",
+			expectedID:   "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+		},
+		{
+			testName:   "no separator",
+			service:    "codecommit",
+			resource:   "my-repository",
+			expectedID: "my-repository",
+		},
+		{
+			testName:   "S3 object",
+			service:    "s3",
+			resource:   "my_corporate_bucket/Development/*",
+			expectedID: "my_corporate_bucket/Development/*",
+		},
+		{
+			testName:          "SNS subscription",
+			service:           "sns",
+			resource:          "my-topic:a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			expectedID:        "my-topic",
+			expectedQualifier: "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+		},
+		{
+			testName:   "SQS queue",
+			service:    "sqs",
+			resource:   "my-queue",
+			expectedID: "my-queue",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			gotType, gotID, gotQualifier := tfmeta.ParseARNResource(testCase.service, testCase.resource)
+
+			if got, want := gotType, testCase.expectedType; got != want {
+				t.Errorf("resource type = %q, want %q", got, want)
+			}
+
+			if got, want := gotID, testCase.expectedID; got != want {
+				t.Errorf("resource ID = %q, want %q", got, want)
+			}
+
+			if got, want := gotQualifier, testCase.expectedQualifier; got != want {
+				t.Errorf("resource qualifier = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestAccMetaARNDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	arn := "arn:aws:rds:eu-west-1:123456789012:db:mysql-db" // lintignore:AWSAT003,AWSAT005
@@ -31,6 +134,9 @@ func TestAccMetaARNDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "partition", "aws"),
 					resource.TestCheckResourceAttr(dataSourceName, names.AttrRegion, "eu-west-1"), // lintignore:AWSAT003
 					resource.TestCheckResourceAttr(dataSourceName, "resource", "db:mysql-db"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrResourceID, "mysql-db"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_qualifier", ""),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrResourceType, "db"),
 					resource.TestCheckResourceAttr(dataSourceName, "service", "rds"),
 				),
 			},
@@ -56,6 +162,9 @@ func TestAccMetaARNDataSource_s3Bucket(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "partition", "aws"),
 					resource.TestCheckResourceAttr(dataSourceName, names.AttrRegion, ""),
 					resource.TestCheckResourceAttr(dataSourceName, "resource", "my_corporate_bucket/Development/*"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrResourceID, "my_corporate_bucket/Development/*"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_qualifier", ""),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrResourceType, ""),
 					resource.TestCheckResourceAttr(dataSourceName, "service", "s3"),
 				),
 			},
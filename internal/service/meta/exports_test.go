@@ -7,4 +7,5 @@ package meta
 var (
 	FindRegionByEC2Endpoint = findRegionByEC2Endpoint
 	FindRegionByName        = findRegionByName
+	ParseARNResource        = parseARNResource
 )
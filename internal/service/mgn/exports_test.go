@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+// Exports for use in tests only.
+var (
+	ResourceApplication    = newApplicationResource
+	ResourceTemplateAction = newTemplateActionResource
+	ResourceWave           = newWaveResource
+
+	FindApplicationByID            = findApplicationByID
+	FindTemplateActionByTwoPartKey = findTemplateActionByTwoPartKey
+	FindWaveByID                   = findWaveByID
+)
@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_template_action", name="Template Action")
+func newTemplateActionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &templateActionResource{}, nil
+}
+
+type templateActionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *templateActionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"action_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-zA-Z]$|^[0-9a-zA-Z][0-9a-zA-Z_-]*[0-9a-zA-Z]$`), ""),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"category": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ActionCategory](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			"document_identifier": schema.StringAttribute{
+				Required: true,
+			},
+			"document_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"launch_configuration_template_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"must_succeed_for_cutover": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"operating_system": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("LINUX", "WINDOWS"),
+				},
+			},
+			"order": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1001, 10000),
+				},
+			},
+			"timeout_seconds": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *templateActionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	var input mgn.PutTemplateActionInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.PutTemplateAction(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Application Migration Service Template Action (%s)", data.ActionID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("creating Application Migration Service Template Action", err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *templateActionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	output, err := findTemplateActionByTwoPartKey(ctx, conn, data.LaunchConfigurationTemplateID.ValueString(), data.ActionID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Application Migration Service Template Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateActionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data templateActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	// PutTemplateAction is an upsert.
+	var input mgn.PutTemplateActionInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.PutTemplateAction(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Application Migration Service Template Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateActionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	input := mgn.RemoveTemplateActionInput{
+		ActionID:                      fwflex.StringFromFramework(ctx, data.ActionID),
+		LaunchConfigurationTemplateID: fwflex.StringFromFramework(ctx, data.LaunchConfigurationTemplateID),
+	}
+	_, err := conn.RemoveTemplateAction(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Application Migration Service Template Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findTemplateActionByTwoPartKey(ctx context.Context, conn *mgn.Client, launchConfigurationTemplateID, actionID string) (*awstypes.TemplateActionDocument, error) {
+	input := mgn.ListTemplateActionsInput{
+		Filters: &awstypes.TemplateActionsRequestFilters{
+			ActionIDs: []string{actionID},
+		},
+		LaunchConfigurationTemplateID: aws.String(launchConfigurationTemplateID),
+	}
+
+	return findTemplateAction(ctx, conn, &input)
+}
+
+func findTemplateAction(ctx context.Context, conn *mgn.Client, input *mgn.ListTemplateActionsInput) (*awstypes.TemplateActionDocument, error) {
+	output, err := findTemplateActions(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findTemplateActions(ctx context.Context, conn *mgn.Client, input *mgn.ListTemplateActionsInput) ([]awstypes.TemplateActionDocument, error) {
+	var output []awstypes.TemplateActionDocument
+
+	pages := mgn.NewListTemplateActionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type templateActionResourceModel struct {
+	ActionID                      types.String                                `tfsdk:"action_id"`
+	ActionName                    types.String                                `tfsdk:"action_name"`
+	Active                        types.Bool                                  `tfsdk:"active"`
+	Category                      fwtypes.StringEnum[awstypes.ActionCategory] `tfsdk:"category"`
+	Description                   types.String                                `tfsdk:"description"`
+	DocumentIdentifier            types.String                                `tfsdk:"document_identifier"`
+	DocumentVersion               types.String                                `tfsdk:"document_version"`
+	ID                            types.String                                `tfsdk:"id"`
+	LaunchConfigurationTemplateID types.String                                `tfsdk:"launch_configuration_template_id"`
+	MustSucceedForCutover         types.Bool                                  `tfsdk:"must_succeed_for_cutover"`
+	OperatingSystem               types.String                                `tfsdk:"operating_system"`
+	Order                         types.Int32                                 `tfsdk:"order"`
+	TimeoutSeconds                types.Int32                                 `tfsdk:"timeout_seconds"`
+}
+
+const (
+	templateActionResourceIDPartCount = 2
+)
+
+func (data *templateActionResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, templateActionResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.LaunchConfigurationTemplateID = types.StringValue(parts[0])
+	data.ActionID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *templateActionResourceModel) setID() (string, error) {
+	parts := []string{
+		data.LaunchConfigurationTemplateID.ValueString(),
+		data.ActionID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, templateActionResourceIDPartCount, false)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMgnTemplateAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	templateID := acctest.SkipIfEnvVarNotSet(t, "MGN_LAUNCH_CONFIGURATION_TEMPLATE_ID")
+	resourceName := "aws_mgn_template_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateActionConfig_basic(rName, templateID, 2001),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "active", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "document_identifier", "AWS-RunShellScript"),
+					resource.TestCheckResourceAttr(resourceName, "launch_configuration_template_id", templateID),
+					resource.TestCheckResourceAttr(resourceName, "order", "2001"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTemplateActionConfig_basic(rName, templateID, 2002),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "order", "2002"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMgnTemplateAction_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	templateID := acctest.SkipIfEnvVarNotSet(t, "MGN_LAUNCH_CONFIGURATION_TEMPLATE_ID")
+	resourceName := "aws_mgn_template_action.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateActionConfig_basic(rName, templateID, 2001),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateActionExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmgn.ResourceTemplateAction, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateActionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mgn_template_action" {
+				continue
+			}
+
+			_, err := tfmgn.FindTemplateActionByTwoPartKey(ctx, conn, rs.Primary.Attributes["launch_configuration_template_id"], rs.Primary.Attributes["action_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Application Migration Service Template Action %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateActionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MgnClient(ctx)
+
+		_, err := tfmgn.FindTemplateActionByTwoPartKey(ctx, conn, rs.Primary.Attributes["launch_configuration_template_id"], rs.Primary.Attributes["action_id"])
+
+		return err
+	}
+}
+
+func testAccTemplateActionConfig_basic(rName, templateID string, order int) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_template_action" "test" {
+  launch_configuration_template_id = %[2]q
+  action_id                        = "tf-acc-test-action"
+  action_name                      = %[1]q
+  document_identifier              = "AWS-RunShellScript"
+  order                            = %[3]d
+}
+`, rName, templateID, order)
+}
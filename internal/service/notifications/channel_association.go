@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/notifications"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/notifications/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_notifications_channel_association", name="Channel Association")
+func newChannelAssociationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &channelAssociationResource{}
+
+	return r, nil
+}
+
+type channelAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (r *channelAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"notification_configuration_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *channelAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data channelAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NotificationsClient(ctx)
+
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("creating User Notifications Channel Association", err.Error())
+
+		return
+	}
+
+	input := notifications.AssociateChannelInput{
+		Arn:                          fwflex.StringFromFramework(ctx, data.ARN),
+		NotificationConfigurationArn: fwflex.StringFromFramework(ctx, data.NotificationConfigurationARN),
+	}
+	_, err = conn.AssociateChannel(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating User Notifications Channel Association (%s)", id), err.Error())
+
+		return
+	}
+
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *channelAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data channelAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().NotificationsClient(ctx)
+
+	_, err := findChannelAssociationByTwoPartKey(ctx, conn, data.NotificationConfigurationARN.ValueString(), data.ARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading User Notifications Channel Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data channelAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NotificationsClient(ctx)
+
+	input := notifications.DisassociateChannelInput{
+		Arn:                          fwflex.StringFromFramework(ctx, data.ARN),
+		NotificationConfigurationArn: fwflex.StringFromFramework(ctx, data.NotificationConfigurationARN),
+	}
+	_, err := conn.DisassociateChannel(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting User Notifications Channel Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type channelAssociationResourceModel struct {
+	ARN                          fwtypes.ARN  `tfsdk:"arn"`
+	ID                           types.String `tfsdk:"id"`
+	NotificationConfigurationARN fwtypes.ARN  `tfsdk:"notification_configuration_arn"`
+}
+
+const (
+	channelAssociationResourceIDPartCount = 2
+)
+
+func (m *channelAssociationResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, channelAssociationResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.NotificationConfigurationARN = fwtypes.ARNValue(parts[0])
+	m.ARN = fwtypes.ARNValue(parts[1])
+
+	return nil
+}
+
+func (m *channelAssociationResourceModel) setID() (string, error) {
+	parts := []string{
+		m.NotificationConfigurationARN.ValueString(),
+		m.ARN.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, channelAssociationResourceIDPartCount, false)
+}
+
+func findChannelAssociationByTwoPartKey(ctx context.Context, conn *notifications.Client, notificationConfigurationARN, channelARN string) (*string, error) {
+	input := &notifications.ListChannelsInput{
+		NotificationConfigurationArn: aws.String(notificationConfigurationARN),
+	}
+
+	pages := notifications.NewListChannelsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Channels {
+			if v == channelARN {
+				return aws.String(v), nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
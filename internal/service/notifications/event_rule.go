@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/notifications"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/notifications/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_notifications_event_rule", name="Event Rule")
+func newEventRuleResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &eventRuleResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type eventRuleResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *eventRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"event_pattern": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			"event_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notification_configuration_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrSource: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *eventRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data eventRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NotificationsClient(ctx)
+
+	var input notifications.CreateEventRuleInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateEventRule(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating User Notifications Event Rule (%s)", data.NotificationConfigurationARN.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+
+	if _, err := waitEventRuleCreated(ctx, conn, data.ARN.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), data.ARN) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for User Notifications Event Rule (%s) create", data.ARN.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *eventRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data eventRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NotificationsClient(ctx)
+
+	output, err := findEventRuleByARN(ctx, conn, data.ARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading User Notifications Event Rule (%s)", data.ARN.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// An event rule without an event pattern is returned with an empty one.
+	if aws.ToString(output.EventPattern) == "" {
+		data.EventPattern = jsontypes.NewNormalizedNull()
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *eventRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new eventRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NotificationsClient(ctx)
+
+	if !new.EventPattern.Equal(old.EventPattern) || !new.Regions.Equal(old.Regions) {
+		input := notifications.UpdateEventRuleInput{
+			Arn:          fwflex.StringFromFramework(ctx, new.ARN),
+			EventPattern: fwflex.StringFromFramework(ctx, new.EventPattern),
+			Regions:      fwflex.ExpandFrameworkStringValueSet(ctx, new.Regions),
+		}
+
+		// Removing the event pattern requires an explicit empty pattern.
+		if input.EventPattern == nil {
+			input.EventPattern = aws.String("")
+		}
+
+		_, err := conn.UpdateEventRule(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating User Notifications Event Rule (%s)", new.ARN.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitEventRuleUpdated(ctx, conn, new.ARN.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for User Notifications Event Rule (%s) update", new.ARN.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *eventRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data eventRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NotificationsClient(ctx)
+
+	input := notifications.DeleteEventRuleInput{
+		Arn: fwflex.StringFromFramework(ctx, data.ARN),
+	}
+	_, err := conn.DeleteEventRule(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting User Notifications Event Rule (%s)", data.ARN.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitEventRuleDeleted(ctx, conn, data.ARN.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for User Notifications Event Rule (%s) delete", data.ARN.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *eventRuleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+type eventRuleResourceModel struct {
+	ARN                          types.String         `tfsdk:"arn"`
+	EventPattern                 jsontypes.Normalized `tfsdk:"event_pattern"`
+	EventType                    types.String         `tfsdk:"event_type"`
+	NotificationConfigurationARN fwtypes.ARN          `tfsdk:"notification_configuration_arn"`
+	Regions                      fwtypes.SetOfString  `tfsdk:"regions"`
+	Source                       types.String         `tfsdk:"source"`
+	Timeouts                     timeouts.Value       `tfsdk:"timeouts"`
+}
+
+func findEventRuleByARN(ctx context.Context, conn *notifications.Client, arn string) (*notifications.GetEventRuleOutput, error) {
+	input := &notifications.GetEventRuleInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetEventRule(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// eventRuleStatus returns the status of an event rule across all its Regions.
+// A Region in which the rule is still changing takes precedence, then an inactive Region.
+func eventRuleStatus(output *notifications.GetEventRuleOutput) awstypes.EventRuleStatus {
+	status := awstypes.EventRuleStatusActive
+
+	for _, v := range output.StatusSummaryByRegion {
+		switch v.Status {
+		case awstypes.EventRuleStatusCreating, awstypes.EventRuleStatusUpdating, awstypes.EventRuleStatusDeleting:
+			return v.Status
+		case awstypes.EventRuleStatusInactive:
+			status = v.Status
+		}
+	}
+
+	return status
+}
+
+func statusEventRule(ctx context.Context, conn *notifications.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findEventRuleByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(eventRuleStatus(output)), nil
+	}
+}
+
+func waitEventRuleCreated(ctx context.Context, conn *notifications.Client, arn string, timeout time.Duration) (*notifications.GetEventRuleOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EventRuleStatusCreating),
+		Target:  enum.Slice(awstypes.EventRuleStatusActive, awstypes.EventRuleStatusInactive),
+		Refresh: statusEventRule(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*notifications.GetEventRuleOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEventRuleUpdated(ctx context.Context, conn *notifications.Client, arn string, timeout time.Duration) (*notifications.GetEventRuleOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EventRuleStatusCreating, awstypes.EventRuleStatusUpdating),
+		Target:  enum.Slice(awstypes.EventRuleStatusActive, awstypes.EventRuleStatusInactive),
+		Refresh: statusEventRule(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*notifications.GetEventRuleOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEventRuleDeleted(ctx context.Context, conn *notifications.Client, arn string, timeout time.Duration) (*notifications.GetEventRuleOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EventRuleStatusActive, awstypes.EventRuleStatusInactive, awstypes.EventRuleStatusDeleting),
+		Target:  []string{},
+		Refresh: statusEventRule(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*notifications.GetEventRuleOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
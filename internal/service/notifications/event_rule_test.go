@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package notifications_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/notifications"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnotifications "github.com/hashicorp/terraform-provider-aws/internal/service/notifications"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNotificationsEventRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v notifications.GetEventRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_notifications_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.NotificationsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NotificationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventRuleConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("event_pattern"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("event_type"), knownvalue.StringExact("Object Created")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("regions"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact(acctest.Region()),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrSource), knownvalue.StringExact("aws.s3")),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_configuration_arn", "aws_notifications_notification_configuration.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+		},
+	})
+}
+
+func TestAccNotificationsEventRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v notifications.GetEventRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_notifications_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.NotificationsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NotificationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventRuleExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfnotifications.ResourceEventRule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNotificationsEventRule_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v notifications.GetEventRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_notifications_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.NotificationsEndpointID)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NotificationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventRuleConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("event_pattern"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("regions"), knownvalue.SetSizeExact(1)),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventRuleExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccEventRuleConfig_eventPattern(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("event_pattern"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("regions"), knownvalue.SetSizeExact(2)),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventRuleExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+			{
+				Config: testAccEventRuleConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("event_pattern"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("regions"), knownvalue.SetSizeExact(1)),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventRuleExists(ctx, resourceName, &v),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEventRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NotificationsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_notifications_event_rule" {
+				continue
+			}
+
+			_, err := tfnotifications.FindEventRuleByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("User Notifications Event Rule %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEventRuleExists(ctx context.Context, n string, v *notifications.GetEventRuleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NotificationsClient(ctx)
+
+		output, err := tfnotifications.FindEventRuleByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEventRuleConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccNotificationConfigurationConfig_basic(rName, "test"), fmt.Sprintf(`
+resource "aws_notifications_event_rule" "test" {
+  notification_configuration_arn = aws_notifications_notification_configuration.test.arn
+  source                         = "aws.s3"
+  event_type                     = "Object Created"
+  regions                        = [%[1]q]
+}
+`, acctest.Region()))
+}
+
+func testAccEventRuleConfig_eventPattern(rName string) string {
+	return acctest.ConfigCompose(testAccNotificationConfigurationConfig_basic(rName, "test"), fmt.Sprintf(`
+resource "aws_notifications_event_rule" "test" {
+  notification_configuration_arn = aws_notifications_notification_configuration.test.arn
+  source                         = "aws.s3"
+  event_type                     = "Object Created"
+  regions                        = [%[1]q, %[2]q]
+
+  event_pattern = jsonencode({
+    detail = {
+      bucket = {
+        name = [%[3]q]
+      }
+    }
+  })
+}
+`, acctest.Region(), acctest.AlternateRegion(), rName))
+}
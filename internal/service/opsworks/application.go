@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/opsworks"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -172,6 +173,30 @@ func resourceApplication() *schema.Resource {
 					},
 				},
 			},
+			"secure_environment_wo": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				RequiredWith: []string{"secure_environment_wo_version"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValue: {
+							Type:      schema.TypeString,
+							Required:  true,
+							WriteOnly: true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"secure_environment_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"secure_environment_wo"},
+			},
 			"enable_ssl": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -238,6 +263,18 @@ func resourceApplicationValidate(d *schema.ResourceData) error {
 		return fmt.Errorf("Only one ssl_configuration is permitted.")
 	}
 
+	keys := make(map[string]bool)
+	for _, v := range d.Get(names.AttrEnvironment).(*schema.Set).List() {
+		keys[v.(map[string]any)[names.AttrKey].(string)] = true
+	}
+	for _, v := range d.Get("secure_environment_wo").([]any) {
+		if v, ok := v.(map[string]any); ok {
+			if key := v[names.AttrKey].(string); keys[key] {
+				return fmt.Errorf("environment variable %q is set in both environment and secure_environment_wo.", key)
+			}
+		}
+	}
+
 	attrType := awstypes.AppType(d.Get(names.AttrType).(string))
 	if attrType == awstypes.AppTypeNodejs || attrType == awstypes.AppTypeJava {
 		// allowed attributes: none
@@ -304,6 +341,7 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta a
 	}
 	resourceSetApplicationsDataSource(d, output.DataSources)
 	resourceSetApplicationEnvironmentVariable(d, output.Environment)
+	resourceSetApplicationSecureEnvironmentVariable(d, output.Environment)
 	resourceSetApplicationAttributes(d, output.Attributes)
 
 	return diags
@@ -318,6 +356,12 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "creating OpsWorks Application: %s", err)
 	}
 
+	environment, di := resourceApplicationEnvironmentVariable(d)
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
 	req := &opsworks.CreateAppInput{
 		Name:             aws.String(d.Get(names.AttrName).(string)),
 		Shortname:        aws.String(d.Get("short_name").(string)),
@@ -329,7 +373,7 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 		SslConfiguration: resourceApplicationSSL(d),
 		AppSource:        resourceApplicationSource(d),
 		DataSources:      resourceApplicationsDataSource(d),
-		Environment:      resourceApplicationEnvironmentVariable(d),
+		Environment:      environment,
 		Attributes:       resourceApplicationAttributes(d),
 	}
 
@@ -352,6 +396,12 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "updating OpsWorks Application (%s): %s", d.Id(), err)
 	}
 
+	environment, di := resourceApplicationEnvironmentVariable(d)
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
 	req := &opsworks.UpdateAppInput{
 		AppId:            aws.String(d.Id()),
 		Name:             aws.String(d.Get(names.AttrName).(string)),
@@ -362,7 +412,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		SslConfiguration: resourceApplicationSSL(d),
 		AppSource:        resourceApplicationSource(d),
 		DataSources:      resourceApplicationsDataSource(d),
-		Environment:      resourceApplicationEnvironmentVariable(d),
+		Environment:      environment,
 		Attributes:       resourceApplicationAttributes(d),
 	}
 
@@ -385,10 +435,6 @@ func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta
 		AppId: aws.String(d.Id()),
 	})
 
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		return diags
-	}
-
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting OpsWorks Application (%s): %s", d.Id(), err)
 	}
@@ -458,7 +504,30 @@ func resourceSetApplicationEnvironmentVariable(d *schema.ResourceData, vs []awst
 	d.Set(names.AttrEnvironment, values)
 }
 
-func resourceApplicationEnvironmentVariable(d *schema.ResourceData) []awstypes.EnvironmentVariable {
+// resourceSetApplicationSecureEnvironmentVariable keeps the configured write-only secure environment
+// variable keys that are still present. The API returns placeholder values for secure variables and
+// write-only values are never stored, so only the keys are set.
+func resourceSetApplicationSecureEnvironmentVariable(d *schema.ResourceData, vs []awstypes.EnvironmentVariable) {
+	var values []any
+
+	for _, value := range d.Get("secure_environment_wo").([]any) {
+		value, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if v := resourceFindEnvironmentVariable(value[names.AttrKey].(string), vs); v != nil && aws.ToBool(v.Secure) {
+			values = append(values, map[string]any{
+				names.AttrKey: aws.ToString(v.Key),
+			})
+		}
+	}
+
+	d.Set("secure_environment_wo", values)
+}
+
+func resourceApplicationEnvironmentVariable(d *schema.ResourceData) ([]awstypes.EnvironmentVariable, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	environmentVariables := d.Get(names.AttrEnvironment).(*schema.Set).List()
 	result := make([]awstypes.EnvironmentVariable, len(environmentVariables))
 
@@ -471,7 +540,28 @@ func resourceApplicationEnvironmentVariable(d *schema.ResourceData) []awstypes.E
 			Secure: aws.Bool(env["secure"].(bool)),
 		}
 	}
-	return result
+
+	// Write-only values are only available in the configuration.
+	for i, v := range d.Get("secure_environment_wo").([]any) {
+		env, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		valueWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("secure_environment_wo").IndexInt(i).GetAttr(names.AttrValue))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = append(result, awstypes.EnvironmentVariable{
+			Key:    aws.String(env[names.AttrKey].(string)),
+			Value:  aws.String(valueWO),
+			Secure: aws.Bool(true),
+		})
+	}
+
+	return result, diags
 }
 
 func resourceApplicationSource(d *schema.ResourceData) *awstypes.Source {
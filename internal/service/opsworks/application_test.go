@@ -14,6 +14,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfopsworks "github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
@@ -100,6 +101,46 @@ func TestAccOpsWorksApplication_basic(t *testing.T) {
 	})
 }
 
+func TestAccOpsWorksApplication_secureEnvironmentWriteOnly(t *testing.T) {
+	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
+
+	ctx := acctest.Context(t)
+	var opsapp awstypes.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opsworks_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.OpsWorks) },
+		ErrorCheck: acctest.ErrorCheck(t, names.OpsWorksServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_secureEnvironmentWriteOnly(rName, "secret1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &opsapp),
+					resource.TestCheckResourceAttr(resourceName, "secure_environment_wo.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secure_environment_wo.0.key", acctest.CtKey2),
+					resource.TestCheckNoResourceAttr(resourceName, "secure_environment_wo.0.value"),
+					resource.TestCheckResourceAttr(resourceName, "secure_environment_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_secureEnvironmentWriteOnly(rName, "secret2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &opsapp),
+					resource.TestCheckResourceAttr(resourceName, "secure_environment_wo.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "secure_environment_wo.0.value"),
+					resource.TestCheckResourceAttr(resourceName, "secure_environment_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckApplicationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksClient(ctx)
@@ -350,3 +391,33 @@ EOS
 }
 `, rName))
 }
+
+func testAccApplicationConfig_secureEnvironmentWriteOnly(rName, value string, version int) string {
+	return acctest.ConfigCompose(
+		testAccStackConfig_vpcCreate(rName),
+		fmt.Sprintf(`
+resource "aws_opsworks_application" "test" {
+  document_root = "foo"
+  name          = %[1]q
+  stack_id      = aws_opsworks_stack.test.id
+  type          = "other"
+
+  app_source {
+    type = "other"
+  }
+
+  environment {
+    key    = "key1"
+    value  = "value1"
+    secure = false
+  }
+
+  secure_environment_wo {
+    key   = "key2"
+    value = %[2]q
+  }
+
+  secure_environment_wo_version = %[3]d
+}
+`, rName, value, version))
+}
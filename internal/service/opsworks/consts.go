@@ -11,6 +11,11 @@ const (
 	defaultBerkshelfVersion = "3.2.0"
 )
 
+const (
+	defaultLayerDrainELBOnShutdown      = true
+	defaultLayerInstanceShutdownTimeout = 120
+)
+
 const (
 	instanceStatusBooting      = "booting"
 	instanceStatusOnline       = "online"
@@ -18,13 +23,147 @@ const (
 	instanceStatusRequested    = "requested"
 	instanceStatusRunning      = "running"
 	instanceStatusRunningSetup = "running_setup"
+	instanceStatusSetupFailed  = "setup_failed"
 	instanceStatusShuttingDown = "shutting_down"
+	instanceStatusStartFailed  = "start_failed"
 	instanceStatusStopped      = "stopped"
 	instanceStatusStopping     = "stopping"
 	instanceStatusTerminated   = "terminated"
 	instanceStatusTerminating  = "terminating"
 )
 
+const (
+	deploymentStatusRunning    = "running"
+	deploymentStatusSuccessful = "successful"
+)
+
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+// Valid values for layer type attributes that accept a fixed set of values.
+// These are derived from the OpsWorks Stacks layer reference documentation.
+
+type haproxyHealthCheckMethod string
+
+const (
+	haproxyHealthCheckMethodDelete  haproxyHealthCheckMethod = "DELETE"
+	haproxyHealthCheckMethodGet     haproxyHealthCheckMethod = "GET"
+	haproxyHealthCheckMethodHead    haproxyHealthCheckMethod = "HEAD"
+	haproxyHealthCheckMethodOptions haproxyHealthCheckMethod = "OPTIONS"
+	haproxyHealthCheckMethodPost    haproxyHealthCheckMethod = "POST"
+	haproxyHealthCheckMethodPut     haproxyHealthCheckMethod = "PUT"
+	haproxyHealthCheckMethodTrace   haproxyHealthCheckMethod = "TRACE"
+)
+
+func (haproxyHealthCheckMethod) Values() []haproxyHealthCheckMethod {
+	return []haproxyHealthCheckMethod{
+		haproxyHealthCheckMethodDelete,
+		haproxyHealthCheckMethodGet,
+		haproxyHealthCheckMethodHead,
+		haproxyHealthCheckMethodOptions,
+		haproxyHealthCheckMethodPost,
+		haproxyHealthCheckMethodPut,
+		haproxyHealthCheckMethodTrace,
+	}
+}
+
+type javaAppServer string
+
+const (
+	javaAppServerTomcat javaAppServer = "tomcat"
+)
+
+func (javaAppServer) Values() []javaAppServer {
+	return []javaAppServer{
+		javaAppServerTomcat,
+	}
+}
+
+type javaAppServerVersion string
+
+const (
+	javaAppServerVersion6 javaAppServerVersion = "6"
+	javaAppServerVersion7 javaAppServerVersion = "7"
+	javaAppServerVersion8 javaAppServerVersion = "8"
+)
+
+func (javaAppServerVersion) Values() []javaAppServerVersion {
+	return []javaAppServerVersion{
+		javaAppServerVersion6,
+		javaAppServerVersion7,
+		javaAppServerVersion8,
+	}
+}
+
+type jvmVersion string
+
+const (
+	jvmVersion6 jvmVersion = "6"
+	jvmVersion7 jvmVersion = "7"
+	jvmVersion8 jvmVersion = "8"
+)
+
+func (jvmVersion) Values() []jvmVersion {
+	return []jvmVersion{
+		jvmVersion6,
+		jvmVersion7,
+		jvmVersion8,
+	}
+}
+
+type railsAppServer string
+
+const (
+	railsAppServerApachePassenger railsAppServer = "apache_passenger"
+	railsAppServerNginxUnicorn    railsAppServer = "nginx_unicorn"
+)
+
+func (railsAppServer) Values() []railsAppServer {
+	return []railsAppServer{
+		railsAppServerApachePassenger,
+		railsAppServerNginxUnicorn,
+	}
+}
+
+type rubyVersion string
+
+const (
+	rubyVersion193 rubyVersion = "1.9.3"
+	rubyVersion200 rubyVersion = "2.0.0"
+	rubyVersion21  rubyVersion = "2.1"
+	rubyVersion22  rubyVersion = "2.2"
+	rubyVersion23  rubyVersion = "2.3"
+	rubyVersion24  rubyVersion = "2.4"
+	rubyVersion25  rubyVersion = "2.5"
+	rubyVersion26  rubyVersion = "2.6"
+)
+
+func (rubyVersion) Values() []rubyVersion {
+	return []rubyVersion{
+		rubyVersion193,
+		rubyVersion200,
+		rubyVersion21,
+		rubyVersion22,
+		rubyVersion23,
+		rubyVersion24,
+		rubyVersion25,
+		rubyVersion26,
+	}
+}
+
+type layerSecurityGroupUpdateStrategy string
+
+const (
+	// Attach the new custom security groups with one UpdateLayer call and detach the old ones with a second.
+	layerSecurityGroupUpdateStrategyAddThenRemove layerSecurityGroupUpdateStrategy = "add_then_remove"
+	// Replace the layer's custom security groups with a single UpdateLayer call.
+	layerSecurityGroupUpdateStrategyReplace layerSecurityGroupUpdateStrategy = "replace"
+)
+
+func (layerSecurityGroupUpdateStrategy) Values() []layerSecurityGroupUpdateStrategy {
+	return []layerSecurityGroupUpdateStrategy{
+		layerSecurityGroupUpdateStrategyAddThenRemove,
+		layerSecurityGroupUpdateStrategyReplace,
+	}
+}
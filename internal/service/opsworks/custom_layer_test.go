@@ -149,6 +149,54 @@ func TestAccOpsWorksCustomLayer_update(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr(resourceName, "system_packages.*", "subversion"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
 					resource.TestCheckResourceAttr(resourceName, "use_ebs_optimized_instances", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_instances_online", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpsWorksCustomLayer_lifecycleEventConfiguration(t *testing.T) {
+	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
+
+	ctx := acctest.Context(t)
+	var v awstypes.Layer
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opsworks_custom_layer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.OpsWorks) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpsWorksServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomLayerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLayerConfig_lifecycleEventConfiguration(rName, false, 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "drain_elb_on_shutdown", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "instance_shutdown_timeout", "300"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_event_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_event_configuration.0.shutdown.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_event_configuration.0.shutdown.0.delay_until_elb_connections_drained", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_event_configuration.0.shutdown.0.execution_timeout", "300"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCustomLayerConfig_lifecycleEventConfiguration(rName, true, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "drain_elb_on_shutdown", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "instance_shutdown_timeout", "600"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_event_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_event_configuration.0.shutdown.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_event_configuration.0.shutdown.0.delay_until_elb_connections_drained", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_event_configuration.0.shutdown.0.execution_timeout", "600"),
 				),
 			},
 		},
@@ -178,17 +226,19 @@ func TestAccOpsWorksCustomLayer_cloudWatch(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.enabled", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.batch_count", "1000"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.batch_size", "32768"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.buffer_duration", "5000"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.datetime_format", ""),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.encoding", "utf_8"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.file", "/var/log/system.log*"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.file_fingerprint_lines", "1"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.initial_position", "start_of_file"),
-					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_configuration.0.log_streams.0.log_group_name", logGroupResourceName, names.AttrName),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.multiline_start_pattern", ""),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.time_zone", ""),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cloudwatch_configuration.0.log_streams.*", map[string]string{
+						"batch_count":             "1000",
+						"batch_size":              "32768",
+						"buffer_duration":         "5000",
+						"datetime_format":         "",
+						"encoding":                "utf_8",
+						"file":                    "/var/log/system.log*",
+						"file_fingerprint_lines":  "1",
+						"initial_position":        "start_of_file",
+						"multiline_start_pattern": "",
+						"time_zone":               "",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "cloudwatch_configuration.0.log_streams.*.log_group_name", logGroupResourceName, names.AttrName),
 				),
 			},
 			{
@@ -203,17 +253,19 @@ func TestAccOpsWorksCustomLayer_cloudWatch(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.enabled", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.batch_count", "1000"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.batch_size", "32768"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.buffer_duration", "5000"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.datetime_format", ""),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.encoding", "utf_8"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.file", "/var/log/system.log*"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.file_fingerprint_lines", "1"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.initial_position", "start_of_file"),
-					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_configuration.0.log_streams.0.log_group_name", logGroupResourceName, names.AttrName),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.multiline_start_pattern", ""),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.time_zone", ""),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cloudwatch_configuration.0.log_streams.*", map[string]string{
+						"batch_count":             "1000",
+						"batch_size":              "32768",
+						"buffer_duration":         "5000",
+						"datetime_format":         "",
+						"encoding":                "utf_8",
+						"file":                    "/var/log/system.log*",
+						"file_fingerprint_lines":  "1",
+						"initial_position":        "start_of_file",
+						"multiline_start_pattern": "",
+						"time_zone":               "",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "cloudwatch_configuration.0.log_streams.*.log_group_name", logGroupResourceName, names.AttrName),
 				),
 			},
 			{
@@ -224,16 +276,18 @@ func TestAccOpsWorksCustomLayer_cloudWatch(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.enabled", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.batch_count", "2000"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.batch_size", "50000"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.buffer_duration", "6000"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.encoding", "mac_turkish"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.file", "/var/log/system.lo*"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.file_fingerprint_lines", "2"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.initial_position", "end_of_file"),
-					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_configuration.0.log_streams.0.log_group_name", logGroupResourceName, names.AttrName),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.multiline_start_pattern", "test*"),
-					resource.TestCheckResourceAttr(resourceName, "cloudwatch_configuration.0.log_streams.0.time_zone", "LOCAL"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cloudwatch_configuration.0.log_streams.*", map[string]string{
+						"batch_count":             "2000",
+						"batch_size":              "50000",
+						"buffer_duration":         "6000",
+						"encoding":                "mac_turkish",
+						"file":                    "/var/log/system.lo*",
+						"file_fingerprint_lines":  "2",
+						"initial_position":        "end_of_file",
+						"multiline_start_pattern": "test*",
+						"time_zone":               "LOCAL",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "cloudwatch_configuration.0.log_streams.*.log_group_name", logGroupResourceName, names.AttrName),
 				),
 			},
 		},
@@ -313,6 +367,121 @@ func TestAccOpsWorksCustomLayer_loadBasedAutoScaling(t *testing.T) {
 	})
 }
 
+func TestAccOpsWorksCustomLayer_loadBasedAutoScalingRemoved(t *testing.T) {
+	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
+
+	ctx := acctest.Context(t)
+	var v awstypes.Layer
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opsworks_custom_layer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.OpsWorks) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpsWorksServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomLayerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLayerConfig_loadBasedAutoScaling(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "load_based_auto_scaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "load_based_auto_scaling.0.enable", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccCustomLayerConfig_noLoadBasedAutoScaling(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "load_based_auto_scaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "load_based_auto_scaling.0.enable", acctest.CtFalse),
+				),
+			},
+			{
+				Config:   testAccCustomLayerConfig_noLoadBasedAutoScaling(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccOpsWorksCustomLayer_customJSON(t *testing.T) {
+	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
+
+	ctx := acctest.Context(t)
+	var v awstypes.Layer
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opsworks_custom_layer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.OpsWorks) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpsWorksServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomLayerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLayerConfig_customJSON(rName, `{"key1":"value1","key2":{"a":1,"b":2}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "custom_json", `{"key1":"value1","key2":{"a":1,"b":2}}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccCustomLayerConfig_customJSON(rName, "{\n  \"key2\": {\"b\": 2, \"a\": 1},\n  \"key1\": \"value1\"\n}\n"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCustomLayerConfig_customJSON(rName, `{"key1":"value2"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "custom_json", `{"key1":"value2"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpsWorksCustomLayer_securityGroupUpdateStrategy(t *testing.T) {
+	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
+
+	ctx := acctest.Context(t)
+	var v awstypes.Layer
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opsworks_custom_layer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.OpsWorks) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpsWorksServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomLayerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLayerConfig_securityGroupUpdateStrategy(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "custom_security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "custom_security_group_ids.*", "aws_security_group.test.0", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "security_group_update_strategy", "add_then_remove"),
+				),
+			},
+			{
+				Config: testAccCustomLayerConfig_securityGroupUpdateStrategy(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayerExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "custom_security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "custom_security_group_ids.*", "aws_security_group.test.1", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "security_group_update_strategy", "add_then_remove"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCustomLayerDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error { return testAccCheckLayerDestroy(ctx, "aws_opsworks_custom_layer", s) }
 }
@@ -401,10 +570,46 @@ resource "aws_opsworks_custom_layer" "test" {
   }
 
   custom_json = %[2]q
+
+  wait_for_instances_online = true
 }
 `, rName, testAccCustomJSON1))
 }
 
+func testAccCustomLayerConfig_lifecycleEventConfiguration(rName string, drain bool, timeout int) string {
+	return acctest.ConfigCompose(testAccLayerConfig_base(rName), fmt.Sprintf(`
+resource "aws_opsworks_custom_layer" "test" {
+  stack_id   = aws_opsworks_stack.test.id
+  name       = %[1]q
+  short_name = "tf-ops-acc-custom-layer"
+
+  custom_security_group_ids = aws_security_group.test[*].id
+
+  lifecycle_event_configuration {
+    shutdown {
+      delay_until_elb_connections_drained = %[2]t
+      execution_timeout                   = %[3]d
+    }
+  }
+}
+`, rName, drain, timeout))
+}
+
+func testAccCustomLayerConfig_customJSON(rName, customJSON string) string {
+	return acctest.ConfigCompose(testAccLayerConfig_base(rName), fmt.Sprintf(`
+resource "aws_opsworks_custom_layer" "test" {
+  stack_id               = aws_opsworks_stack.test.id
+  name                   = %[1]q
+  short_name             = "tf-ops-acc-custom-layer"
+  auto_assign_public_ips = true
+
+  custom_security_group_ids = aws_security_group.test[*].id
+
+  custom_json = %[2]q
+}
+`, rName, customJSON))
+}
+
 func testAccCustomLayerConfig_cloudWatch(rName string, enabled bool) string {
 	return acctest.ConfigCompose(testAccLayerConfig_base(rName), fmt.Sprintf(`
 resource "aws_cloudwatch_log_group" "test" {
@@ -508,3 +713,32 @@ resource "aws_opsworks_custom_layer" "test" {
 }
 `, rName, enable))
 }
+
+func testAccCustomLayerConfig_noLoadBasedAutoScaling(rName string) string {
+	return acctest.ConfigCompose(testAccLayerConfig_base(rName), fmt.Sprintf(`
+resource "aws_opsworks_custom_layer" "test" {
+  stack_id               = aws_opsworks_stack.test.id
+  name                   = %[1]q
+  short_name             = "tf-ops-acc-custom-layer"
+  auto_assign_public_ips = true
+
+  custom_security_group_ids = aws_security_group.test[*].id
+
+  drain_elb_on_shutdown     = true
+  instance_shutdown_timeout = 300
+}
+`, rName))
+}
+
+func testAccCustomLayerConfig_securityGroupUpdateStrategy(rName string, securityGroupIndex int) string {
+	return acctest.ConfigCompose(testAccLayerConfig_base(rName), fmt.Sprintf(`
+resource "aws_opsworks_custom_layer" "test" {
+  stack_id   = aws_opsworks_stack.test.id
+  name       = %[1]q
+  short_name = "tf-ops-acc-custom-layer"
+
+  custom_security_group_ids      = [aws_security_group.test[%[2]d].id]
+  security_group_update_strategy = "add_then_remove"
+}
+`, rName, securityGroupIndex))
+}
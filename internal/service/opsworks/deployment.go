@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opsworks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/opsworks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_opsworks_deployment", name="Deployment")
+func newDeploymentResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &deploymentResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type deploymentResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[deploymentResourceModel]
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *deploymentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_json": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instance_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"layer_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"stack_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"command": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[deploymentCommandModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.DeploymentCommandName](),
+							Required:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"arg": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[deploymentCommandArgModel](ctx),
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									names.AttrValues: schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.RequiresReplace(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+		DeprecationMessage: "This resource is deprecated and will be removed in the next major version of the AWS Provider. Consider the AWS Systems Manager service instead.",
+	}
+}
+
+func (r *deploymentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data deploymentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpsWorksClient(ctx)
+
+	stackID := data.StackID.ValueString()
+	var input opsworks.CreateDeploymentInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	args, diags := expandDeploymentCommandArgs(ctx, data.Command)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Command.Args = args
+
+	output, err := conn.CreateDeployment(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating OpsWorks Deployment (%s)", stackID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.DeploymentId)
+
+	if data.WaitForCompletion.ValueBool() {
+		if _, err := waitDeploymentSuccessful(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for OpsWorks Deployment (%s) complete", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	deployment, err := findDeploymentByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading OpsWorks Deployment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenDeployment(ctx, deployment, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *deploymentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data deploymentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpsWorksClient(ctx)
+
+	deployment, err := findDeploymentByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading OpsWorks Deployment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenDeployment(ctx, deployment, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findDeploymentByID(ctx context.Context, conn *opsworks.Client, id string) (*awstypes.Deployment, error) {
+	input := &opsworks.DescribeDeploymentsInput{
+		DeploymentIds: []string{id},
+	}
+
+	output, err := conn.DescribeDeployments(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Deployments == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Deployments)
+}
+
+// flattenDeployment sets the deployment's Computed values and the arguments that round-trip unchanged.
+// The command, instance IDs and custom JSON returned by the API reflect the deployment as run,
+// e.g. instance IDs are resolved from layer IDs, so the configured values are kept.
+func flattenDeployment(ctx context.Context, apiObject *awstypes.Deployment, data *deploymentResourceModel) diag.Diagnostics {
+	return fwflex.Flatten(ctx, apiObject, data,
+		fwflex.WithIgnoredFieldNamesAppend("Command"),
+		fwflex.WithIgnoredFieldNamesAppend("CustomJson"),
+		fwflex.WithIgnoredFieldNamesAppend("InstanceIds"),
+	)
+}
+
+// expandDeploymentCommandArgs expands the command's arguments.
+// AutoFlex cannot expand a set of name/values blocks into the API's map of string slices.
+func expandDeploymentCommandArgs(ctx context.Context, command fwtypes.ListNestedObjectValueOf[deploymentCommandModel]) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := command.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	args, d := data.Arg.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() || len(args) == 0 {
+		return nil, diags
+	}
+
+	apiObject := make(map[string][]string, len(args))
+	for _, arg := range args {
+		apiObject[arg.Name.ValueString()] = fwflex.ExpandFrameworkStringValueList(ctx, arg.Values)
+	}
+
+	return apiObject, diags
+}
+
+type deploymentResourceModel struct {
+	AppID             types.String                                            `tfsdk:"app_id"`
+	Command           fwtypes.ListNestedObjectValueOf[deploymentCommandModel] `tfsdk:"command"`
+	Comment           types.String                                            `tfsdk:"comment"`
+	CompletedAt       types.String                                            `tfsdk:"completed_at"`
+	CreatedAt         types.String                                            `tfsdk:"created_at"`
+	CustomJSON        jsontypes.Normalized                                    `tfsdk:"custom_json"`
+	ID                types.String                                            `tfsdk:"id"`
+	InstanceIDs       fwtypes.SetOfString                                     `tfsdk:"instance_ids"`
+	LayerIDs          fwtypes.SetOfString                                     `tfsdk:"layer_ids"`
+	StackID           types.String                                            `tfsdk:"stack_id"`
+	Status            types.String                                            `tfsdk:"status"`
+	Timeouts          timeouts.Value                                          `tfsdk:"timeouts"`
+	Triggers          fwtypes.MapOfString                                     `tfsdk:"triggers"`
+	WaitForCompletion types.Bool                                              `tfsdk:"wait_for_completion"`
+}
+
+type deploymentCommandModel struct {
+	Arg  fwtypes.SetNestedObjectValueOf[deploymentCommandArgModel] `tfsdk:"arg" autoflex:"-"`
+	Name fwtypes.StringEnum[awstypes.DeploymentCommandName]        `tfsdk:"name"`
+}
+
+type deploymentCommandArgModel struct {
+	Name   types.String         `tfsdk:"name"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opsworks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopsworks "github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpsWorksDeployment_basic(t *testing.T) {
+	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
+
+	ctx := acctest.Context(t)
+	var v awstypes.Deployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opsworks_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.OpsWorks) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpsWorksServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "command.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "command.0.name", "update_custom_cookbooks"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_id", "aws_opsworks_stack.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "successful"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpsWorksDeployment_triggers(t *testing.T) {
+	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
+
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.Deployment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opsworks_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.OpsWorks) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpsWorksServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_triggers(rName, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "command.0.name", "execute_recipes"),
+					resource.TestCheckResourceAttr(resourceName, "command.0.arg.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "layer_ids.0", "aws_opsworks_custom_layer.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "v1"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_triggers(rName, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v2),
+					testAccCheckDeploymentRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentExists(ctx context.Context, n string, v *awstypes.Deployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksClient(ctx)
+
+		output, err := tfopsworks.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentRecreated(before, after *awstypes.Deployment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.DeploymentId), aws.ToString(after.DeploymentId); before == after {
+			return fmt.Errorf("OpsWorks Deployment (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccDeploymentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStackConfig_basic(rName), `
+resource "aws_opsworks_deployment" "test" {
+  stack_id = aws_opsworks_stack.test.id
+
+  command {
+    name = "update_custom_cookbooks"
+  }
+}
+`)
+}
+
+func testAccDeploymentConfig_triggers(rName, version string) string {
+	return acctest.ConfigCompose(testAccCustomLayerConfig_basic(rName), fmt.Sprintf(`
+resource "aws_opsworks_deployment" "test" {
+  stack_id  = aws_opsworks_stack.test.id
+  layer_ids = [aws_opsworks_custom_layer.test.id]
+  comment   = %[1]q
+
+  command {
+    name = "execute_recipes"
+
+    arg {
+      name   = "recipes"
+      values = ["deploy::default"]
+    }
+  }
+
+  triggers = {
+    version = %[2]q
+  }
+}
+`, rName, version))
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opsworks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opsworks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// How long the layers described for a stack are reused before being described again.
+	stackLayersDescriptionTTL = 30 * time.Second
+
+	stackLayersDescriptionCacheKey = "opsworks.stackLayersDescriptions"
+)
+
+// stackLayersDescriptions returns the client's cache of stack-wide layer descriptions.
+// Refreshing a stack with many layers then costs three Describe calls in total rather than three per layer.
+func stackLayersDescriptions(ctx context.Context, c *conns.AWSClient) *stackLayersDescriptionCache {
+	return c.ServiceCache(ctx, stackLayersDescriptionCacheKey, func() any {
+		return newStackLayersDescriptionCache(stackLayersDescriptionTTL)
+	}).(*stackLayersDescriptionCache)
+}
+
+// stackLayersDescription is the result of describing all the layers in a stack.
+type stackLayersDescription struct {
+	layers                             map[string]awstypes.Layer
+	elasticLoadBalancers               map[string]awstypes.ElasticLoadBalancer
+	loadBasedAutoScalingConfigurations map[string]awstypes.LoadBasedAutoScalingConfiguration
+}
+
+// stackLayersDescriptionKey identifies a stack. Stack IDs are only unique within an account and Region.
+type stackLayersDescriptionKey struct {
+	accountID string
+	region    string
+	stackID   string
+}
+
+type stackLayersDescriptionEntry struct {
+	mu          sync.Mutex
+	description *stackLayersDescription
+	expiresAt   time.Time
+}
+
+// stackLayersDescriptionCache is a concurrency-safe, time-bounded cache of stack layer descriptions.
+// Concurrent readers of the same stack share a single set of Describe calls.
+type stackLayersDescriptionCache struct {
+	mu      sync.Mutex
+	entries map[stackLayersDescriptionKey]*stackLayersDescriptionEntry
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+func newStackLayersDescriptionCache(ttl time.Duration) *stackLayersDescriptionCache {
+	return &stackLayersDescriptionCache{
+		entries: make(map[stackLayersDescriptionKey]*stackLayersDescriptionEntry),
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+// get returns the cached description of the specified stack, calling load if there is no unexpired description.
+// Errors are not cached. Expired descriptions of other stacks are evicted.
+func (c *stackLayersDescriptionCache) get(ctx context.Context, key stackLayersDescriptionKey, load func(context.Context) (*stackLayersDescription, error)) (*stackLayersDescription, error) {
+	c.mu.Lock()
+	c.evictExpired(key)
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &stackLayersDescriptionEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.description != nil && c.now().Before(entry.expiresAt) {
+		return entry.description, nil
+	}
+
+	description, err := load(ctx)
+
+	if err != nil {
+		entry.description = nil
+		entry.expiresAt = c.now()
+		return nil, err
+	}
+
+	entry.description = description
+	entry.expiresAt = c.now().Add(c.ttl)
+
+	return description, nil
+}
+
+// invalidate discards any cached description of the specified stack.
+func (c *stackLayersDescriptionCache) invalidate(key stackLayersDescriptionKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// evictExpired removes the entries, other than the specified one, whose descriptions have expired or failed to load.
+// Entries in use are skipped. The caller must hold c.mu.
+func (c *stackLayersDescriptionCache) evictExpired(except stackLayersDescriptionKey) {
+	now := c.now()
+
+	for k, entry := range c.entries {
+		if k == except || !entry.mu.TryLock() {
+			continue
+		}
+
+		// An entry that has never been loaded is about to be.
+		if !entry.expiresAt.IsZero() && !now.Before(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+
+		entry.mu.Unlock()
+	}
+}
+
+func describeStackLayers(ctx context.Context, conn *opsworks.Client, stackID string) (*stackLayersDescription, error) {
+	inputDL := &opsworks.DescribeLayersInput{
+		StackId: aws.String(stackID),
+	}
+
+	outputDL, err := conn.DescribeLayers(ctx, inputDL)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: inputDL,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if outputDL == nil {
+		return nil, tfresource.NewEmptyResultError(inputDL)
+	}
+
+	description := &stackLayersDescription{
+		layers:                             make(map[string]awstypes.Layer, len(outputDL.Layers)),
+		elasticLoadBalancers:               make(map[string]awstypes.ElasticLoadBalancer),
+		loadBasedAutoScalingConfigurations: make(map[string]awstypes.LoadBasedAutoScalingConfiguration),
+	}
+
+	for _, v := range outputDL.Layers {
+		description.layers[aws.ToString(v.LayerId)] = v
+	}
+
+	if len(description.layers) == 0 {
+		return description, nil
+	}
+
+	inputDELB := &opsworks.DescribeElasticLoadBalancersInput{
+		StackId: aws.String(stackID),
+	}
+
+	outputDELB, err := conn.DescribeElasticLoadBalancers(ctx, inputDELB)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if outputDELB != nil {
+		for _, v := range outputDELB.ElasticLoadBalancers {
+			description.elasticLoadBalancers[aws.ToString(v.LayerId)] = v
+		}
+	}
+
+	inputDLBAS := &opsworks.DescribeLoadBasedAutoScalingInput{
+		LayerIds: tfslices.ApplyToAll(outputDL.Layers, func(v awstypes.Layer) string {
+			return aws.ToString(v.LayerId)
+		}),
+	}
+
+	outputDLBAS, err := conn.DescribeLoadBasedAutoScaling(ctx, inputDLBAS)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if outputDLBAS != nil {
+		for _, v := range outputDLBAS.LoadBasedAutoScalingConfigurations {
+			description.loadBasedAutoScalingConfigurations[aws.ToString(v.LayerId)] = v
+		}
+	}
+
+	return description, nil
+}
+
+// findStackLayersDescription returns the (possibly cached) description of all the layers in the specified stack.
+func findStackLayersDescription(ctx context.Context, c *conns.AWSClient, stackID string) (*stackLayersDescription, error) {
+	conn := c.OpsWorksClient(ctx)
+
+	return stackLayersDescriptions(ctx, c).get(ctx, newStackLayersDescriptionKey(ctx, c, stackID), func(ctx context.Context) (*stackLayersDescription, error) {
+		return describeStackLayers(ctx, conn, stackID)
+	})
+}
+
+// invalidateStackLayersDescription discards any cached description of the layers in the specified stack.
+func invalidateStackLayersDescription(ctx context.Context, c *conns.AWSClient, stackID string) {
+	stackLayersDescriptions(ctx, c).invalidate(newStackLayersDescriptionKey(ctx, c, stackID))
+}
+
+func newStackLayersDescriptionKey(ctx context.Context, c *conns.AWSClient, stackID string) stackLayersDescriptionKey {
+	return stackLayersDescriptionKey{
+		accountID: c.AccountID(ctx),
+		region:    c.Region(ctx),
+		stackID:   stackID,
+	}
+}
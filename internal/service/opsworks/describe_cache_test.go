@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opsworks
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
+)
+
+func TestStackLayersDescriptionCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	cache := newStackLayersDescriptionCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	stack1 := stackLayersDescriptionKey{accountID: "123456789012", region: "us-east-1", stackID: "stack1"}
+	stack2 := stackLayersDescriptionKey{accountID: "123456789012", region: "us-east-1", stackID: "stack2"}
+	stack3 := stackLayersDescriptionKey{accountID: "123456789012", region: "us-east-1", stackID: "stack3"}
+
+	var mu sync.Mutex
+	calls := 0
+	load := func(context.Context) (*stackLayersDescription, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return &stackLayersDescription{}, nil
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.get(ctx, stack1, load); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := calls, 1; got != want {
+		t.Errorf("concurrent gets: calls = %d, want %d", got, want)
+	}
+
+	if _, err := cache.get(ctx, stack2, load); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := calls, 2; got != want {
+		t.Errorf("different stack: calls = %d, want %d", got, want)
+	}
+
+	cache.invalidate(stack1)
+	if _, err := cache.get(ctx, stack1, load); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := calls, 3; got != want {
+		t.Errorf("after invalidate: calls = %d, want %d", got, want)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := cache.get(ctx, stack1, load); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := calls, 4; got != want {
+		t.Errorf("after expiry: calls = %d, want %d", got, want)
+	}
+
+	errLoad := errors.New("test")
+	if _, err := cache.get(ctx, stack3, func(context.Context) (*stackLayersDescription, error) { return nil, errLoad }); !errors.Is(err, errLoad) {
+		t.Errorf("error = %v, want %v", err, errLoad)
+	}
+	if _, err := cache.get(ctx, stack3, load); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := calls, 5; got != want {
+		t.Errorf("after error: calls = %d, want %d", got, want)
+	}
+}
+
+func TestStackLayersDescriptionCacheKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cache := newStackLayersDescriptionCache(time.Minute)
+
+	load := func(accountID string) func(context.Context) (*stackLayersDescription, error) {
+		return func(context.Context) (*stackLayersDescription, error) {
+			return &stackLayersDescription{layers: map[string]awstypes.Layer{accountID: {}}}, nil
+		}
+	}
+
+	key1 := stackLayersDescriptionKey{accountID: "111111111111", region: "us-east-1", stackID: "stack1"}
+	key2 := stackLayersDescriptionKey{accountID: "222222222222", region: "us-east-1", stackID: "stack1"}
+	key3 := stackLayersDescriptionKey{accountID: "111111111111", region: "us-west-2", stackID: "stack1"}
+
+	for _, key := range []stackLayersDescriptionKey{key1, key2, key3} {
+		if _, err := cache.get(ctx, key, load(key.accountID+key.region)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	for _, key := range []stackLayersDescriptionKey{key1, key2, key3} {
+		description, err := cache.get(ctx, key, load("unexpected"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, ok := description.layers[key.accountID+key.region]; !ok {
+			t.Errorf("%v: description of another account or Region returned", key)
+		}
+	}
+}
+
+func TestStackLayersDescriptionCacheEviction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Now()
+	cache := newStackLayersDescriptionCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	load := func(context.Context) (*stackLayersDescription, error) {
+		return &stackLayersDescription{}, nil
+	}
+
+	stack1 := stackLayersDescriptionKey{stackID: "stack1"}
+	stack2 := stackLayersDescriptionKey{stackID: "stack2"}
+	stack3 := stackLayersDescriptionKey{stackID: "stack3"}
+
+	if _, err := cache.get(ctx, stack1, load); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := cache.get(ctx, stack2, func(context.Context) (*stackLayersDescription, error) { return nil, errors.New("test") }); err == nil {
+		t.Fatal("expected error")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, err := cache.get(ctx, stack3, load); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(cache.entries), 1; got != want {
+		t.Errorf("entries = %d, want %d", got, want)
+	}
+	if _, ok := cache.entries[stack3]; !ok {
+		t.Errorf("entry for %v evicted", stack3)
+	}
+}
@@ -42,12 +42,64 @@ func TestAccOpsWorksECSClusterLayer_basic(t *testing.T) {
 	})
 }
 
+func TestAccOpsWorksECSClusterLayer_clusterAttachment(t *testing.T) {
+	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
+
+	ctx := acctest.Context(t)
+	var v awstypes.Layer
+	var cluster awstypes.EcsCluster
+	stackName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opsworks_ecs_cluster_layer.test"
+	attachmentResourceName := "aws_opsworks_ecs_cluster_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.OpsWorks) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpsWorksServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckECSClusterLayerDestroy(ctx),
+			testAccCheckECSClusterAttachmentDestroy(ctx),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccECSClusterLayerConfig_clusterAttachment(stackName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerExists(ctx, resourceName, &v),
+					testAccCheckECSClusterAttachmentExists(ctx, attachmentResourceName, &cluster),
+					resource.TestCheckResourceAttrPair(resourceName, "ecs_cluster_arn", "aws_ecs_cluster.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "ecs_cluster_arn", attachmentResourceName, "ecs_cluster_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_id", attachmentResourceName, "stack_id"),
+					resource.TestCheckResourceAttr(resourceName, "register_ecs_cluster", acctest.CtFalse),
+				),
+			},
+			{
+				// Adopt the registration using the layer's stack ID and ECS cluster ARN.
+				ResourceName:      attachmentResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccECSClusterLayerClusterAttachmentImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckECSClusterLayerDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		return testAccCheckLayerDestroy(ctx, "aws_opsworks_ecs_cluster_layer", s)
 	}
 }
 
+func testAccECSClusterLayerClusterAttachmentImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["stack_id"], rs.Primary.Attributes["ecs_cluster_arn"]), nil
+	}
+}
+
 func testAccECSClusterLayerConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccLayerConfig_base(rName), fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -62,3 +114,25 @@ resource "aws_opsworks_ecs_cluster_layer" "test" {
 }
 `, rName))
 }
+
+func testAccECSClusterLayerConfig_clusterAttachment(rName string) string {
+	return acctest.ConfigCompose(testAccLayerConfig_base(rName), fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_opsworks_ecs_cluster_attachment" "test" {
+  stack_id        = aws_opsworks_stack.test.id
+  ecs_cluster_arn = aws_ecs_cluster.test.arn
+}
+
+resource "aws_opsworks_ecs_cluster_layer" "test" {
+  stack_id        = aws_opsworks_stack.test.id
+  ecs_cluster_arn = aws_opsworks_ecs_cluster_attachment.test.ecs_cluster_arn
+
+  register_ecs_cluster = false
+
+  custom_security_group_ids = aws_security_group.test[*].id
+}
+`, rName))
+}
@@ -5,12 +5,15 @@ package opsworks
 
 // Exports for use in tests only.
 var (
-	ResourceRailsAppLayer = resourceRailsAppLayer
-	ResourceRDSDBInstance = resourceRDSDBInstance
-	ResourceStack         = resourceStack
-	ResourceUserProfile   = resourceUserProfile
+	ResourceECSClusterAttachment = newECSClusterAttachmentResource
+	ResourceRailsAppLayer        = resourceRailsAppLayer
+	ResourceRDSDBInstance        = resourceRDSDBInstance
+	ResourceStack                = resourceStack
+	ResourceUserProfile          = resourceUserProfile
 
 	FindAppByID                   = findAppByID
+	FindDeploymentByID            = findDeploymentByID
+	FindECSClusterByTwoPartKey    = findECSClusterByTwoPartKey
 	FindInstanceByID              = findInstanceByID
 	FindLayerByID                 = findLayerByID
 	FindPermissionByTwoPartKey    = findPermissionByTwoPartKey
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opsworks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opsworks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func findInstanceByID(ctx context.Context, conn *opsworks.Client, id string) (*awstypes.Instance, error) {
+	input := &opsworks.DescribeInstancesInput{
+		InstanceIds: []string{id},
+	}
+
+	output, err := conn.DescribeInstances(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Instances == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Instances)
+}
+
+func findInstancesByLayerID(ctx context.Context, conn *opsworks.Client, layerID string) ([]awstypes.Instance, error) {
+	input := &opsworks.DescribeInstancesInput{
+		LayerId: aws.String(layerID),
+	}
+
+	output, err := conn.DescribeInstances(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Instances, nil
+}
+
+func findLayerByID(ctx context.Context, conn *opsworks.Client, id string) (*awstypes.Layer, error) {
+	input := &opsworks.DescribeLayersInput{
+		LayerIds: []string{id},
+	}
+
+	output, err := conn.DescribeLayers(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Layers) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Layers); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Layers)
+}
+
+func findElasticLoadBalancerByLayerID(ctx context.Context, conn *opsworks.Client, id string) (*awstypes.ElasticLoadBalancer, error) {
+	input := &opsworks.DescribeElasticLoadBalancersInput{
+		LayerIds: []string{id},
+	}
+
+	output, err := conn.DescribeElasticLoadBalancers(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ElasticLoadBalancers) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ElasticLoadBalancers); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.ElasticLoadBalancers)
+}
+
+func findLoadBasedAutoScalingConfigurationByLayerID(ctx context.Context, conn *opsworks.Client, id string) (*awstypes.LoadBasedAutoScalingConfiguration, error) {
+	input := &opsworks.DescribeLoadBasedAutoScalingInput{
+		LayerIds: []string{id},
+	}
+
+	output, err := conn.DescribeLoadBasedAutoScaling(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.LoadBasedAutoScalingConfigurations) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.LoadBasedAutoScalingConfigurations); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.LoadBasedAutoScalingConfigurations)
+}
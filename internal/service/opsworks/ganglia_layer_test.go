@@ -39,6 +39,13 @@ func TestAccOpsWorksGangliaLayer_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrUsername, "opsworks"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The password is write-only and isn't returned by the API.
+				ImportStateVerifyIgnore: []string{names.AttrPassword},
+			},
 		},
 	})
 }
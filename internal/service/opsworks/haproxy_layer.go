@@ -6,6 +6,7 @@ package opsworks
 import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 )
 
 // @SDKResource("aws_opsworks_haproxy_layer", name="HAProxy Layer")
@@ -17,9 +18,10 @@ func resourceHAProxyLayer() *schema.Resource {
 
 		Attributes: map[string]*opsworksLayerTypeAttribute{
 			"healthcheck_method": {
-				AttrName: awstypes.LayerAttributesKeysHaproxyHealthCheckMethod,
-				Type:     schema.TypeString,
-				Default:  "OPTIONS",
+				AttrName:         awstypes.LayerAttributesKeysHaproxyHealthCheckMethod,
+				Type:             schema.TypeString,
+				Default:          "OPTIONS",
+				ValidateDiagFunc: enum.Validate[haproxyHealthCheckMethod](),
 			},
 			"healthcheck_url": {
 				AttrName: awstypes.LayerAttributesKeysHaproxyHealthCheckUrl,
@@ -42,6 +42,13 @@ func TestAccOpsWorksHAProxyLayer_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "stats_user", "opsworks"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The password is write-only and isn't returned by the API.
+				ImportStateVerifyIgnore: []string{"stats_password"},
+			},
 		},
 	})
 }
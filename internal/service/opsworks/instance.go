@@ -14,13 +14,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/opsworks"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -817,10 +815,6 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta an
 
 	_, err := conn.DeleteInstance(ctx, req)
 
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		return diags
-	}
-
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting OpsWorks instance (%s): %s", d.Id(), err)
 	}
@@ -832,30 +826,6 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta an
 	return diags
 }
 
-func findInstanceByID(ctx context.Context, conn *opsworks.Client, id string) (*awstypes.Instance, error) {
-	input := &opsworks.DescribeInstancesInput{
-		InstanceIds: []string{id},
-	}
-
-	output, err := conn.DescribeInstances(ctx, input)
-
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	if output == nil || output.Instances == nil {
-		return nil, tfresource.NewEmptyResultError(input)
-	}
-
-	return tfresource.AssertSingleValueResult(output.Instances)
-}
-
 func resourceInstanceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	// Neither delete_eip nor delete_ebs can be fetched
 	// from any API call, so we need to default to the values
@@ -915,71 +885,6 @@ func stopInstance(ctx context.Context, d *schema.ResourceData, meta any, timeout
 	return nil
 }
 
-func waitInstanceDeleted(ctx context.Context, conn *opsworks.Client, instanceId string) error {
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{instanceStatusStopped, instanceStatusTerminating, instanceStatusTerminated},
-		Target:     []string{},
-		Refresh:    instanceStatus(ctx, conn, instanceId),
-		Timeout:    2 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err := stateConf.WaitForStateContext(ctx)
-	return err
-}
-
-func waitInstanceStarted(ctx context.Context, conn *opsworks.Client, instanceId string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{instanceStatusRequested, instanceStatusPending, instanceStatusBooting, instanceStatusRunningSetup},
-		Target:     []string{instanceStatusOnline},
-		Refresh:    instanceStatus(ctx, conn, instanceId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-	_, err := stateConf.WaitForStateContext(ctx)
-	return err
-}
-
-func waitInstanceStopped(ctx context.Context, conn *opsworks.Client, instanceId string, timeout time.Duration) error {
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{instanceStatusStopping, instanceStatusTerminating, instanceStatusShuttingDown, instanceStatusTerminated},
-		Target:     []string{instanceStatusStopped},
-		Refresh:    instanceStatus(ctx, conn, instanceId),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-	_, err := stateConf.WaitForStateContext(ctx)
-	return err
-}
-
-func instanceStatus(ctx context.Context, conn *opsworks.Client, instanceID string) retry.StateRefreshFunc {
-	return func() (any, string, error) {
-		resp, err := conn.DescribeInstances(ctx, &opsworks.DescribeInstancesInput{
-			InstanceIds: []string{instanceID},
-		})
-
-		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		if resp == nil || len(resp.Instances) == 0 {
-			// Sometimes AWS just has consistency issues and doesn't see
-			// our instance yet. Return an empty state.
-			return nil, "", nil
-		}
-
-		i := resp.Instances[0]
-		return i, aws.ToString(i.Status), nil
-	}
-}
-
 func readBlockDevices(instance *awstypes.Instance) map[string]any {
 	blockDevices := make(map[string]any)
 	blockDevices["ebs"] = make([]map[string]any, 0)
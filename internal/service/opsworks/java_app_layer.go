@@ -6,6 +6,7 @@ package opsworks
 import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 )
 
 // @SDKResource("aws_opsworks_java_app_layer", name="Java App Layer")
@@ -17,14 +18,16 @@ func resourceJavaAppLayer() *schema.Resource {
 
 		Attributes: map[string]*opsworksLayerTypeAttribute{
 			"app_server": {
-				AttrName: awstypes.LayerAttributesKeysJavaAppServer,
-				Type:     schema.TypeString,
-				Default:  "tomcat",
+				AttrName:         awstypes.LayerAttributesKeysJavaAppServer,
+				Type:             schema.TypeString,
+				Default:          "tomcat",
+				ValidateDiagFunc: enum.Validate[javaAppServer](),
 			},
 			"app_server_version": {
-				AttrName: awstypes.LayerAttributesKeysJavaAppServerVersion,
-				Type:     schema.TypeString,
-				Default:  "7",
+				AttrName:         awstypes.LayerAttributesKeysJavaAppServerVersion,
+				Type:             schema.TypeString,
+				Default:          "7",
+				ValidateDiagFunc: enum.Validate[javaAppServerVersion](),
 			},
 			"jvm_options": {
 				AttrName: awstypes.LayerAttributesKeysJvmOptions,
@@ -37,9 +40,10 @@ func resourceJavaAppLayer() *schema.Resource {
 				Default:  "openjdk",
 			},
 			"jvm_version": {
-				AttrName: awstypes.LayerAttributesKeysJvmVersion,
-				Type:     schema.TypeString,
-				Default:  "7",
+				AttrName:         awstypes.LayerAttributesKeysJvmVersion,
+				Type:             schema.TypeString,
+				Default:          "7",
+				ValidateDiagFunc: enum.Validate[jvmVersion](),
 			},
 		},
 	}
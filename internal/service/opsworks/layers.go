@@ -7,23 +7,25 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"maps"
 	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/opsworks"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -47,7 +49,21 @@ type opsworksLayerTypeAttribute struct {
 	ForceNew     bool
 	Required     bool
 	ValidateFunc schema.SchemaValidateFunc
-	WriteOnly    bool
+	// ValidateDiagFunc is used for attributes that accept a fixed set of values, see consts.go.
+	ValidateDiagFunc schema.SchemaValidateDiagFunc
+	// WriteOnly attributes are secrets that the API does not return.
+	// String-typed write-only attributes also get a genuine Terraform write-only
+	// companion argument (<name>_wo) and its trigger (<name>_wo_version).
+	WriteOnly bool
+}
+
+const (
+	writeOnlyAttrSuffix        = "_wo"
+	writeOnlyVersionAttrSuffix = "_wo_version"
+)
+
+func (attr *opsworksLayerTypeAttribute) hasWriteOnlyArgument() bool {
+	return attr.WriteOnly && attr.Type == schema.TypeString
 }
 
 type opsworksLayerTypeAttributeMap map[string]*opsworksLayerTypeAttribute
@@ -80,101 +96,7 @@ func (lt *opsworksLayerType) resourceSchema() *schema.Resource {
 			Optional: true,
 			Default:  true,
 		},
-		"cloudwatch_configuration": {
-			Type:     schema.TypeList,
-			Optional: true,
-			MaxItems: 1,
-			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-				if old == "1" && new == "0" && !d.Get("cloudwatch_configuration.0.enabled").(bool) {
-					return true
-				}
-				return false
-			},
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					names.AttrEnabled: {
-						Type:     schema.TypeBool,
-						Optional: true,
-						DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-							if old == "false" && new == "" {
-								return true
-							}
-							return false
-						},
-					},
-					"log_streams": {
-						Type:     schema.TypeList,
-						Optional: true,
-						DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-							if old == "1" && new == "0" && !d.Get("cloudwatch_configuration.0.enabled").(bool) {
-								return true
-							}
-							return false
-						},
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								"batch_count": {
-									Type:         schema.TypeInt,
-									Default:      1000,
-									Optional:     true,
-									ValidateFunc: validation.IntAtMost(10000),
-								},
-								"batch_size": {
-									Type:         schema.TypeInt,
-									Default:      32768,
-									Optional:     true,
-									ValidateFunc: validation.IntAtMost(1048576),
-								},
-								"buffer_duration": {
-									Type:         schema.TypeInt,
-									Default:      5000,
-									Optional:     true,
-									ValidateFunc: validation.IntAtLeast(5000),
-								},
-								"datetime_format": {
-									Type:     schema.TypeString,
-									Optional: true,
-								},
-								"encoding": {
-									Type:             schema.TypeString,
-									Optional:         true,
-									Default:          awstypes.CloudWatchLogsEncodingUtf8,
-									ValidateDiagFunc: enum.Validate[awstypes.CloudWatchLogsEncoding](),
-								},
-								"file": {
-									Type:     schema.TypeString,
-									Required: true,
-								},
-								"file_fingerprint_lines": {
-									Type:     schema.TypeString,
-									Optional: true,
-									Default:  "1",
-								},
-								"initial_position": {
-									Type:             schema.TypeString,
-									Optional:         true,
-									Default:          awstypes.CloudWatchLogsInitialPositionStartOfFile,
-									ValidateDiagFunc: enum.Validate[awstypes.CloudWatchLogsInitialPosition](),
-								},
-								names.AttrLogGroupName: {
-									Type:     schema.TypeString,
-									Required: true,
-								},
-								"multiline_start_pattern": {
-									Type:     schema.TypeString,
-									Optional: true,
-								},
-								"time_zone": {
-									Type:             schema.TypeString,
-									Optional:         true,
-									ValidateDiagFunc: enum.Validate[awstypes.CloudWatchLogsTimeZone](),
-								},
-							},
-						},
-					},
-				},
-			},
-		},
+		"cloudwatch_configuration": cloudWatchConfigurationSchema(schema.TypeSet),
 		"custom_configure_recipes": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -190,14 +112,18 @@ func (lt *opsworksLayerType) resourceSchema() *schema.Resource {
 			Optional:     true,
 			ValidateFunc: verify.ValidARN,
 		},
+		// Equivalent JSON documents are suppressed, but a changed document is still shown as a whole-string diff.
+		// The SDKv2 plan renderer has no way to show a structured, key-level diff of a JSON string attribute.
 		"custom_json": {
-			Type:         schema.TypeString,
-			ValidateFunc: validation.StringIsJSON,
+			Type:                  schema.TypeString,
+			Optional:              true,
+			ValidateFunc:          validation.StringIsJSON,
+			DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+			DiffSuppressOnRefresh: true,
 			StateFunc: func(v any) string {
 				json, _ := structure.NormalizeJsonString(v)
 				return json
 			},
-			Optional: true,
 		},
 		"custom_security_group_ids": {
 			Type:     schema.TypeSet,
@@ -220,9 +146,12 @@ func (lt *opsworksLayerType) resourceSchema() *schema.Resource {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		"drain_elb_on_shutdown": {
-			Type:     schema.TypeBool,
-			Optional: true,
-			Default:  true,
+			Type:             schema.TypeBool,
+			Optional:         true,
+			ConflictsWith:    []string{"lifecycle_event_configuration"},
+			Deprecated:       "drain_elb_on_shutdown is deprecated. Use lifecycle_event_configuration instead.",
+			Default:          defaultLayerDrainELBOnShutdown,
+			DiffSuppressFunc: suppressIfLifecycleEventConfigurationConfigured,
 		},
 		"ebs_volume": {
 			Type:     schema.TypeSet,
@@ -281,15 +210,48 @@ func (lt *opsworksLayerType) resourceSchema() *schema.Resource {
 			Optional: true,
 		},
 		"instance_shutdown_timeout": {
-			Type:     schema.TypeInt,
-			Optional: true,
-			Default:  120,
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ConflictsWith:    []string{"lifecycle_event_configuration"},
+			Deprecated:       "instance_shutdown_timeout is deprecated. Use lifecycle_event_configuration instead.",
+			Default:          defaultLayerInstanceShutdownTimeout,
+			DiffSuppressFunc: suppressIfLifecycleEventConfigurationConfigured,
 		},
 		"install_updates_on_boot": {
 			Type:     schema.TypeBool,
 			Optional: true,
 			Default:  true,
 		},
+		"lifecycle_event_configuration": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			Computed:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"drain_elb_on_shutdown", "instance_shutdown_timeout"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"shutdown": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"delay_until_elb_connections_drained": {
+									Type:     schema.TypeBool,
+									Optional: true,
+									Default:  defaultLayerDrainELBOnShutdown,
+								},
+								"execution_timeout": {
+									Type:     schema.TypeInt,
+									Optional: true,
+									Default:  defaultLayerInstanceShutdownTimeout,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"load_based_auto_scaling": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -396,6 +358,12 @@ func (lt *opsworksLayerType) resourceSchema() *schema.Resource {
 				},
 			},
 		},
+		"security_group_update_strategy": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          layerSecurityGroupUpdateStrategyReplace,
+			ValidateDiagFunc: enum.Validate[layerSecurityGroupUpdateStrategy](),
+		},
 		"system_packages": {
 			Type:     schema.TypeSet,
 			Optional: true,
@@ -413,6 +381,11 @@ func (lt *opsworksLayerType) resourceSchema() *schema.Resource {
 			Optional: true,
 			Default:  false,
 		},
+		"wait_for_instances_online": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 	}
 
 	if lt.CustomShortName {
@@ -437,15 +410,63 @@ func (lt *opsworksLayerType) resourceSchema() *schema.Resource {
 
 	for key, def := range lt.Attributes {
 		resourceSchema[key] = &schema.Schema{
-			Type:         def.Type,
-			Default:      def.Default,
-			ForceNew:     def.ForceNew,
-			Required:     def.Required,
-			Optional:     !def.Required,
-			ValidateFunc: def.ValidateFunc,
+			Type:             def.Type,
+			Default:          def.Default,
+			ForceNew:         def.ForceNew,
+			Required:         def.Required,
+			Optional:         !def.Required,
+			ValidateFunc:     def.ValidateFunc,
+			ValidateDiagFunc: def.ValidateDiagFunc,
+		}
+
+		if def.hasWriteOnlyArgument() {
+			keyWO, keyWOVersion := key+writeOnlyAttrSuffix, key+writeOnlyVersionAttrSuffix
+
+			resourceSchema[key].Sensitive = true
+			resourceSchema[keyWO] = &schema.Schema{
+				Type:             def.Type,
+				Optional:         true,
+				WriteOnly:        true,
+				Sensitive:        true,
+				ValidateFunc:     def.ValidateFunc,
+				ValidateDiagFunc: def.ValidateDiagFunc,
+				RequiredWith:     []string{keyWOVersion},
+			}
+			resourceSchema[keyWOVersion] = &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     def.ForceNew,
+				RequiredWith: []string{keyWO},
+			}
+
+			if def.Required {
+				resourceSchema[key].Required = false
+				resourceSchema[key].Optional = true
+				resourceSchema[key].ExactlyOneOf = []string{key, keyWO}
+				resourceSchema[keyWO].ExactlyOneOf = []string{key, keyWO}
+			} else {
+				resourceSchema[key].ConflictsWith = []string{keyWO}
+				resourceSchema[keyWO].ConflictsWith = []string{key}
+			}
 		}
 	}
 
+	if lt.TypeName == awstypes.LayerTypeEcsCluster {
+		// Registering the ECS cluster with the stack is moving to aws_opsworks_ecs_cluster_attachment.
+		// Until then the layer keeps registering the cluster unless told not to.
+		resourceSchema["register_ecs_cluster"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		}
+	}
+
+	resourceSchemaV0 := maps.Clone(resourceSchema)
+	resourceSchemaV0["cloudwatch_configuration"] = cloudWatchConfigurationSchema(schema.TypeList)
+	resourceV0 := &schema.Resource{
+		Schema: resourceSchemaV0,
+	}
+
 	return &schema.Resource{
 		DeprecationMessage: "This resource is deprecated and will be removed in the next major version of the AWS Provider. Consider the AWS Systems Manager service instead.",
 		CreateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -462,7 +483,31 @@ func (lt *opsworksLayerType) resourceSchema() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				d.Set("security_group_update_strategy", layerSecurityGroupUpdateStrategyReplace)
+				d.Set("wait_for_instances_online", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: loadBasedAutoScalingCustomizeDiff,
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type: resourceV0.CoreConfigSchema().ImpliedType(),
+				Upgrade: func(_ context.Context, rawState map[string]any, _ any) (map[string]any, error) {
+					// cloudwatch_configuration.log_streams changed from a list to a set.
+					// Both are stored as JSON arrays, so the state needs no changes.
+					return rawState, nil
+				},
+				Version: 0,
+			},
 		},
 
 		SchemaFunc: func() map[string]*schema.Schema {
@@ -471,33 +516,162 @@ func (lt *opsworksLayerType) resourceSchema() *schema.Resource {
 	}
 }
 
+// loadBasedAutoScalingCustomizeDiff disables load-based auto scaling when the load_based_auto_scaling block is removed from configuration.
+// The attribute is Optional+Computed, so without this removing the block would produce no diff and leave scaling enabled.
+func loadBasedAutoScalingCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if v := d.GetRawConfig().GetAttr("load_based_auto_scaling"); !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0) {
+		return nil
+	}
+
+	o, _ := d.GetChange("load_based_auto_scaling")
+	tfList, ok := o.([]any)
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := maps.Clone(tfList[0].(map[string]any))
+	if v, ok := tfMap["enable"].(bool); !ok || !v {
+		return nil
+	}
+
+	tfMap["enable"] = false
+
+	return d.SetNew("load_based_auto_scaling", []any{tfMap})
+}
+
+// cloudWatchConfigurationSchema returns the schema of the cloudwatch_configuration block.
+// Before schema version 1, log_streams was a list.
+func cloudWatchConfigurationSchema(logStreamsType schema.ValueType) *schema.Schema {
+	v := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			if old == "1" && new == "0" && !d.Get("cloudwatch_configuration.0.enabled").(bool) {
+				return true
+			}
+			return false
+		},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrEnabled: {
+					Type:     schema.TypeBool,
+					Optional: true,
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						if old == "false" && new == "" {
+							return true
+						}
+						return false
+					},
+				},
+				"log_streams": {
+					Type:     logStreamsType,
+					Optional: true,
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						if old == "1" && new == "0" && !d.Get("cloudwatch_configuration.0.enabled").(bool) {
+							return true
+						}
+						return false
+					},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"batch_count": {
+								Type:         schema.TypeInt,
+								Default:      1000,
+								Optional:     true,
+								ValidateFunc: validation.IntAtMost(10000),
+							},
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Default:      32768,
+								Optional:     true,
+								ValidateFunc: validation.IntAtMost(1048576),
+							},
+							"buffer_duration": {
+								Type:         schema.TypeInt,
+								Default:      5000,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(5000),
+							},
+							"datetime_format": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"encoding": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          awstypes.CloudWatchLogsEncodingUtf8,
+								ValidateDiagFunc: enum.Validate[awstypes.CloudWatchLogsEncoding](),
+							},
+							"file": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"file_fingerprint_lines": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  "1",
+							},
+							"initial_position": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          awstypes.CloudWatchLogsInitialPositionStartOfFile,
+								ValidateDiagFunc: enum.Validate[awstypes.CloudWatchLogsInitialPosition](),
+							},
+							names.AttrLogGroupName: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"multiline_start_pattern": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"time_zone": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.CloudWatchLogsTimeZone](),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if logStreamsType == schema.TypeSet {
+		// Key log streams on their source file and destination log group so that reordering them doesn't produce a diff.
+		v.Elem.(*schema.Resource).Schema["log_streams"].Set = sdkv2.SimpleSchemaSetFunc("file", names.AttrLogGroupName)
+	}
+
+	return v
+}
+
 func (lt *opsworksLayerType) Create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OpsWorksClient(ctx)
 
-	attributes, err := lt.Attributes.resourceDataToAPIAttributes(d)
-
-	if err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	attributes, di := lt.Attributes.resourceDataToAPIAttributes(d)
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
 	}
 
 	name := d.Get(names.AttrName).(string)
 	input := &opsworks.CreateLayerInput{
-		Attributes:           attributes,
-		AutoAssignElasticIps: aws.Bool(d.Get("auto_assign_elastic_ips").(bool)),
-		AutoAssignPublicIps:  aws.Bool(d.Get("auto_assign_public_ips").(bool)),
-		CustomRecipes:        &awstypes.Recipes{},
-		EnableAutoHealing:    aws.Bool(d.Get("auto_healing").(bool)),
-		InstallUpdatesOnBoot: aws.Bool(d.Get("install_updates_on_boot").(bool)),
-		LifecycleEventConfiguration: &awstypes.LifecycleEventConfiguration{
-			Shutdown: &awstypes.ShutdownEventConfiguration{
-				DelayUntilElbConnectionsDrained: aws.Bool(d.Get("drain_elb_on_shutdown").(bool)),
-			},
-		},
-		Name:                     aws.String(name),
-		Type:                     lt.TypeName,
-		StackId:                  aws.String(d.Get("stack_id").(string)),
-		UseEbsOptimizedInstances: aws.Bool(d.Get("use_ebs_optimized_instances").(bool)),
+		Attributes:                  attributes,
+		AutoAssignElasticIps:        aws.Bool(d.Get("auto_assign_elastic_ips").(bool)),
+		AutoAssignPublicIps:         aws.Bool(d.Get("auto_assign_public_ips").(bool)),
+		CustomRecipes:               &awstypes.Recipes{},
+		EnableAutoHealing:           aws.Bool(d.Get("auto_healing").(bool)),
+		InstallUpdatesOnBoot:        aws.Bool(d.Get("install_updates_on_boot").(bool)),
+		LifecycleEventConfiguration: expandLifecycleEventConfiguration(d),
+		Name:                        aws.String(name),
+		Type:                        lt.TypeName,
+		StackId:                     aws.String(d.Get("stack_id").(string)),
+		UseEbsOptimizedInstances:    aws.Bool(d.Get("use_ebs_optimized_instances").(bool)),
 	}
 
 	if v, ok := d.GetOk("cloudwatch_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
@@ -540,10 +714,6 @@ func (lt *opsworksLayerType) Create(ctx context.Context, d *schema.ResourceData,
 		input.VolumeConfigurations = expandVolumeConfigurations(v.(*schema.Set).List())
 	}
 
-	if v, ok := d.GetOk("instance_shutdown_timeout"); ok {
-		input.LifecycleEventConfiguration.Shutdown.ExecutionTimeout = aws.Int32(int32(v.(int)))
-	}
-
 	if lt.CustomShortName {
 		input.Shortname = aws.String(d.Get("short_name").(string))
 	} else {
@@ -554,8 +724,8 @@ func (lt *opsworksLayerType) Create(ctx context.Context, d *schema.ResourceData,
 		input.Packages = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("ecs_cluster_arn"); ok {
-		arn := v.(string)
+	if lt.registersECSCluster(d) {
+		arn := d.Get("ecs_cluster_arn").(string)
 		_, err := conn.RegisterEcsCluster(ctx, &opsworks.RegisterEcsClusterInput{
 			EcsClusterArn: aws.String(arn),
 			StackId:       input.StackId,
@@ -598,19 +768,27 @@ func (lt *opsworksLayerType) Create(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if tags := keyValueTags(ctx, getTagsIn(ctx)); len(tags) > 0 {
+	// CreateLayer returns only the layer ID, so the layer is read back for its ARN.
+	if tags := getTagsIn(ctx); len(tags) > 0 {
 		layer, err := findLayerByID(ctx, conn, d.Id())
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "reading OpsWorks Layer (%s): %s", d.Id(), err)
 		}
 
+		// The new layer may not yet be visible to TagResource.
 		arn := aws.ToString(layer.Arn)
-		if err := updateTags(ctx, conn, arn, nil, tags); err != nil {
-			return sdkdiag.AppendErrorf(diags, "adding OpsWorks Layer (%s) tags: %s", arn, err)
+		_, err = tfresource.RetryWhenIsA[*awstypes.ResourceNotFoundException](ctx, propagationTimeout, func() (any, error) {
+			return nil, createTags(ctx, conn, arn, tags)
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting OpsWorks Layer (%s) tags: %s", arn, err)
 		}
 	}
 
+	invalidateStackLayersDescription(ctx, meta.(*conns.AWSClient), d.Get("stack_id").(string))
+
 	return append(diags, lt.Read(ctx, d, meta)...)
 }
 
@@ -618,7 +796,50 @@ func (lt *opsworksLayerType) Read(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OpsWorksClient(ctx)
 
-	layer, err := findLayerByID(ctx, conn, d.Id())
+	var layer *awstypes.Layer
+	var loadBalancer *awstypes.ElasticLoadBalancer
+	var loadBasedAutoScalingConfiguration *awstypes.LoadBasedAutoScalingConfiguration
+	var err error
+
+	// When the stack is known, describe all its layers at once and share the result with the stack's other layers.
+	if stackID := d.Get("stack_id").(string); stackID != "" && !d.IsNewResource() {
+		var description *stackLayersDescription
+		description, err = findStackLayersDescription(ctx, meta.(*conns.AWSClient), stackID)
+
+		if err == nil {
+			if v, ok := description.layers[d.Id()]; ok {
+				layer = &v
+			} else {
+				err = tfresource.NewEmptyResultError(nil)
+			}
+			if v, ok := description.elasticLoadBalancers[d.Id()]; ok {
+				loadBalancer = &v
+			}
+			if v, ok := description.loadBasedAutoScalingConfigurations[d.Id()]; ok {
+				loadBasedAutoScalingConfiguration = &v
+			}
+		}
+	} else {
+		layer, err = findLayerByID(ctx, conn, d.Id())
+
+		if err == nil {
+			loadBalancer, err = findElasticLoadBalancerByLayerID(ctx, conn, d.Id())
+
+			if tfresource.NotFound(err) {
+				err = nil
+			} else if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading OpsWorks Layer (%s) load balancers: %s", d.Id(), err)
+			}
+
+			loadBasedAutoScalingConfiguration, err = findLoadBasedAutoScalingConfigurationByLayerID(ctx, conn, d.Id())
+
+			if tfresource.NotFound(err) {
+				err = nil
+			} else if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading OpsWorks Layer (%s) load-based auto scaling configurations: %s", d.Id(), err)
+			}
+		}
+	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] OpsWorks Layer %s not found, removing from state", d.Id())
@@ -659,20 +880,23 @@ func (lt *opsworksLayerType) Read(ctx context.Context, d *schema.ResourceData, m
 	if layer.CustomJson == nil {
 		d.Set("custom_json", "")
 	} else {
-		policy, err := structure.NormalizeJsonString(aws.ToString(layer.CustomJson))
+		customJSON, err := structure.NormalizeJsonString(aws.ToString(layer.CustomJson))
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "policy contains an invalid JSON: %s", err)
+			return sdkdiag.AppendErrorf(diags, "custom_json contains an invalid JSON: %s", err)
 		}
-		d.Set("custom_json", policy)
+		d.Set("custom_json", customJSON)
 	}
 	d.Set("custom_security_group_ids", layer.CustomSecurityGroupIds)
 	if layer.LifecycleEventConfiguration == nil || layer.LifecycleEventConfiguration.Shutdown == nil {
-		d.Set("drain_elb_on_shutdown", nil)
-		d.Set("instance_shutdown_timeout", nil)
+		d.Set("drain_elb_on_shutdown", defaultLayerDrainELBOnShutdown)
+		d.Set("instance_shutdown_timeout", defaultLayerInstanceShutdownTimeout)
 	} else {
 		d.Set("drain_elb_on_shutdown", layer.LifecycleEventConfiguration.Shutdown.DelayUntilElbConnectionsDrained)
 		d.Set("instance_shutdown_timeout", layer.LifecycleEventConfiguration.Shutdown.ExecutionTimeout)
 	}
+	if err := d.Set("lifecycle_event_configuration", []any{flattenLifecycleEventConfiguration(layer.LifecycleEventConfiguration)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting lifecycle_event_configuration: %s", err)
+	}
 	if err := d.Set("ebs_volume", flattenVolumeConfigurations(layer.VolumeConfigurations)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ebs_volume: %s", err)
 	}
@@ -684,31 +908,29 @@ func (lt *opsworksLayerType) Read(ctx context.Context, d *schema.ResourceData, m
 	d.Set("system_packages", layer.Packages)
 	d.Set("stack_id", layer.StackId)
 	d.Set("use_ebs_optimized_instances", layer.UseEbsOptimizedInstances)
+	if lt.TypeName == awstypes.LayerTypeEcsCluster {
+		// Layers created before register_ecs_cluster was added registered their ECS cluster.
+		if v := d.GetRawState(); !v.IsNull() && v.GetAttr("register_ecs_cluster").IsNull() {
+			d.Set("register_ecs_cluster", true)
+		}
+	}
 
 	if err := lt.Attributes.apiAttributesToResourceData(layer.Attributes, d); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	loadBalancer, err := findElasticLoadBalancerByLayerID(ctx, conn, d.Id())
-
-	if err == nil {
+	if loadBalancer != nil {
 		d.Set("elastic_load_balancer", loadBalancer.ElasticLoadBalancerName)
-	} else if tfresource.NotFound(err) {
-		d.Set("elastic_load_balancer", nil)
 	} else {
-		return sdkdiag.AppendErrorf(diags, "reading OpsWorks Layer (%s) load balancers: %s", d.Id(), err)
+		d.Set("elastic_load_balancer", nil)
 	}
 
-	loadBasedAutoScalingConfiguration, err := findLoadBasedAutoScalingConfigurationByLayerID(ctx, conn, d.Id())
-
-	if err == nil {
+	if loadBasedAutoScalingConfiguration != nil {
 		if err := d.Set("load_based_auto_scaling", []any{flattenLoadBasedAutoScalingConfiguration(loadBasedAutoScalingConfiguration)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting load_based_auto_scaling: %s", err)
 		}
-	} else if tfresource.NotFound(err) {
-		d.Set("load_based_auto_scaling", nil)
 	} else {
-		return sdkdiag.AppendErrorf(diags, "reading OpsWorks Layer (%s) load-based auto scaling configurations: %s", d.Id(), err)
+		d.Set("load_based_auto_scaling", nil)
 	}
 
 	return nil
@@ -718,16 +940,19 @@ func (lt *opsworksLayerType) Update(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OpsWorksClient(ctx)
 
-	if d.HasChangesExcept("elastic_load_balancer", "load_based_auto_scaling", names.AttrTags, names.AttrTagsAll) {
+	// Discard the stack's cached layer descriptions even if the update only partially succeeds.
+	defer invalidateStackLayersDescription(ctx, meta.(*conns.AWSClient), d.Get("stack_id").(string))
+
+	if d.HasChangesExcept("elastic_load_balancer", "load_based_auto_scaling", "register_ecs_cluster", "security_group_update_strategy", names.AttrTags, names.AttrTagsAll, "wait_for_instances_online") {
 		input := &opsworks.UpdateLayerInput{
 			LayerId: aws.String(d.Id()),
 		}
 
-		if d.HasChanges(tfmaps.Keys(lt.Attributes)...) {
-			attributes, err := lt.Attributes.resourceDataToAPIAttributes(d)
-
-			if err != nil {
-				return sdkdiag.AppendFromErr(diags, err)
+		if d.HasChanges(lt.Attributes.resourceDataKeys()...) {
+			attributes, di := lt.Attributes.resourceDataToAPIAttributes(d)
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
 			}
 
 			input.Attributes = attributes
@@ -742,7 +967,7 @@ func (lt *opsworksLayerType) Update(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if d.HasChanges("auto_healing") {
-			input.EnableAutoHealing = aws.Bool(d.Get("auto_assign_public_ips").(bool))
+			input.EnableAutoHealing = aws.Bool(d.Get("auto_healing").(bool))
 		}
 
 		if d.HasChanges("cloudwatch_configuration") {
@@ -785,17 +1010,25 @@ func (lt *opsworksLayerType) Update(ctx context.Context, d *schema.ResourceData,
 			input.CustomJson = aws.String(d.Get("custom_json").(string))
 		}
 
+		var removeSecurityGroups bool
 		if d.HasChanges("custom_security_group_ids") {
-			input.CustomSecurityGroupIds = flex.ExpandStringValueSet(d.Get("custom_security_group_ids").(*schema.Set))
+			o, n := d.GetChange("custom_security_group_ids")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			// With add_then_remove, when security groups are both added and removed, the first UpdateLayer call
+			// attaches the union of the old and new groups so that instances don't lose connectivity, and a second
+			// UpdateLayer call below detaches the old groups. The union must fit within the security group limits,
+			// and if the second call fails the old groups stay attached until the next apply.
+			if layerSecurityGroupUpdateStrategy(d.Get("security_group_update_strategy").(string)) == layerSecurityGroupUpdateStrategyAddThenRemove && ns.Difference(os).Len() > 0 && os.Difference(ns).Len() > 0 {
+				input.CustomSecurityGroupIds = flex.ExpandStringValueSet(os.Union(ns))
+				removeSecurityGroups = true
+			} else {
+				input.CustomSecurityGroupIds = flex.ExpandStringValueSet(ns)
+			}
 		}
 
-		if d.HasChanges("drain_elb_on_shutdown", "instance_shutdown_timeout") {
-			input.LifecycleEventConfiguration = &awstypes.LifecycleEventConfiguration{
-				Shutdown: &awstypes.ShutdownEventConfiguration{
-					DelayUntilElbConnectionsDrained: aws.Bool(d.Get("drain_elb_on_shutdown").(bool)),
-					ExecutionTimeout:                aws.Int32(int32(d.Get("instance_shutdown_timeout").(int))),
-				},
-			}
+		if d.HasChanges("drain_elb_on_shutdown", "instance_shutdown_timeout", "lifecycle_event_configuration") {
+			input.LifecycleEventConfiguration = expandLifecycleEventConfiguration(d)
 		}
 
 		if d.HasChanges("ebs_volume") {
@@ -821,7 +1054,7 @@ func (lt *opsworksLayerType) Update(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if d.HasChanges("use_ebs_optimized_instances") {
-			input.UseEbsOptimizedInstances = aws.Bool(d.Get("install_updates_on_boot").(bool))
+			input.UseEbsOptimizedInstances = aws.Bool(d.Get("use_ebs_optimized_instances").(bool))
 		}
 
 		log.Printf("[DEBUG] Updating OpsWorks Layer: %#v", input)
@@ -830,6 +1063,19 @@ func (lt *opsworksLayerType) Update(ctx context.Context, d *schema.ResourceData,
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating OpsWorks Layer (%s): %s", d.Id(), err)
 		}
+
+		if removeSecurityGroups {
+			input := &opsworks.UpdateLayerInput{
+				CustomSecurityGroupIds: flex.ExpandStringValueSet(d.Get("custom_security_group_ids").(*schema.Set)),
+				LayerId:                aws.String(d.Id()),
+			}
+
+			_, err := conn.UpdateLayer(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing OpsWorks Layer (%s) custom security groups: %s", d.Id(), err)
+			}
+		}
 	}
 
 	if d.HasChange("elastic_load_balancer") {
@@ -858,17 +1104,28 @@ func (lt *opsworksLayerType) Update(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	// Removing the load_based_auto_scaling block is planned by loadBasedAutoScalingCustomizeDiff as a change to enable = false.
 	if d.HasChange("load_based_auto_scaling") {
-		input := expandSetLoadBasedAutoScalingInput(d.Get("load_based_auto_scaling").([]any)[0].(map[string]any))
-		input.LayerId = aws.String(d.Id())
+		if v, ok := d.Get("load_based_auto_scaling").([]any); ok && len(v) > 0 && v[0] != nil {
+			input := expandSetLoadBasedAutoScalingInput(v[0].(map[string]any))
+			input.LayerId = aws.String(d.Id())
 
-		_, err := conn.SetLoadBasedAutoScaling(ctx, input)
+			_, err := conn.SetLoadBasedAutoScaling(ctx, input)
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting OpsWorks Layer (%s) load-based auto scaling configuration: %s", d.Id(), err)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting OpsWorks Layer (%s) load-based auto scaling configuration: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.Get("wait_for_instances_online").(bool) {
+		if err := waitLayerInstancesOnline(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpsWorks Layer (%s) instances online: %s", d.Id(), err)
 		}
 	}
 
+	invalidateStackLayersDescription(ctx, meta.(*conns.AWSClient), d.Get("stack_id").(string))
+
 	return append(diags, lt.Read(ctx, d, meta)...)
 }
 
@@ -881,16 +1138,14 @@ func (lt *opsworksLayerType) Delete(ctx context.Context, d *schema.ResourceData,
 		LayerId: aws.String(d.Id()),
 	})
 
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		return nil
-	}
+	invalidateStackLayersDescription(ctx, meta.(*conns.AWSClient), d.Get("stack_id").(string))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting OpsWorks Layer (%s): %s", d.Id(), err)
 	}
 
-	if v, ok := d.GetOk("ecs_cluster_arn"); ok {
-		arn := v.(string)
+	if lt.registersECSCluster(d) {
+		arn := d.Get("ecs_cluster_arn").(string)
 		_, err := conn.DeregisterEcsCluster(ctx, &opsworks.DeregisterEcsClusterInput{
 			EcsClusterArn: aws.String(arn),
 		})
@@ -903,77 +1158,9 @@ func (lt *opsworksLayerType) Delete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func findLayerByID(ctx context.Context, conn *opsworks.Client, id string) (*awstypes.Layer, error) {
-	input := &opsworks.DescribeLayersInput{
-		LayerIds: []string{id},
-	}
-
-	output, err := conn.DescribeLayers(ctx, input)
-
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	if output == nil || len(output.Layers) == 0 {
-		return nil, tfresource.NewEmptyResultError(input)
-	}
-
-	if count := len(output.Layers); count > 1 {
-		return nil, tfresource.NewTooManyResultsError(count, input)
-	}
-
-	return tfresource.AssertSingleValueResult(output.Layers)
-}
-
-func findElasticLoadBalancerByLayerID(ctx context.Context, conn *opsworks.Client, id string) (*awstypes.ElasticLoadBalancer, error) {
-	input := &opsworks.DescribeElasticLoadBalancersInput{
-		LayerIds: []string{id},
-	}
-
-	output, err := conn.DescribeElasticLoadBalancers(ctx, input)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if output == nil || len(output.ElasticLoadBalancers) == 0 {
-		return nil, tfresource.NewEmptyResultError(input)
-	}
-
-	if count := len(output.ElasticLoadBalancers); count > 1 {
-		return nil, tfresource.NewTooManyResultsError(count, input)
-	}
-
-	return tfresource.AssertSingleValueResult(output.ElasticLoadBalancers)
-}
-
-func findLoadBasedAutoScalingConfigurationByLayerID(ctx context.Context, conn *opsworks.Client, id string) (*awstypes.LoadBasedAutoScalingConfiguration, error) {
-	input := &opsworks.DescribeLoadBasedAutoScalingInput{
-		LayerIds: []string{id},
-	}
-
-	output, err := conn.DescribeLoadBasedAutoScaling(ctx, input)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if output == nil || len(output.LoadBasedAutoScalingConfigurations) == 0 {
-		return nil, tfresource.NewEmptyResultError(input)
-	}
-
-	if count := len(output.LoadBasedAutoScalingConfigurations); count > 1 {
-		return nil, tfresource.NewTooManyResultsError(count, input)
-	}
-
-	return tfresource.AssertSingleValueResult(output.LoadBasedAutoScalingConfigurations)
+// registersECSCluster returns whether the layer registers its ECS cluster with the stack on create and deregisters it on delete.
+func (lt *opsworksLayerType) registersECSCluster(d *schema.ResourceData) bool {
+	return lt.TypeName == awstypes.LayerTypeEcsCluster && d.Get("register_ecs_cluster").(bool)
 }
 
 func (m opsworksLayerTypeAttributeMap) apiAttributesToResourceData(apiAttributes map[string]string, d *schema.ResourceData) error {
@@ -984,7 +1171,10 @@ func (m opsworksLayerTypeAttributeMap) apiAttributesToResourceData(apiAttributes
 			continue
 		}
 
-		if v, ok := apiAttributes[string(attr.AttrName)]; ok {
+		// Attributes that the API doesn't return take their default value so that
+		// imported state (and any configuration generated from it) matches a configuration
+		// that leaves them unset.
+		if v, ok := apiAttributes[string(attr.AttrName)]; ok && (v != "" || attr.Type == schema.TypeString) {
 			switch typ := attr.Type; typ {
 			case schema.TypeString:
 				d.Set(k, v)
@@ -992,7 +1182,7 @@ func (m opsworksLayerTypeAttributeMap) apiAttributesToResourceData(apiAttributes
 				if v, err := strconv.Atoi(v); err == nil {
 					d.Set(k, v)
 				} else {
-					d.Set(k, nil)
+					d.Set(k, attr.Default)
 				}
 			case schema.TypeBool:
 				d.Set(k, v != "false")
@@ -1000,19 +1190,46 @@ func (m opsworksLayerTypeAttributeMap) apiAttributesToResourceData(apiAttributes
 				return fmt.Errorf("unsupported OpsWorks Layer (%s) attribute (%s) type: %s", d.Id(), k, typ)
 			}
 		} else {
-			d.Set(k, nil)
+			d.Set(k, attr.Default)
 		}
 	}
 
 	return nil
 }
 
-func (m opsworksLayerTypeAttributeMap) resourceDataToAPIAttributes(d *schema.ResourceData) (map[string]string, error) {
+// resourceDataKeys returns the resource data keys, including any write-only version keys.
+func (m opsworksLayerTypeAttributeMap) resourceDataKeys() []string {
+	keys := tfmaps.Keys(m)
+
+	for k, attr := range m {
+		if attr.hasWriteOnlyArgument() {
+			keys = append(keys, k+writeOnlyVersionAttrSuffix)
+		}
+	}
+
+	return keys
+}
+
+func (m opsworksLayerTypeAttributeMap) resourceDataToAPIAttributes(d *schema.ResourceData) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	apiAttributes := map[string]string{}
 
 	for k, attr := range m {
 		v := d.Get(k)
 
+		// Write-only values are only available in the configuration.
+		if attr.hasWriteOnlyArgument() {
+			valueWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath(k+writeOnlyAttrSuffix))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			if valueWO != "" {
+				v = valueWO
+			}
+		}
+
 		switch typ := attr.Type; typ {
 		case schema.TypeString:
 			apiAttributes[string(attr.AttrName)] = v.(string)
@@ -1021,11 +1238,11 @@ func (m opsworksLayerTypeAttributeMap) resourceDataToAPIAttributes(d *schema.Res
 		case schema.TypeBool:
 			apiAttributes[string(attr.AttrName)] = strconv.FormatBool(v.(bool))
 		default:
-			return nil, fmt.Errorf("unsupported OpsWorks Layer (%s) attribute (%s) type: %s", d.Id(), k, typ)
+			return nil, sdkdiag.AppendErrorf(diags, "unsupported OpsWorks Layer (%s) attribute (%s) type: %s", d.Id(), k, typ)
 		}
 	}
 
-	return apiAttributes, nil
+	return apiAttributes, diags
 }
 
 func expandCloudWatchLogsConfiguration(tfMap map[string]any) *awstypes.CloudWatchLogsConfiguration {
@@ -1039,8 +1256,8 @@ func expandCloudWatchLogsConfiguration(tfMap map[string]any) *awstypes.CloudWatc
 		apiObject.Enabled = aws.Bool(v)
 	}
 
-	if v, ok := tfMap["log_streams"].([]any); ok && len(v) > 0 {
-		apiObject.LogStreams = expandCloudWatchLogsLogStreams(v)
+	if v, ok := tfMap["log_streams"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LogStreams = expandCloudWatchLogsLogStreams(v.List())
 	}
 
 	return apiObject
@@ -1192,6 +1409,97 @@ func flattenCloudWatchLogsLogStreams(apiObjects []awstypes.CloudWatchLogsLogStre
 	return tfList
 }
 
+// lifecycleEventConfigurationConfigured returns whether the lifecycle_event_configuration block is configured.
+func lifecycleEventConfigurationConfigured(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	v := rawConfig.GetAttr("lifecycle_event_configuration")
+
+	return v.IsKnown() && !v.IsNull() && v.LengthInt() > 0
+}
+
+// suppressIfLifecycleEventConfigurationConfigured suppresses differences in the deprecated drain_elb_on_shutdown and instance_shutdown_timeout
+// arguments, whose defaults would otherwise be planned against the values read from the API, when the lifecycle_event_configuration block is configured.
+func suppressIfLifecycleEventConfigurationConfigured(k, old, new string, d *schema.ResourceData) bool {
+	return lifecycleEventConfigurationConfigured(d)
+}
+
+// expandLifecycleEventConfiguration builds the layer's lifecycle event configuration from
+// the lifecycle_event_configuration block if configured, otherwise from the
+// deprecated drain_elb_on_shutdown and instance_shutdown_timeout arguments.
+func expandLifecycleEventConfiguration(d *schema.ResourceData) *awstypes.LifecycleEventConfiguration {
+	if lifecycleEventConfigurationConfigured(d) {
+		if v, ok := d.GetOk("lifecycle_event_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			tfMap := v.([]any)[0].(map[string]any)
+
+			if v, ok := tfMap["shutdown"].([]any); ok && len(v) > 0 && v[0] != nil {
+				return &awstypes.LifecycleEventConfiguration{
+					Shutdown: expandShutdownEventConfiguration(v[0].(map[string]any)),
+				}
+			}
+		}
+	}
+
+	return &awstypes.LifecycleEventConfiguration{
+		Shutdown: &awstypes.ShutdownEventConfiguration{
+			DelayUntilElbConnectionsDrained: aws.Bool(d.Get("drain_elb_on_shutdown").(bool)),
+			ExecutionTimeout:                aws.Int32(int32(d.Get("instance_shutdown_timeout").(int))),
+		},
+	}
+}
+
+func expandShutdownEventConfiguration(tfMap map[string]any) *awstypes.ShutdownEventConfiguration {
+	apiObject := &awstypes.ShutdownEventConfiguration{}
+
+	if v, ok := tfMap["delay_until_elb_connections_drained"].(bool); ok {
+		apiObject.DelayUntilElbConnectionsDrained = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["execution_timeout"].(int); ok {
+		apiObject.ExecutionTimeout = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenLifecycleEventConfiguration(apiObject *awstypes.LifecycleEventConfiguration) map[string]any {
+	var shutdown *awstypes.ShutdownEventConfiguration
+
+	if apiObject != nil {
+		shutdown = apiObject.Shutdown
+	}
+
+	tfMap := map[string]any{
+		"shutdown": []any{flattenShutdownEventConfiguration(shutdown)},
+	}
+
+	return tfMap
+}
+
+func flattenShutdownEventConfiguration(apiObject *awstypes.ShutdownEventConfiguration) map[string]any {
+	tfMap := map[string]any{
+		"delay_until_elb_connections_drained": defaultLayerDrainELBOnShutdown,
+		"execution_timeout":                   defaultLayerInstanceShutdownTimeout,
+	}
+
+	if apiObject == nil {
+		return tfMap
+	}
+
+	if v := apiObject.DelayUntilElbConnectionsDrained; v != nil {
+		tfMap["delay_until_elb_connections_drained"] = aws.ToBool(v)
+	}
+
+	if v := apiObject.ExecutionTimeout; v != nil {
+		tfMap["execution_timeout"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
 func expandVolumeConfiguration(tfMap map[string]any) awstypes.VolumeConfiguration {
 	apiObject := awstypes.VolumeConfiguration{}
 
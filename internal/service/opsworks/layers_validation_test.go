@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opsworks
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestLayerTypeAttributeValidation(t *testing.T) {
+	t.Parallel()
+
+	layerResources := map[string]func() *schema.Resource{
+		"haproxy":  resourceHAProxyLayer,
+		"java_app": resourceJavaAppLayer,
+		"rails":    resourceRailsAppLayer,
+	}
+
+	for name, f := range layerResources {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := f()
+
+			// Every default value must be accepted by the attribute's own validation.
+			for key, s := range r.Schema {
+				if s.ValidateDiagFunc == nil || s.Default == nil {
+					continue
+				}
+
+				if diags := s.ValidateDiagFunc(s.Default, cty.GetAttrPath(key)); diags.HasError() {
+					t.Errorf("%s: default %v is not valid: %v", key, s.Default, diags)
+				}
+			}
+		})
+	}
+}
+
+func TestLayerTypeAttributeValidation_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		resource func() *schema.Resource
+		key      string
+		value    string
+	}{
+		{
+			testName: "haproxy healthcheck_method",
+			resource: resourceHAProxyLayer,
+			key:      "healthcheck_method",
+			value:    "options",
+		},
+		{
+			testName: "java app_server_version",
+			resource: resourceJavaAppLayer,
+			key:      "app_server_version",
+			value:    "9",
+		},
+		{
+			testName: "rails app_server",
+			resource: resourceRailsAppLayer,
+			key:      "app_server",
+			value:    "puma",
+		},
+		{
+			testName: "rails ruby_version",
+			resource: resourceRailsAppLayer,
+			key:      "ruby_version",
+			value:    "3.0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			s := testCase.resource().Schema[testCase.key]
+
+			if diags := s.ValidateDiagFunc(testCase.value, cty.GetAttrPath(testCase.key)); !diags.HasError() {
+				t.Errorf("%s: expected %q to be invalid", testCase.key, testCase.value)
+			}
+		})
+	}
+}
@@ -5,12 +5,14 @@ package opsworks_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -38,6 +40,48 @@ func TestAccOpsWorksMySQLLayer_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "root_password_on_all_instances", acctest.CtTrue),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOpsWorksMySQLLayer_rootPasswordWriteOnly(t *testing.T) {
+	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
+
+	ctx := acctest.Context(t)
+	var v awstypes.Layer
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opsworks_mysql_layer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.OpsWorks) },
+		ErrorCheck: acctest.ErrorCheck(t, names.OpsWorksServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMySQLLayerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMySQLLayerConfig_rootPasswordWriteOnly(rName, "Password1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "root_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "root_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccMySQLLayerConfig_rootPasswordWriteOnly(rName, "Password2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "root_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "root_password_wo_version", "2"),
+				),
+			},
 		},
 	})
 }
@@ -57,3 +101,16 @@ resource "aws_opsworks_mysql_layer" "test" {
 }
 `)
 }
+
+func testAccMySQLLayerConfig_rootPasswordWriteOnly(rName, rootPassword string, rootPasswordVersion int) string {
+	return acctest.ConfigCompose(testAccLayerConfig_base(rName), fmt.Sprintf(`
+resource "aws_opsworks_mysql_layer" "test" {
+  stack_id = aws_opsworks_stack.test.id
+
+  custom_security_group_ids = aws_security_group.test[*].id
+
+  root_password_wo         = %[1]q
+  root_password_wo_version = %[2]d
+}
+`, rootPassword, rootPasswordVersion))
+}
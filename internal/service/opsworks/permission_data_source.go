@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opsworks
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_opsworks_permission", name="Permission")
+func dataSourcePermission() *schema.Resource {
+	return &schema.Resource{
+		DeprecationMessage: "This data source is deprecated and will be removed in the next major version of the AWS Provider. Consider the AWS Systems Manager service instead.",
+		ReadWithoutTimeout: dataSourcePermissionRead,
+
+		Schema: map[string]*schema.Schema{
+			"allow_ssh": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"allow_sudo": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"level": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stack_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourcePermissionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpsWorksClient(ctx)
+
+	iamUserARN := d.Get("user_arn").(string)
+	stackID := d.Get("stack_id").(string)
+	id := iamUserARN + stackID
+	permission, err := findPermissionByTwoPartKey(ctx, conn, iamUserARN, stackID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpsWorks Permission (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("allow_ssh", permission.AllowSsh)
+	d.Set("allow_sudo", permission.AllowSudo)
+	d.Set("level", permission.Level)
+	d.Set("stack_id", permission.StackId)
+	d.Set("user_arn", permission.IamUserArn)
+
+	return diags
+}
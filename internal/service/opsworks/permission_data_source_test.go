@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opsworks_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpsWorksPermissionDataSource_basic(t *testing.T) {
+	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_opsworks_permission.test"
+	resourceName := "aws_opsworks_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.OpsWorks) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpsWorksServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "allow_ssh", resourceName, "allow_ssh"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "allow_sudo", resourceName, "allow_sudo"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "level", resourceName, "level"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stack_id", resourceName, "stack_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "user_arn", resourceName, "user_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_create(rName, true, false, "deploy"), `
+data "aws_opsworks_permission" "test" {
+  stack_id = aws_opsworks_permission.test.stack_id
+  user_arn = aws_opsworks_permission.test.user_arn
+}
+`)
+}
@@ -6,6 +6,7 @@ package opsworks
 import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 )
 
 // @SDKResource("aws_opsworks_rails_app_layer", name="Rails App Layer")
@@ -17,9 +18,10 @@ func resourceRailsAppLayer() *schema.Resource {
 
 		Attributes: map[string]*opsworksLayerTypeAttribute{
 			"app_server": {
-				AttrName: awstypes.LayerAttributesKeysRailsStack,
-				Type:     schema.TypeString,
-				Default:  "apache_passenger",
+				AttrName:         awstypes.LayerAttributesKeysRailsStack,
+				Type:             schema.TypeString,
+				Default:          "apache_passenger",
+				ValidateDiagFunc: enum.Validate[railsAppServer](),
 			},
 			"bundler_version": {
 				AttrName: awstypes.LayerAttributesKeysBundlerVersion,
@@ -37,9 +39,10 @@ func resourceRailsAppLayer() *schema.Resource {
 				Default:  "4.0.46",
 			},
 			"ruby_version": {
-				AttrName: awstypes.LayerAttributesKeysRubyVersion,
-				Type:     schema.TypeString,
-				Default:  "2.0.0",
+				AttrName:         awstypes.LayerAttributesKeysRubyVersion,
+				Type:             schema.TypeString,
+				Default:          "2.0.0",
+				ValidateDiagFunc: enum.Validate[rubyVersion](),
 			},
 			"rubygems_version": {
 				AttrName: awstypes.LayerAttributesKeysRubygemsVersion,
@@ -10,12 +10,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/opsworks"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -30,9 +32,23 @@ func resourceRDSDBInstance() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"db_password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"db_password", "db_password_wo"},
+			},
+			"db_password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"db_password"},
+				RequiredWith:  []string{"db_password_wo_version"},
+			},
+			"db_password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"db_password_wo"},
 			},
 			"db_user": {
 				Type:     schema.TypeString,
@@ -56,6 +72,12 @@ func resourceRDSDBInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 	var diags diag.Diagnostics
 	client := meta.(*conns.AWSClient).OpsWorksClient(ctx)
 
+	dbPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("db_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
 	dbInstanceARN := d.Get("rds_db_instance_arn").(string)
 	stackID := d.Get("stack_id").(string)
 	id := dbInstanceARN + stackID
@@ -66,6 +88,10 @@ func resourceRDSDBInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 		StackId:          aws.String(stackID),
 	}
 
+	if dbPasswordWO != "" {
+		input.DbPassword = aws.String(dbPasswordWO)
+	}
+
 	_, err := client.RegisterRdsDbInstance(ctx, input)
 
 	if err != nil {
@@ -112,6 +138,18 @@ func resourceRDSDBInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		input.DbPassword = aws.String(d.Get("db_password").(string))
 	}
 
+	if d.HasChange("db_password_wo_version") {
+		dbPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("db_password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if dbPasswordWO != "" {
+			input.DbPassword = aws.String(dbPasswordWO)
+		}
+	}
+
 	if d.HasChange("db_user") {
 		input.DbUser = aws.String(d.Get("db_user").(string))
 	}
@@ -134,10 +172,6 @@ func resourceRDSDBInstanceDelete(ctx context.Context, d *schema.ResourceData, me
 		RdsDbInstanceArn: aws.String(d.Get("rds_db_instance_arn").(string)),
 	})
 
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		return diags
-	}
-
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deregistering OpsWorks RDS DB Instance (%s): %s", d.Id(), err)
 	}
@@ -11,7 +11,9 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfopsworks "github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
@@ -65,6 +67,53 @@ func TestAccOpsWorksRDSDBInstance_basic(t *testing.T) {
 	})
 }
 
+func TestAccOpsWorksRDSDBInstance_dbPasswordWriteOnly(t *testing.T) {
+	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
+
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.RdsDbInstance
+	resourceName := "aws_opsworks_rds_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.OpsWorks) },
+		ErrorCheck: acctest.ErrorCheck(t, names.OpsWorksServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRDSDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRDSDBInstanceConfig_dbPasswordWriteOnly(rName, "user1", "password1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRDSDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "db_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "db_password_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "db_user", "user1"),
+				),
+			},
+			{
+				Config: testAccRDSDBInstanceConfig_dbPasswordWriteOnly(rName, "user1", "password2", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRDSDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "db_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "db_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccOpsWorksRDSDBInstance_disappears(t *testing.T) {
 	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
 
@@ -146,7 +195,7 @@ func testAccCheckRDSDBInstanceDestroy(ctx context.Context) resource.TestCheckFun
 	}
 }
 
-func testAccRDSDBInstanceConfig_basic(rName, userName, password string) string {
+func testAccRDSDBInstanceConfig_base(rName string) string {
 	return acctest.ConfigCompose(testAccStackConfig_basic(rName), fmt.Sprintf(`
 data "aws_rds_engine_version" "default" {
   engine = "mysql"
@@ -175,13 +224,30 @@ resource "aws_db_instance" "test" {
   password                = "avoid-plaintext-passwords"
   username                = "tfacctest"
 }
+`, rName))
+}
 
+func testAccRDSDBInstanceConfig_basic(rName, userName, password string) string {
+	return acctest.ConfigCompose(testAccRDSDBInstanceConfig_base(rName), fmt.Sprintf(`
 resource "aws_opsworks_rds_db_instance" "test" {
   stack_id = aws_opsworks_stack.test.id
 
   rds_db_instance_arn = aws_db_instance.test.arn
-  db_user             = %[2]q
-  db_password         = %[3]q
+  db_user             = %[1]q
+  db_password         = %[2]q
+}
+`, userName, password))
+}
+
+func testAccRDSDBInstanceConfig_dbPasswordWriteOnly(rName, userName, password string, passwordVersion int) string {
+	return acctest.ConfigCompose(testAccRDSDBInstanceConfig_base(rName), fmt.Sprintf(`
+resource "aws_opsworks_rds_db_instance" "test" {
+  stack_id = aws_opsworks_stack.test.id
+
+  rds_db_instance_arn    = aws_db_instance.test.arn
+  db_user                = %[1]q
+  db_password_wo         = %[2]q
+  db_password_wo_version = %[3]d
 }
-`, rName, userName, password))
+`, userName, password, passwordVersion))
 }
@@ -19,11 +19,33 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newDeploymentResource,
+			TypeName: "aws_opsworks_deployment",
+			Name:     "Deployment",
+		},
+		{
+			Factory:  newECSClusterAttachmentResource,
+			TypeName: "aws_opsworks_ecs_cluster_attachment",
+			Name:     "ECS Cluster Attachment",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourcePermission,
+			TypeName: "aws_opsworks_permission",
+			Name:     "Permission",
+		},
+		{
+			Factory:  dataSourceStackSummary,
+			TypeName: "aws_opsworks_stack_summary",
+			Name:     "Stack Summary",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
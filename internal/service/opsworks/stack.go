@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -65,6 +66,19 @@ func resourceStack() *schema.Resource {
 				Optional: true,
 				Default:  defaultBerkshelfVersion,
 			},
+			"clone_app_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_stack_id"},
+			},
+			"clone_permissions": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"source_stack_id"},
+			},
 			"color": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -175,6 +189,11 @@ func resourceStack() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"source_stack_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"stack_endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -268,8 +287,25 @@ func resourceStackCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		input.VpcId = aws.String(v.(string))
 	}
 
+	// Cloning copies the source stack's layers (and optionally apps and permissions) into the new stack.
+	// Any stack settings configured here override the source stack's settings.
+	var cloneInput *opsworks.CloneStackInput
+	if v, ok := d.GetOk("source_stack_id"); ok {
+		cloneInput = expandCloneStackInput(input)
+		cloneInput.SourceStackId = aws.String(v.(string))
+		cloneInput.ClonePermissions = aws.Bool(d.Get("clone_permissions").(bool))
+
+		if v, ok := d.GetOk("clone_app_ids"); ok && v.(*schema.Set).Len() > 0 {
+			cloneInput.CloneAppIds = flex.ExpandStringValueSet(v.(*schema.Set))
+		}
+	}
+
 	outputRaw, err := tfresource.RetryWhen(ctx, d.Timeout(schema.TimeoutCreate),
 		func() (any, error) {
+			if cloneInput != nil {
+				return conn.CloneStack(ctx, cloneInput)
+			}
+
 			return conn.CreateStack(ctx, input)
 		},
 		func(err error) (bool, error) {
@@ -292,7 +328,12 @@ func resourceStackCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "creating OpsWorks Stack (%s): %s", name, err)
 	}
 
-	d.SetId(aws.ToString(outputRaw.(*opsworks.CreateStackOutput).StackId))
+	switch output := outputRaw.(type) {
+	case *opsworks.CloneStackOutput:
+		d.SetId(aws.ToString(output.StackId))
+	case *opsworks.CreateStackOutput:
+		d.SetId(aws.ToString(output.StackId))
+	}
 
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition(ctx),
@@ -555,10 +596,6 @@ func resourceStackDelete(ctx context.Context, d *schema.ResourceData, meta any)
 		o.Region = region
 	})
 
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		return diags
-	}
-
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting OpsWork Stack (%s): %s", d.Id(), err)
 	}
@@ -611,6 +648,31 @@ func findStackByID(ctx context.Context, conn *opsworks.Client, id string, optFns
 	return tfresource.AssertSingleValueResult(output.Stacks)
 }
 
+// expandCloneStackInput maps the settings of a new stack onto a CloneStack request.
+func expandCloneStackInput(input *opsworks.CreateStackInput) *opsworks.CloneStackInput {
+	return &opsworks.CloneStackInput{
+		AgentVersion:              input.AgentVersion,
+		Attributes:                input.Attributes,
+		ChefConfiguration:         input.ChefConfiguration,
+		ConfigurationManager:      input.ConfigurationManager,
+		CustomCookbooksSource:     input.CustomCookbooksSource,
+		CustomJson:                input.CustomJson,
+		DefaultAvailabilityZone:   input.DefaultAvailabilityZone,
+		DefaultInstanceProfileArn: input.DefaultInstanceProfileArn,
+		DefaultOs:                 input.DefaultOs,
+		DefaultRootDeviceType:     input.DefaultRootDeviceType,
+		DefaultSshKeyName:         input.DefaultSshKeyName,
+		DefaultSubnetId:           input.DefaultSubnetId,
+		HostnameTheme:             input.HostnameTheme,
+		Name:                      input.Name,
+		Region:                    input.Region,
+		ServiceRoleArn:            input.ServiceRoleArn,
+		UseCustomCookbooks:        input.UseCustomCookbooks,
+		UseOpsworksSecurityGroups: input.UseOpsworksSecurityGroups,
+		VpcId:                     input.VpcId,
+	}
+}
+
 func expandSource(tfMap map[string]any) *awstypes.Source {
 	if tfMap == nil {
 		return nil
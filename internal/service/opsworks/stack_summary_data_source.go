@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opsworks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opsworks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opsworks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_opsworks_stack_summary", name="Stack Summary")
+func dataSourceStackSummary() *schema.Resource {
+	return &schema.Resource{
+		DeprecationMessage: "This data source is deprecated and will be removed in the next major version of the AWS Provider. Consider the AWS Systems Manager service instead.",
+		ReadWithoutTimeout: dataSourceStackSummaryRead,
+
+		Schema: map[string]*schema.Schema{
+			"apps_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instances_count": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assigning": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"booting": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"connection_lost": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"deregistering": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"online": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"pending": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rebooting": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"registered": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"registering": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"requested": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"running_setup": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"setup_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"shutting_down": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"start_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"stop_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"stopped": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"stopping": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"terminated": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"terminating": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"unassigning": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"layers_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stack_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceStackSummaryRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpsWorksClient(ctx)
+
+	stackID := d.Get("stack_id").(string)
+	summary, err := findStackSummaryByID(ctx, conn, stackID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpsWorks Stack (%s) summary: %s", stackID, err)
+	}
+
+	d.SetId(aws.ToString(summary.StackId))
+	d.Set("apps_count", summary.AppsCount)
+	d.Set(names.AttrARN, summary.Arn)
+	if err := d.Set("instances_count", flattenInstancesCount(summary.InstancesCount)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instances_count: %s", err)
+	}
+	d.Set("layers_count", summary.LayersCount)
+	d.Set(names.AttrName, summary.Name)
+	d.Set("stack_id", summary.StackId)
+
+	return diags
+}
+
+func findStackSummaryByID(ctx context.Context, conn *opsworks.Client, id string) (*awstypes.StackSummary, error) {
+	input := &opsworks.DescribeStackSummaryInput{
+		StackId: aws.String(id),
+	}
+
+	output, err := conn.DescribeStackSummary(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StackSummary == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StackSummary, nil
+}
+
+func flattenInstancesCount(apiObject *awstypes.InstancesCount) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"assigning":       aws.ToInt32(apiObject.Assigning),
+		"booting":         aws.ToInt32(apiObject.Booting),
+		"connection_lost": aws.ToInt32(apiObject.ConnectionLost),
+		"deregistering":   aws.ToInt32(apiObject.Deregistering),
+		"online":          aws.ToInt32(apiObject.Online),
+		"pending":         aws.ToInt32(apiObject.Pending),
+		"rebooting":       aws.ToInt32(apiObject.Rebooting),
+		"registered":      aws.ToInt32(apiObject.Registered),
+		"registering":     aws.ToInt32(apiObject.Registering),
+		"requested":       aws.ToInt32(apiObject.Requested),
+		"running_setup":   aws.ToInt32(apiObject.RunningSetup),
+		"setup_failed":    aws.ToInt32(apiObject.SetupFailed),
+		"shutting_down":   aws.ToInt32(apiObject.ShuttingDown),
+		"start_failed":    aws.ToInt32(apiObject.StartFailed),
+		"stop_failed":     aws.ToInt32(apiObject.StopFailed),
+		"stopped":         aws.ToInt32(apiObject.Stopped),
+		"stopping":        aws.ToInt32(apiObject.Stopping),
+		"terminated":      aws.ToInt32(apiObject.Terminated),
+		"terminating":     aws.ToInt32(apiObject.Terminating),
+		"unassigning":     aws.ToInt32(apiObject.Unassigning),
+	}
+
+	return []any{tfMap}
+}
@@ -500,6 +500,50 @@ func TestAccOpsWorksStack_windows(t *testing.T) {
 	})
 }
 
+func TestAccOpsWorksStack_clone(t *testing.T) {
+	acctest.Skip(t, "skipping test; Amazon OpsWorks has been deprecated and will be removed in the next major release")
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opsworks_stack.clone"
+	sourceResourceName := "aws_opsworks_stack.test"
+	var source, clone awstypes.Stack
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpsWorks)
+			testAccPreCheckStacks(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpsWorksServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_clone(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, sourceResourceName, &source),
+					testAccCheckStackExists(ctx, resourceName, &clone),
+					resource.TestCheckResourceAttr(resourceName, "clone_permissions", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "default_subnet_id", "aws_subnet.test.1", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName+"-clone"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_stack_id", sourceResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrVPCID, sourceResourceName, names.AttrVPCID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"clone_permissions",
+					"source_stack_id",
+				},
+			},
+		},
+	})
+}
+
 func testAccPreCheckStacks(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksClient(ctx)
 
@@ -998,3 +1042,20 @@ const (
 	testAccCustomJSON1 = `{"key1":"value1"}`
 	testAccCustomJSON2 = `{"key2":"value2"}`
 )
+
+func testAccStackConfig_clone(rName string) string {
+	return acctest.ConfigCompose(testAccStackConfig_basic(rName), fmt.Sprintf(`
+resource "aws_opsworks_stack" "clone" {
+  name                         = "%[1]s-clone"
+  region                       = %[2]q
+  service_role_arn             = aws_iam_role.opsworks_service.arn
+  default_instance_profile_arn = aws_iam_instance_profile.opsworks_instance.arn
+  default_subnet_id            = aws_subnet.test[1].id
+  vpc_id                       = aws_vpc.test.id
+  use_opsworks_security_groups = false
+
+  source_stack_id   = aws_opsworks_stack.test.id
+  clone_permissions = true
+}
+`, rName, acctest.Region()))
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opsworks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opsworks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusInstance(ctx context.Context, conn *opsworks.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findInstanceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func statusDeployment(ctx context.Context, conn *opsworks.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDeploymentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+// statusLayerInstances returns "online" once every instance in the layer that is
+// expected to run has come online. Stopped and terminated instances are ignored.
+func statusLayerInstances(ctx context.Context, conn *opsworks.Client, layerID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findInstancesByLayerID(ctx, conn, layerID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := instanceStatusOnline
+		for _, v := range output {
+			switch s := aws.ToString(v.Status); s {
+			case instanceStatusOnline, instanceStatusStopped, instanceStatusStopping, instanceStatusShuttingDown, instanceStatusTerminating, instanceStatusTerminated:
+			case instanceStatusSetupFailed, instanceStatusStartFailed:
+				return output, s, fmt.Errorf("OpsWorks Instance (%s) status: %s", aws.ToString(v.InstanceId), s)
+			default:
+				status = instanceStatusPending
+			}
+		}
+
+		return output, status, nil
+	}
+}
@@ -130,10 +130,6 @@ func resourceUserProfileDelete(ctx context.Context, d *schema.ResourceData, meta
 		IamUserArn: aws.String(d.Id()),
 	})
 
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-		return diags
-	}
-
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting OpsWorks User Profile (%s): %s", d.Id(), err)
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/paymentcryptography"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/paymentcryptography/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -62,6 +63,13 @@ func (r *resourceKey) Schema(ctx context.Context, request resource.SchemaRequest
 		Attributes: map[string]schema.Attribute{
 			names.AttrARN: framework.ARNAttributeComputedOnly(),
 			names.AttrID:  framework.IDAttribute(),
+			"create_timestamp": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"deletion_window_in_days": schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
@@ -114,6 +122,20 @@ func (r *resourceKey) Schema(ctx context.Context, request resource.SchemaRequest
 			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"usage_start_timestamp": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usage_stop_timestamp": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"key_attributes": schema.SingleNestedBlock{ // nosemgrep:ci.avoid-SingleNestedBlock pre-existing, will be converted
@@ -371,6 +393,28 @@ func (r *resourceKey) Update(ctx context.Context, request resource.UpdateRequest
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
+func (r *resourceKey) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state resourceKeyModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Starting or stopping key usage sets the key usage timestamps.
+	if !plan.Enabled.Equal(state.Enabled) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("usage_start_timestamp"), timetypes.NewRFC3339Unknown())...)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("usage_stop_timestamp"), timetypes.NewRFC3339Unknown())...)
+	}
+}
+
 func (r *resourceKey) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	conn := r.Meta().PaymentCryptographyClient(ctx)
 
@@ -498,6 +542,7 @@ func findKeyByID(ctx context.Context, conn *paymentcryptography.Client, id strin
 
 type resourceKeyModel struct {
 	KeyArn                 types.String                                        `tfsdk:"arn"`
+	CreateTimestamp        timetypes.RFC3339                                   `tfsdk:"create_timestamp"`
 	DeletionWindowInDays   types.Int64                                         `tfsdk:"deletion_window_in_days"`
 	Enabled                types.Bool                                          `tfsdk:"enabled"`
 	Exportable             types.Bool                                          `tfsdk:"exportable"`
@@ -510,6 +555,8 @@ type resourceKeyModel struct {
 	Tags                   tftags.Map                                          `tfsdk:"tags"`
 	TagsAll                tftags.Map                                          `tfsdk:"tags_all"`
 	Timeouts               timeouts.Value                                      `tfsdk:"timeouts"`
+	UsageStartTimestamp    timetypes.RFC3339                                   `tfsdk:"usage_start_timestamp"`
+	UsageStopTimestamp     timetypes.RFC3339                                   `tfsdk:"usage_stop_timestamp"`
 }
 
 func (k *resourceKeyModel) setId() {
@@ -49,6 +49,8 @@ func TestAccPaymentCryptographyKey_basic(t *testing.T) {
 					testAccCheckKeyExists(ctx, resourceName, &key),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "payment-cryptography", regexache.MustCompile(`key/.+`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "create_timestamp"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "usage_start_timestamp"),
 				),
 			},
 			{
@@ -153,6 +155,7 @@ func TestAccPaymentCryptographyKey_update(t *testing.T) {
 					testAccCheckKeyNotRecreated(&key1, &key2),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
 					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "payment-cryptography", regexache.MustCompile(`key/.+`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "usage_stop_timestamp"),
 				),
 			},
 			{
@@ -5,11 +5,17 @@ package pinpointsmsvoicev2
 
 // Exports for use in tests only.
 var (
-	ResourceConfigurationSet = newConfigurationSetResource
-	ResourceOptOutList       = newOptOutListResource
-	ResourcePhoneNumber      = newPhoneNumberResource
+	ResourceConfigurationSet       = newConfigurationSetResource
+	ResourceOptOutList             = newOptOutListResource
+	ResourcePhoneNumber            = newPhoneNumberResource
+	ResourceRegistration           = newRegistrationResource
+	ResourceRegistrationAttachment = newRegistrationAttachmentResource
+	ResourceSenderID               = newSenderIDResource
 
-	FindConfigurationSetByID = findConfigurationSetByID
-	FindOptOutListByID       = findOptOutListByID
-	FindPhoneNumberByID      = findPhoneNumberByID
+	FindConfigurationSetByID       = findConfigurationSetByID
+	FindOptOutListByID             = findOptOutListByID
+	FindPhoneNumberByID            = findPhoneNumberByID
+	FindRegistrationAttachmentByID = findRegistrationAttachmentByID
+	FindRegistrationByID           = findRegistrationByID
+	FindSenderIDByTwoPartKey       = findSenderIDByTwoPartKey
 )
@@ -0,0 +1,516 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pinpointsmsvoicev2_registration", name="Registration")
+// @Tags(identifierAttribute="arn")
+func newRegistrationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &registrationResource{}
+
+	return r, nil
+}
+
+type registrationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *registrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"approved_version_number": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"current_version_number": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"registration_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RegistrationStatus](),
+				Computed:   true,
+			},
+			"registration_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"submit_registration_version": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"field_value": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[registrationFieldValueModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field_path": schema.StringAttribute{
+							Required: true,
+						},
+						"registration_attachment_id": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("select_choices"),
+									path.MatchRelative().AtParent().AtName("text_value"),
+								),
+							},
+						},
+						"select_choices": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"text_value": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *registrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data registrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	input := &pinpointsmsvoicev2.CreateRegistrationInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		RegistrationType: fwflex.StringFromFramework(ctx, data.RegistrationType),
+		Tags:             getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateRegistration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating End User Messaging SMS Registration (%s)", data.RegistrationType.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.RegistrationID = fwflex.StringToFramework(ctx, output.RegistrationId)
+	response.State.SetAttribute(ctx, path.Root(names.AttrID), data.RegistrationID) // Set 'id' so as to taint the resource.
+
+	id := data.RegistrationID.ValueString()
+
+	fieldValues, diags := data.FieldValues.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putRegistrationFieldValues(ctx, conn, id, fieldValues); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating End User Messaging SMS Registration (%s)", id), err.Error())
+
+		return
+	}
+
+	if data.SubmitRegistrationVersion.ValueBool() {
+		if err := submitRegistrationVersion(ctx, conn, id); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating End User Messaging SMS Registration (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	out, err := findRegistrationByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading End User Messaging SMS Registration (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *registrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data registrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	id := data.RegistrationID.ValueString()
+	out, err := findRegistrationByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading End User Messaging SMS Registration (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	fieldValues, err := findRegistrationFieldValuesByTwoPartKey(ctx, conn, id, aws.ToInt64(out.CurrentVersionNumber))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading End User Messaging SMS Registration (%s) field values", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, fieldValues, &data.FieldValues)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *registrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new registrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	id := new.RegistrationID.ValueString()
+
+	if !new.FieldValues.Equal(old.FieldValues) {
+		// A submitted registration version is read-only. Changes go into a new draft version.
+		version, err := findRegistrationVersionByTwoPartKey(ctx, conn, id, old.CurrentVersionNumber.ValueInt64())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading End User Messaging SMS Registration (%s) version", id), err.Error())
+
+			return
+		}
+
+		if version.RegistrationVersionStatus != awstypes.RegistrationVersionStatusDraft {
+			_, err := conn.CreateRegistrationVersion(ctx, &pinpointsmsvoicev2.CreateRegistrationVersionInput{
+				RegistrationId: aws.String(id),
+			})
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("creating End User Messaging SMS Registration (%s) version", id), err.Error())
+
+				return
+			}
+		}
+
+		oldFieldValues, diags := old.FieldValues.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		newFieldValues, diags := new.FieldValues.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		fieldPaths := make(map[string]struct{})
+		for _, v := range newFieldValues {
+			fieldPaths[v.FieldPath.ValueString()] = struct{}{}
+		}
+
+		for _, v := range oldFieldValues {
+			fieldPath := v.FieldPath.ValueString()
+			if _, ok := fieldPaths[fieldPath]; ok {
+				continue
+			}
+
+			_, err := conn.DeleteRegistrationFieldValue(ctx, &pinpointsmsvoicev2.DeleteRegistrationFieldValueInput{
+				FieldPath:      aws.String(fieldPath),
+				RegistrationId: aws.String(id),
+			})
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				continue
+			}
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("deleting End User Messaging SMS Registration (%s) field value (%s)", id, fieldPath), err.Error())
+
+				return
+			}
+		}
+
+		if err := putRegistrationFieldValues(ctx, conn, id, newFieldValues); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating End User Messaging SMS Registration (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	if new.SubmitRegistrationVersion.ValueBool() && (!new.FieldValues.Equal(old.FieldValues) || !old.SubmitRegistrationVersion.ValueBool()) {
+		if err := submitRegistrationVersion(ctx, conn, id); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating End User Messaging SMS Registration (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	out, err := findRegistrationByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading End User Messaging SMS Registration (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *registrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data registrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	_, err := conn.DeleteRegistration(ctx, &pinpointsmsvoicev2.DeleteRegistrationInput{
+		RegistrationId: data.RegistrationID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting End User Messaging SMS Registration (%s)", data.RegistrationID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type registrationResourceModel struct {
+	ApprovedVersionNumber     types.Int64                                                 `tfsdk:"approved_version_number"`
+	CurrentVersionNumber      types.Int64                                                 `tfsdk:"current_version_number"`
+	FieldValues               fwtypes.SetNestedObjectValueOf[registrationFieldValueModel] `tfsdk:"field_value"`
+	RegistrationARN           types.String                                                `tfsdk:"arn"`
+	RegistrationID            types.String                                                `tfsdk:"id"`
+	RegistrationStatus        fwtypes.StringEnum[awstypes.RegistrationStatus]             `tfsdk:"registration_status"`
+	RegistrationType          types.String                                                `tfsdk:"registration_type"`
+	SubmitRegistrationVersion types.Bool                                                  `tfsdk:"submit_registration_version"`
+	Tags                      tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                  `tfsdk:"tags_all"`
+}
+
+type registrationFieldValueModel struct {
+	FieldPath                types.String         `tfsdk:"field_path"`
+	RegistrationAttachmentID types.String         `tfsdk:"registration_attachment_id"`
+	SelectChoices            fwtypes.ListOfString `tfsdk:"select_choices"`
+	TextValue                types.String         `tfsdk:"text_value"`
+}
+
+func putRegistrationFieldValues(ctx context.Context, conn *pinpointsmsvoicev2.Client, id string, fieldValues []*registrationFieldValueModel) error {
+	for _, v := range fieldValues {
+		input := &pinpointsmsvoicev2.PutRegistrationFieldValueInput{}
+		if diags := fwflex.Expand(ctx, v, input); diags.HasError() {
+			return fwdiag.DiagnosticsError(diags)
+		}
+
+		input.RegistrationId = aws.String(id)
+
+		_, err := conn.PutRegistrationFieldValue(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("putting field value (%s): %w", v.FieldPath.ValueString(), err)
+		}
+	}
+
+	return nil
+}
+
+func submitRegistrationVersion(ctx context.Context, conn *pinpointsmsvoicev2.Client, id string) error {
+	_, err := conn.SubmitRegistrationVersion(ctx, &pinpointsmsvoicev2.SubmitRegistrationVersionInput{
+		RegistrationId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("submitting version: %w", err)
+	}
+
+	return nil
+}
+
+func findRegistrationByID(ctx context.Context, conn *pinpointsmsvoicev2.Client, id string) (*awstypes.RegistrationInformation, error) {
+	input := &pinpointsmsvoicev2.DescribeRegistrationsInput{
+		RegistrationIds: []string{id},
+	}
+
+	output, err := findRegistration(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.RegistrationStatus; status == awstypes.RegistrationStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findRegistration(ctx context.Context, conn *pinpointsmsvoicev2.Client, input *pinpointsmsvoicev2.DescribeRegistrationsInput) (*awstypes.RegistrationInformation, error) {
+	output, err := findRegistrations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRegistrations(ctx context.Context, conn *pinpointsmsvoicev2.Client, input *pinpointsmsvoicev2.DescribeRegistrationsInput) ([]awstypes.RegistrationInformation, error) {
+	var output []awstypes.RegistrationInformation
+
+	pages := pinpointsmsvoicev2.NewDescribeRegistrationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Registrations...)
+	}
+
+	return output, nil
+}
+
+func findRegistrationVersionByTwoPartKey(ctx context.Context, conn *pinpointsmsvoicev2.Client, id string, versionNumber int64) (*awstypes.RegistrationVersionInformation, error) {
+	input := &pinpointsmsvoicev2.DescribeRegistrationVersionsInput{
+		RegistrationId: aws.String(id),
+		VersionNumbers: []int64{versionNumber},
+	}
+
+	var output []awstypes.RegistrationVersionInformation
+
+	pages := pinpointsmsvoicev2.NewDescribeRegistrationVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RegistrationVersions...)
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRegistrationFieldValuesByTwoPartKey(ctx context.Context, conn *pinpointsmsvoicev2.Client, id string, versionNumber int64) ([]awstypes.RegistrationFieldValueInformation, error) {
+	input := &pinpointsmsvoicev2.DescribeRegistrationFieldValuesInput{
+		RegistrationId: aws.String(id),
+		VersionNumber:  aws.Int64(versionNumber),
+	}
+
+	var output []awstypes.RegistrationFieldValueInformation
+
+	pages := pinpointsmsvoicev2.NewDescribeRegistrationFieldValuesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RegistrationFieldValues...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pinpointsmsvoicev2_registration_attachment", name="Registration Attachment")
+// @Tags(identifierAttribute="arn")
+func newRegistrationAttachmentResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &registrationAttachmentResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type registrationAttachmentResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[registrationAttachmentResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *registrationAttachmentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"attachment_body": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("attachment_url"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"attachment_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AttachmentStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"attachment_url": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *registrationAttachmentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data registrationAttachmentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	input := &pinpointsmsvoicev2.CreateRegistrationAttachmentInput{
+		AttachmentUrl: fwflex.StringFromFramework(ctx, data.AttachmentURL),
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if !data.AttachmentBody.IsNull() {
+		v, err := itypes.Base64Decode(data.AttachmentBody.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("attachment_body"), "decoding base64", err.Error())
+
+			return
+		}
+
+		input.AttachmentBody = v
+	}
+
+	output, err := conn.CreateRegistrationAttachment(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating End User Messaging SMS Registration Attachment", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.RegistrationAttachmentID = fwflex.StringToFramework(ctx, output.RegistrationAttachmentId)
+	response.State.SetAttribute(ctx, path.Root(names.AttrID), data.RegistrationAttachmentID) // Set 'id' so as to taint the resource.
+
+	out, err := waitRegistrationAttachmentUploaded(ctx, conn, data.RegistrationAttachmentID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for End User Messaging SMS Registration Attachment (%s) create", data.RegistrationAttachmentID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *registrationAttachmentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data registrationAttachmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	out, err := findRegistrationAttachmentByID(ctx, conn, data.RegistrationAttachmentID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading End User Messaging SMS Registration Attachment (%s)", data.RegistrationAttachmentID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *registrationAttachmentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data registrationAttachmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	_, err := conn.DeleteRegistrationAttachment(ctx, &pinpointsmsvoicev2.DeleteRegistrationAttachmentInput{
+		RegistrationAttachmentId: data.RegistrationAttachmentID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting End User Messaging SMS Registration Attachment (%s)", data.RegistrationAttachmentID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type registrationAttachmentResourceModel struct {
+	AttachmentBody            types.String                                  `tfsdk:"attachment_body"`
+	AttachmentStatus          fwtypes.StringEnum[awstypes.AttachmentStatus] `tfsdk:"attachment_status"`
+	AttachmentURL             types.String                                  `tfsdk:"attachment_url"`
+	RegistrationAttachmentARN types.String                                  `tfsdk:"arn"`
+	RegistrationAttachmentID  types.String                                  `tfsdk:"id"`
+	Tags                      tftags.Map                                    `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                    `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                `tfsdk:"timeouts"`
+}
+
+func findRegistrationAttachmentByID(ctx context.Context, conn *pinpointsmsvoicev2.Client, id string) (*awstypes.RegistrationAttachmentsInformation, error) {
+	input := &pinpointsmsvoicev2.DescribeRegistrationAttachmentsInput{
+		RegistrationAttachmentIds: []string{id},
+	}
+
+	output, err := findRegistrationAttachment(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.AttachmentStatus; status == awstypes.AttachmentStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findRegistrationAttachment(ctx context.Context, conn *pinpointsmsvoicev2.Client, input *pinpointsmsvoicev2.DescribeRegistrationAttachmentsInput) (*awstypes.RegistrationAttachmentsInformation, error) {
+	output, err := findRegistrationAttachments(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRegistrationAttachments(ctx context.Context, conn *pinpointsmsvoicev2.Client, input *pinpointsmsvoicev2.DescribeRegistrationAttachmentsInput) ([]awstypes.RegistrationAttachmentsInformation, error) {
+	var output []awstypes.RegistrationAttachmentsInformation
+
+	pages := pinpointsmsvoicev2.NewDescribeRegistrationAttachmentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RegistrationAttachments...)
+	}
+
+	return output, nil
+}
+
+func statusRegistrationAttachment(ctx context.Context, conn *pinpointsmsvoicev2.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findRegistrationAttachmentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.AttachmentStatus), nil
+	}
+}
+
+func waitRegistrationAttachmentUploaded(ctx context.Context, conn *pinpointsmsvoicev2.Client, id string, timeout time.Duration) (*awstypes.RegistrationAttachmentsInformation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AttachmentStatusUploadInProgress),
+		Target:  enum.Slice(awstypes.AttachmentStatusUploadComplete),
+		Refresh: statusRegistrationAttachment(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.RegistrationAttachmentsInformation); ok {
+		if output.AttachmentStatus == awstypes.AttachmentStatusUploadFailed {
+			tfresource.SetLastError(err, errors.New(string(output.AttachmentUploadErrorReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpointsmsvoicev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpointsmsvoicev2 "github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPinpointSMSVoiceV2Registration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RegistrationInformation
+	resourceName := "aws_pinpointsmsvoicev2_registration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckRegistration(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointSMSVoiceV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRegistrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistrationConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistrationExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("field_value"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("registration_status"), knownvalue.StringExact("CREATED")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("registration_type"), knownvalue.StringExact("US_TEN_DLC_BRAND_REGISTRATION")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("submit_registration_version"), knownvalue.Bool(false)),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"submit_registration_version"},
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2Registration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RegistrationInformation
+	resourceName := "aws_pinpointsmsvoicev2_registration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckRegistration(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointSMSVoiceV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRegistrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistrationConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistrationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpinpointsmsvoicev2.ResourceRegistration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2Registration_fieldValue(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RegistrationInformation
+	resourceName := "aws_pinpointsmsvoicev2_registration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckRegistration(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointSMSVoiceV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRegistrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistrationConfig_fieldValue("Example Corp"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistrationExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("field_value"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"field_path": knownvalue.StringExact("companyInfo.companyName"),
+							"text_value": knownvalue.StringExact("Example Corp"),
+						}),
+					})),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"submit_registration_version"},
+			},
+			{
+				Config: testAccRegistrationConfig_fieldValue("Example Corp Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegistrationExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("field_value"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"field_path": knownvalue.StringExact("companyInfo.companyName"),
+							"text_value": knownvalue.StringExact("Example Corp Updated"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckRegistrationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pinpointsmsvoicev2_registration" {
+				continue
+			}
+
+			_, err := tfpinpointsmsvoicev2.FindRegistrationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("End User Messaging SMS Registration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRegistrationExists(ctx context.Context, n string, v *awstypes.RegistrationInformation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Client(ctx)
+
+		output, err := tfpinpointsmsvoicev2.FindRegistrationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheckRegistration(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Client(ctx)
+
+	input := &pinpointsmsvoicev2.DescribeRegistrationsInput{}
+
+	_, err := conn.DescribeRegistrations(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+const testAccRegistrationConfig_basic = `
+resource "aws_pinpointsmsvoicev2_registration" "test" {
+  registration_type = "US_TEN_DLC_BRAND_REGISTRATION"
+}
+`
+
+func testAccRegistrationConfig_fieldValue(companyName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpointsmsvoicev2_registration" "test" {
+  registration_type = "US_TEN_DLC_BRAND_REGISTRATION"
+
+  field_value {
+    field_path = "companyInfo.companyName"
+    text_value = %[1]q
+  }
+}
+`, companyName)
+}
@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpointsmsvoicev2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pinpointsmsvoicev2_sender_id", name="Sender ID")
+// @Tags(identifierAttribute="arn")
+func newSenderIDResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &senderIDResource{}
+
+	return r, nil
+}
+
+type senderIDResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *senderIDResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"deletion_protection_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"iso_country_code": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Z]{2}$`), "must be in ISO 3166-1 alpha-2 format"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message_types": schema.SetAttribute{
+				CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.MessageType]](ctx),
+				Optional:    true,
+				Computed:    true,
+				ElementType: fwtypes.StringEnumType[awstypes.MessageType](),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIfConfigured(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monthly_leasing_price": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registered": schema.BoolAttribute{
+				Computed: true,
+			},
+			"registration_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"sender_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z0-9_-]{1,11}$`), "must be between 1 and 11 characters long and contain only letters, numbers, underscores, and dashes"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *senderIDResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data senderIDResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	input := &pinpointsmsvoicev2.RequestSenderIdInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.RequestSenderId(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("requesting End User Messaging SMS Sender ID (%s)", data.SenderID.ValueString()), err.Error())
+
+		return
+	}
+
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("requesting End User Messaging SMS Sender ID (%s)", data.SenderID.ValueString()), err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	out, err := findSenderIDByTwoPartKey(ctx, conn, data.SenderID.ValueString(), data.ISOCountryCode.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading End User Messaging SMS Sender ID (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *senderIDResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data senderIDResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	out, err := findSenderIDByTwoPartKey(ctx, conn, data.SenderID.ValueString(), data.ISOCountryCode.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading End User Messaging SMS Sender ID (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *senderIDResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new senderIDResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	if !new.DeletionProtectionEnabled.Equal(old.DeletionProtectionEnabled) {
+		input := &pinpointsmsvoicev2.UpdateSenderIdInput{
+			DeletionProtectionEnabled: fwflex.BoolFromFramework(ctx, new.DeletionProtectionEnabled),
+			IsoCountryCode:            fwflex.StringFromFramework(ctx, new.ISOCountryCode),
+			SenderId:                  fwflex.StringFromFramework(ctx, new.SenderID),
+		}
+
+		_, err := conn.UpdateSenderId(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating End User Messaging SMS Sender ID (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	out, err := findSenderIDByTwoPartKey(ctx, conn, new.SenderID.ValueString(), new.ISOCountryCode.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading End User Messaging SMS Sender ID (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, out, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *senderIDResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data senderIDResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PinpointSMSVoiceV2Client(ctx)
+
+	_, err := conn.ReleaseSenderId(ctx, &pinpointsmsvoicev2.ReleaseSenderIdInput{
+		IsoCountryCode: data.ISOCountryCode.ValueStringPointer(),
+		SenderId:       data.SenderID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("releasing End User Messaging SMS Sender ID (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type senderIDResourceModel struct {
+	DeletionProtectionEnabled types.Bool                                                   `tfsdk:"deletion_protection_enabled"`
+	ID                        types.String                                                 `tfsdk:"id"`
+	ISOCountryCode            types.String                                                 `tfsdk:"iso_country_code"`
+	MessageTypes              fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.MessageType]] `tfsdk:"message_types"`
+	MonthlyLeasingPrice       types.String                                                 `tfsdk:"monthly_leasing_price"`
+	Registered                types.Bool                                                   `tfsdk:"registered"`
+	RegistrationID            types.String                                                 `tfsdk:"registration_id"`
+	SenderID                  types.String                                                 `tfsdk:"sender_id"`
+	SenderIDARN               types.String                                                 `tfsdk:"arn"`
+	Tags                      tftags.Map                                                   `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                   `tfsdk:"tags_all"`
+}
+
+const (
+	senderIDResourceIDPartCount = 2
+)
+
+func (m *senderIDResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, senderIDResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.SenderID = types.StringValue(parts[0])
+	m.ISOCountryCode = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *senderIDResourceModel) setID() (string, error) {
+	parts := []string{
+		m.SenderID.ValueString(),
+		m.ISOCountryCode.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, senderIDResourceIDPartCount, false)
+}
+
+func findSenderIDByTwoPartKey(ctx context.Context, conn *pinpointsmsvoicev2.Client, senderID, isoCountryCode string) (*awstypes.SenderIdInformation, error) {
+	input := &pinpointsmsvoicev2.DescribeSenderIdsInput{
+		SenderIds: []awstypes.SenderIdAndCountry{
+			{
+				IsoCountryCode: aws.String(isoCountryCode),
+				SenderId:       aws.String(senderID),
+			},
+		},
+	}
+
+	return findSenderID(ctx, conn, input)
+}
+
+func findSenderID(ctx context.Context, conn *pinpointsmsvoicev2.Client, input *pinpointsmsvoicev2.DescribeSenderIdsInput) (*awstypes.SenderIdInformation, error) {
+	output, err := findSenderIDs(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findSenderIDs(ctx context.Context, conn *pinpointsmsvoicev2.Client, input *pinpointsmsvoicev2.DescribeSenderIdsInput) ([]awstypes.SenderIdInformation, error) {
+	var output []awstypes.SenderIdInformation
+
+	pages := pinpointsmsvoicev2.NewDescribeSenderIdsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SenderIds...)
+	}
+
+	return output, nil
+}
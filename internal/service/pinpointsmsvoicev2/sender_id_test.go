@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpointsmsvoicev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpointsmsvoicev2 "github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPinpointSMSVoiceV2SenderID_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SenderIdInformation
+	senderID := sdkacctest.RandStringFromCharSet(11, sdkacctest.CharSetAlpha)
+	resourceName := "aws_pinpointsmsvoicev2_sender_id.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSenderID(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointSMSVoiceV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSenderIDDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSenderIDConfig_basic(senderID, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSenderIDExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("deletion_protection_enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("iso_country_code"), knownvalue.StringExact("GB")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("message_types"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("TRANSACTIONAL"),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("sender_id"), knownvalue.StringExact(senderID)),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSenderIDConfig_basic(senderID, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSenderIDExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("deletion_protection_enabled"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: testAccSenderIDConfig_basic(senderID, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSenderIDExists(ctx, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("deletion_protection_enabled"), knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccPinpointSMSVoiceV2SenderID_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SenderIdInformation
+	senderID := sdkacctest.RandStringFromCharSet(11, sdkacctest.CharSetAlpha)
+	resourceName := "aws_pinpointsmsvoicev2_sender_id.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSenderID(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointSMSVoiceV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSenderIDDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSenderIDConfig_basic(senderID, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSenderIDExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpinpointsmsvoicev2.ResourceSenderID, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSenderIDDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pinpointsmsvoicev2_sender_id" {
+				continue
+			}
+
+			_, err := tfpinpointsmsvoicev2.FindSenderIDByTwoPartKey(ctx, conn, rs.Primary.Attributes["sender_id"], rs.Primary.Attributes["iso_country_code"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("End User Messaging SMS Sender ID %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSenderIDExists(ctx context.Context, n string, v *awstypes.SenderIdInformation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Client(ctx)
+
+		output, err := tfpinpointsmsvoicev2.FindSenderIDByTwoPartKey(ctx, conn, rs.Primary.Attributes["sender_id"], rs.Primary.Attributes["iso_country_code"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheckSenderID(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointSMSVoiceV2Client(ctx)
+
+	input := &pinpointsmsvoicev2.DescribeSenderIdsInput{}
+
+	_, err := conn.DescribeSenderIds(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccSenderIDConfig_basic(senderID string, deletionProtectionEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_pinpointsmsvoicev2_sender_id" "test" {
+  sender_id                   = %[1]q
+  iso_country_code            = "GB"
+  message_types               = ["TRANSACTIONAL"]
+  deletion_protection_enabled = %[2]t
+}
+`, senderID, deletionProtectionEnabled)
+}
@@ -44,6 +44,30 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  newRegistrationResource,
+			TypeName: "aws_pinpointsmsvoicev2_registration",
+			Name:     "Registration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newRegistrationAttachmentResource,
+			TypeName: "aws_pinpointsmsvoicev2_registration_attachment",
+			Name:     "Registration Attachment",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newSenderIDResource,
+			TypeName: "aws_pinpointsmsvoicev2_sender_id",
+			Name:     "Sender ID",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 	}
 }
 
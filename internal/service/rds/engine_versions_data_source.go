@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_rds_engine_versions", name="Engine Versions")
+func dataSourceEngineVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEngineVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"default_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			names.AttrEngine: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEngine: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parameter_group_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"valid_major_targets": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"valid_minor_targets": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"exclude_major_version_upgrades": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"valid_upgrade_targets_for"},
+			},
+			names.AttrFilter: namevaluesfilters.Schema(),
+			"include_all": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"parameter_group_family": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"valid_upgrade_targets_for": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{names.AttrEngine},
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceEngineVersionsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	input := &rds.DescribeDBEngineVersionsInput{}
+
+	if v, ok := d.GetOk("default_only"); ok {
+		input.DefaultOnly = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk(names.AttrEngine); ok {
+		input.Engine = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrFilter); ok {
+		input.Filters = namevaluesfilters.New(v.(*schema.Set)).RDSFilters()
+	}
+
+	if v, ok := d.GetOk("include_all"); ok {
+		input.IncludeAll = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("parameter_group_family"); ok {
+		input.DBParameterGroupFamily = aws.String(v.(string))
+	}
+
+	filter := tfslices.PredicateTrue[*awstypes.DBEngineVersion]()
+
+	if v, ok := d.GetOk("valid_upgrade_targets_for"); ok {
+		sourceVersion := v.(string)
+		sourceEngineVersions, err := findDBEngineVersions(ctx, conn, &rds.DescribeDBEngineVersionsInput{
+			Engine:        input.Engine,
+			EngineVersion: aws.String(sourceVersion),
+			IncludeAll:    aws.Bool(true),
+		}, tfslices.PredicateTrue[*awstypes.DBEngineVersion]())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RDS Engine Version (%s): %s", sourceVersion, err)
+		}
+
+		excludeMajor := d.Get("exclude_major_version_upgrades").(bool)
+		targets := make(map[string]struct{})
+		for _, sourceEngineVersion := range sourceEngineVersions {
+			for _, upgradeTarget := range sourceEngineVersion.ValidUpgradeTarget {
+				if excludeMajor && aws.ToBool(upgradeTarget.IsMajorVersionUpgrade) {
+					continue
+				}
+
+				targets[aws.ToString(upgradeTarget.EngineVersion)] = struct{}{}
+			}
+		}
+
+		filter = func(v *awstypes.DBEngineVersion) bool {
+			_, ok := targets[aws.ToString(v.EngineVersion)]
+			return ok
+		}
+	}
+
+	engineVersions, err := findDBEngineVersions(ctx, conn, input, filter)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Engine Versions: %s", err)
+	}
+
+	sortEngineVersions(engineVersions)
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	if err := d.Set("engine_versions", flattenDBEngineVersions(engineVersions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting engine_versions: %s", err)
+	}
+	d.Set("versions", tfslices.ApplyToAll(engineVersions, func(v awstypes.DBEngineVersion) string {
+		return aws.ToString(v.EngineVersion)
+	}))
+
+	return diags
+}
+
+func flattenDBEngineVersions(apiObjects []awstypes.DBEngineVersion) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		var minorTargets, majorTargets []string
+		for _, v := range apiObject.ValidUpgradeTarget {
+			if aws.ToBool(v.IsMajorVersionUpgrade) {
+				majorTargets = append(majorTargets, aws.ToString(v.EngineVersion))
+			} else {
+				minorTargets = append(minorTargets, aws.ToString(v.EngineVersion))
+			}
+		}
+
+		tfList = append(tfList, map[string]any{
+			names.AttrEngine:         aws.ToString(apiObject.Engine),
+			"parameter_group_family": aws.ToString(apiObject.DBParameterGroupFamily),
+			names.AttrStatus:         aws.ToString(apiObject.Status),
+			"valid_major_targets":    majorTargets,
+			"valid_minor_targets":    minorTargets,
+			names.AttrVersion:        aws.ToString(apiObject.EngineVersion),
+			"version_description":    aws.ToString(apiObject.DBEngineVersionDescription),
+		})
+	}
+
+	return tfList
+}
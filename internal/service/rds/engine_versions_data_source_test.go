@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSEngineVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_engine_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccEngineVersionPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionsDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "versions.#", regexache.MustCompile(`^[1-9][0-9]*`)),
+					resource.TestMatchResourceAttr(dataSourceName, "engine_versions.#", regexache.MustCompile(`^[1-9][0-9]*`)),
+					resource.TestCheckResourceAttr(dataSourceName, "engine_versions.0.engine", tfrds.InstanceEngineMySQL),
+					resource.TestCheckResourceAttrSet(dataSourceName, "engine_versions.0.parameter_group_family"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "engine_versions.0.status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "engine_versions.0.version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSEngineVersionsDataSource_defaultOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_engine_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccEngineVersionPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionsDataSourceConfig_defaultOnly(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "engine_versions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSEngineVersionsDataSource_validUpgradeTargetsFor(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_engine_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccEngineVersionPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionsDataSourceConfig_validUpgradeTargetsFor(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "versions.#", regexache.MustCompile(`^[1-9][0-9]*`)),
+				),
+			},
+			{
+				Config: testAccEngineVersionsDataSourceConfig_validUpgradeTargetsFor(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "versions.#", regexache.MustCompile(`^[1-9][0-9]*`)),
+					resource.TestMatchResourceAttr(dataSourceName, "versions.0", regexache.MustCompile(`^8\.0\.`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccEngineVersionsDataSourceConfig_basic() string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_versions" "test" {
+  engine = %[1]q
+}
+`, tfrds.InstanceEngineMySQL)
+}
+
+func testAccEngineVersionsDataSourceConfig_defaultOnly() string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_versions" "test" {
+  engine                 = %[1]q
+  default_only           = true
+  parameter_group_family = "mysql8.0"
+}
+`, tfrds.InstanceEngineMySQL)
+}
+
+func testAccEngineVersionsDataSourceConfig_validUpgradeTargetsFor(excludeMajor bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_versions" "test" {
+  engine                         = %[1]q
+  valid_upgrade_targets_for      = "8.0.32"
+  exclude_major_version_upgrades = %[2]t
+}
+`, tfrds.InstanceEngineMySQL, excludeMajor)
+}
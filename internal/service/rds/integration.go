@@ -13,12 +13,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -26,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -34,6 +37,8 @@ import (
 
 // @FrameworkResource("aws_rds_integration", name="Integration")
 // @Tags(identifierAttribute="arn")
+// @ComputedRegion
+// @ComputedAccountID
 // @Testing(tagsTest=false)
 func newIntegrationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &integrationResource{}
@@ -64,10 +69,19 @@ type integrationResource struct {
 func (r *integrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"additional_encryption_context": schema.MapAttribute{
-				CustomType:  fwtypes.MapOfStringType,
+				CustomType:  fwtypes.EmptyAsNullMapOfStringType,
 				ElementType: types.StringType,
 				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.RequiresReplace(),
 				},
@@ -102,6 +116,15 @@ func (r *integrationResource) Schema(ctx context.Context, request resource.Schem
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					fwvalidators.ARNOfResourceType("rds", "cluster", "db"),
+				},
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
@@ -111,6 +134,10 @@ func (r *integrationResource) Schema(ctx context.Context, request resource.Schem
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					// Amazon SageMaker Lakehouse targets are AWS Glue Data Catalog ARNs.
+					fwvalidators.ARNOfService("glue", "redshift", "redshift-serverless"),
+				},
 			},
 		},
 		Blocks: map[string]schema.Block{
@@ -199,19 +226,12 @@ func (r *integrationResource) Read(ctx context.Context, request resource.ReadReq
 		return
 	}
 
-	prevAdditionalEncryptionContext := data.AdditionalEncryptionContext
-
 	// Set attributes for import.
 	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	// Null vs. empty map handling.
-	if prevAdditionalEncryptionContext.IsNull() && !data.AdditionalEncryptionContext.IsNull() && len(data.AdditionalEncryptionContext.Elements()) == 0 {
-		data.AdditionalEncryptionContext = prevAdditionalEncryptionContext
-	}
-
 	setTagsOut(ctx, output.Tags)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
@@ -352,17 +372,19 @@ func integrationError(v awstypes.IntegrationError) error {
 }
 
 type integrationResourceModel struct {
-	AdditionalEncryptionContext fwtypes.MapValueOf[types.String] `tfsdk:"additional_encryption_context"`
-	DataFilter                  types.String                     `tfsdk:"data_filter"`
-	ID                          types.String                     `tfsdk:"id"`
-	IntegrationARN              types.String                     `tfsdk:"arn"`
-	IntegrationName             types.String                     `tfsdk:"integration_name"`
-	KMSKeyID                    types.String                     `tfsdk:"kms_key_id"`
-	SourceARN                   fwtypes.ARN                      `tfsdk:"source_arn"`
-	Tags                        tftags.Map                       `tfsdk:"tags"`
-	TagsAll                     tftags.Map                       `tfsdk:"tags_all"`
-	TargetARN                   fwtypes.ARN                      `tfsdk:"target_arn"`
-	Timeouts                    timeouts.Value                   `tfsdk:"timeouts"`
+	AccountID                   types.String                   `tfsdk:"account_id"`
+	AdditionalEncryptionContext fwtypes.EmptyAsNullMapOfString `tfsdk:"additional_encryption_context"`
+	DataFilter                  types.String                   `tfsdk:"data_filter"`
+	ID                          types.String                   `tfsdk:"id"`
+	IntegrationARN              types.String                   `tfsdk:"arn"`
+	IntegrationName             types.String                   `tfsdk:"integration_name"`
+	KMSKeyID                    types.String                   `tfsdk:"kms_key_id"`
+	Region                      types.String                   `tfsdk:"region"`
+	SourceARN                   fwtypes.ARN                    `tfsdk:"source_arn"`
+	Tags                        tftags.Map                     `tfsdk:"tags"`
+	TagsAll                     tftags.Map                     `tfsdk:"tags_all"`
+	TargetARN                   fwtypes.ARN                    `tfsdk:"target_arn"`
+	Timeouts                    timeouts.Value                 `tfsdk:"timeouts"`
 }
 
 func (model *integrationResourceModel) InitFromID() error {
@@ -94,6 +94,10 @@ func resourceProxy() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"default_endpoint_target_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrEndpoint: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -214,6 +218,17 @@ func resourceProxyRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	d.Set(names.AttrVPCSecurityGroupIDs, dbProxy.VpcSecurityGroupIds)
 	d.Set(names.AttrEndpoint, dbProxy.Endpoint)
 
+	defaultEndpoint, err := findDefaultDBProxyEndpointByProxyName(ctx, conn, d.Id())
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("default_endpoint_target_role", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading RDS DB Proxy (%s) default endpoint: %s", d.Id(), err)
+	default:
+		d.Set("default_endpoint_target_role", defaultEndpoint.TargetRole)
+	}
+
 	return diags
 }
 
@@ -302,6 +317,16 @@ func findDBProxyByName(ctx context.Context, conn *rds.Client, name string) (*typ
 	return output, nil
 }
 
+func findDefaultDBProxyEndpointByProxyName(ctx context.Context, conn *rds.Client, name string) (*types.DBProxyEndpoint, error) {
+	input := &rds.DescribeDBProxyEndpointsInput{
+		DBProxyName: aws.String(name),
+	}
+
+	return findDBProxyEndpoint(ctx, conn, input, func(v *types.DBProxyEndpoint) bool {
+		return aws.ToBool(v.IsDefault)
+	})
+}
+
 func findDBProxy(ctx context.Context, conn *rds.Client, input *rds.DescribeDBProxiesInput, filter tfslices.Predicate[*types.DBProxy]) (*types.DBProxy, error) {
 	output, err := findDBProxies(ctx, conn, input, filter)
 
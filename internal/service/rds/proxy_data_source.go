@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -59,6 +60,10 @@ func dataSourceProxy() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"default_endpoint_target_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrEndpoint: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -125,5 +130,16 @@ func dataSourceProxyRead(ctx context.Context, d *schema.ResourceData, meta any)
 	d.Set(names.AttrVPCSecurityGroupIDs, dbProxy.VpcSecurityGroupIds)
 	d.Set("vpc_subnet_ids", dbProxy.VpcSubnetIds)
 
+	defaultEndpoint, err := findDefaultDBProxyEndpointByProxyName(ctx, conn, name)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("default_endpoint_target_role", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading RDS DB Proxy (%s) default endpoint: %s", name, err)
+	default:
+		d.Set("default_endpoint_target_role", defaultEndpoint.TargetRole)
+	}
+
 	return diags
 }
@@ -5,6 +5,7 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
@@ -48,6 +49,10 @@ func resourceReservedInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"confirm_purchase": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"currency_code": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -127,7 +132,26 @@ func resourceReservedInstance() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
+
+		CustomizeDiff: resourceReservedInstanceCustomizeDiff,
+	}
+}
+
+func resourceReservedInstanceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	// Only a new purchase, on create or on replacement, needs to be confirmed. The value may not be known until apply.
+	if d.Id() != "" && !d.HasChanges(names.AttrInstanceCount, "offering_id", "reservation_id") {
+		return nil
+	}
+
+	if !d.NewValueKnown("confirm_purchase") {
+		return nil
 	}
+
+	if !d.Get("confirm_purchase").(bool) {
+		return errors.New("purchasing an RDS Reserved Instance is a financial commitment that can't be cancelled; set confirm_purchase to true to confirm the purchase")
+	}
+
+	return nil
 }
 
 func resourceReservedInstanceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestReservedInstanceCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		state       *terraform.InstanceState
+		config      map[string]any
+		expectError bool
+	}{
+		"create not confirmed": {
+			config: map[string]any{
+				"offering_id": "offering-1",
+			},
+			expectError: true,
+		},
+		"create confirmed": {
+			config: map[string]any{
+				"confirm_purchase": true,
+				"offering_id":      "offering-1",
+			},
+		},
+		"replace not confirmed": {
+			state: &terraform.InstanceState{
+				ID: "reservation-1",
+				Attributes: map[string]string{
+					"instance_count": "1",
+					"offering_id":    "offering-1",
+				},
+			},
+			config: map[string]any{
+				"offering_id": "offering-2",
+			},
+			expectError: true,
+		},
+		"replace confirmed": {
+			state: &terraform.InstanceState{
+				ID: "reservation-1",
+				Attributes: map[string]string{
+					"instance_count": "1",
+					"offering_id":    "offering-1",
+				},
+			},
+			config: map[string]any{
+				"confirm_purchase": true,
+				"instance_count":   2,
+				"offering_id":      "offering-1",
+			},
+		},
+		"existing not confirmed": {
+			state: &terraform.InstanceState{
+				ID: "reservation-1",
+				Attributes: map[string]string{
+					"instance_count": "1",
+					"offering_id":    "offering-1",
+				},
+			},
+			config: map[string]any{
+				"offering_id": "offering-1",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := resourceReservedInstance().Diff(context.Background(), testCase.state, terraform.NewResourceConfigRaw(testCase.config), nil)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
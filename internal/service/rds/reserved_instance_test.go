@@ -97,9 +97,10 @@ data "aws_rds_reserved_instance_offering" "test" {
 }
 
 resource "aws_rds_reserved_instance" "test" {
-  offering_id    = data.aws_rds_reserved_instance_offering.test.offering_id
-  reservation_id = %[1]q
-  instance_count = %[2]s
+  offering_id      = data.aws_rds_reserved_instance_offering.test.offering_id
+  reservation_id   = %[1]q
+  instance_count   = %[2]s
+  confirm_purchase = true
 }
 `, rName, instanceCount)
 }
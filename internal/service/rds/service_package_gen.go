@@ -51,6 +51,8 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			ComputedRegion:    true,
+			ComputedAccountID: true,
 		},
 		{
 			Factory:  newShardGroupResource,
@@ -129,6 +131,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_rds_engine_version",
 			Name:     "Engine Version",
 		},
+		{
+			Factory:  dataSourceEngineVersions,
+			TypeName: "aws_rds_engine_versions",
+			Name:     "Engine Versions",
+		},
 		{
 			Factory:  dataSourceOrderableInstance,
 			TypeName: "aws_rds_orderable_db_instance",
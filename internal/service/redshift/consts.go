@@ -63,6 +63,10 @@ const (
 	endpointAccessStatusModifying = "modifying"
 )
 
+const (
+	integrationImportIDNamePrefix = "name:"
+)
+
 const (
 	propagationTimeout = 2 * time.Minute
 )
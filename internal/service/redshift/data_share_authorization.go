@@ -29,6 +29,7 @@ import (
 )
 
 // @FrameworkResource("aws_redshift_data_share_authorization", name="Data Share Authorization")
+// @RegionOverride
 func newResourceDataShareAuthorization(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceDataShareAuthorization{}, nil
 }
@@ -79,6 +80,14 @@ func (r *resourceDataShareAuthorization) Schema(ctx context.Context, req resourc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
@@ -271,4 +280,5 @@ type resourceDataShareAuthorizationData struct {
 	ID                 types.String `tfsdk:"id"`
 	ManagedBy          types.String `tfsdk:"managed_by"`
 	ProducerARN        fwtypes.ARN  `tfsdk:"producer_arn"`
+	Region             types.String `tfsdk:"region"`
 }
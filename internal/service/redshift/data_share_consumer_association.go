@@ -32,6 +32,7 @@ import (
 )
 
 // @FrameworkResource("aws_redshift_data_share_consumer_association", name="Data Share Consumer Association")
+// @RegionOverride
 func newResourceDataShareConsumerAssociation(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceDataShareConsumerAssociation{}, nil
 }
@@ -93,6 +94,14 @@ func (r *resourceDataShareConsumerAssociation) Schema(ctx context.Context, req r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
@@ -339,6 +348,7 @@ type resourceDataShareConsumerAssociationData struct {
 	ID                     types.String `tfsdk:"id"`
 	ManagedBy              types.String `tfsdk:"managed_by"`
 	ProducerARN            fwtypes.ARN  `tfsdk:"producer_arn"`
+	Region                 types.String `tfsdk:"region"`
 }
 
 // accountIDFromARN returns the account ID from the provided ARN string
@@ -0,0 +1,881 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata"
+	redshiftdatatypes "github.com/aws/aws-sdk-go-v2/service/redshiftdata/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfredshiftdata "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_redshift_integration", name="Integration")
+// @Tags(identifierAttribute="arn")
+// @RegionOverride
+// @ComputedAccountID
+func newIntegrationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &integrationResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type integrationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *integrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"additional_encryption_context": schema.MapAttribute{
+				CustomType:  fwtypes.EmptyAsNullMapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_target_data_on_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Whether to drop the database created from the integration in the target when the integration is destroyed. " +
+					"The database is dropped using the Redshift Data API after the integration is deleted. " +
+					"Requires target_database_name and target_workgroup_name. Not supported for a provisioned cluster target.",
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"integration_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"integration_name": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prevent_destructive_key_change": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrSkipDestroy: schema.BoolAttribute{
+				Optional: true,
+			},
+			"source_account_allowed": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"source_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.Any(
+						fwvalidators.ARNOfResourceType("dynamodb", "table"),
+						fwvalidators.ARNOfService("s3"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ZeroETLIntegrationStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTargetARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.Any(
+						fwvalidators.ARNOfResourceType("redshift", "cluster", "namespace"),
+						fwvalidators.ARNOfResourceType("redshift-serverless", "namespace"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_connection_database_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the database in the target to connect to when dropping the integration database. Defaults to dev.",
+			},
+			"target_namespace_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_database_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the database created from the integration in the target.",
+			},
+			"target_workgroup_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the Redshift Serverless workgroup used to drop the integration database.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *integrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data integrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftClient(ctx)
+
+	name := data.IntegrationName.ValueString()
+	var input redshift.CreateIntegrationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.TagList = getTagsIn(ctx)
+
+	// A provisioned cluster target is identified by its namespace ARN.
+	if v, ok := clusterIdentifierFromARN(data.TargetARN.ValueARN()); ok {
+		namespaceARN, err := findClusterNamespaceARNByID(ctx, conn, v)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating Redshift Integration (%s)", name), fmt.Sprintf("resolving target cluster (%s) namespace: %s", v, err))
+
+			return
+		}
+
+		input.TargetArn = aws.String(namespaceARN)
+	}
+
+	output, err := conn.CreateIntegration(ctx, &input)
+
+	if errs.IsA[*awstypes.UnauthorizedPartnerIntegrationFault](err) {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("creating Redshift Integration (%s)", name),
+			fmt.Sprintf("%s\n\nThe integration source is not authorized to replicate into the target. "+
+				"Check that the target's resource policy allows redshift:AuthorizeInboundIntegration for the source (%s) "+
+				"and, for a source in another AWS account, redshift:CreateInboundIntegration for that account.", err, data.SourceARN.ValueString()),
+		)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Redshift Integration (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.IntegrationARN = fwflex.StringToFramework(ctx, output.IntegrationArn)
+	data.setID()
+	if err := data.setIntegrationID(); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("creating Redshift Integration (%s)", name), err.Error())
+
+		return
+	}
+
+	integration, err := waitIntegrationCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Redshift Integration (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CreateTime = timetypes.NewRFC3339TimePointerValue(integration.CreateTime)
+	data.KMSKeyID = fwflex.StringToFramework(ctx, integration.KMSKeyId)
+	data.Status = fwtypes.StringEnumValue(integration.Status)
+	data.TargetNamespaceARN = fwtypes.ARNValue(aws.ToString(integration.TargetArn))
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *integrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data integrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().RedshiftClient(ctx)
+
+	output, err := findIntegrationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Redshift Integration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// A provisioned cluster target is configured using the cluster ARN, but the API returns its namespace ARN.
+	targetARN := data.TargetARN
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.TargetNamespaceARN = fwtypes.ARNValue(aws.ToString(output.TargetArn))
+	if _, ok := clusterIdentifierFromARN(targetARN.ValueARN()); ok {
+		data.TargetARN = targetARN
+	}
+
+	if err := data.setIntegrationID(); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Redshift Integration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *integrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new integrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftClient(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.IntegrationName.Equal(old.IntegrationName) {
+		input := redshift.ModifyIntegrationInput{
+			IntegrationArn: fwflex.StringFromFramework(ctx, new.ID),
+		}
+
+		if !new.Description.Equal(old.Description) {
+			input.Description = aws.String(new.Description.ValueString())
+		}
+
+		if !new.IntegrationName.Equal(old.IntegrationName) {
+			input.IntegrationName = fwflex.StringFromFramework(ctx, new.IntegrationName)
+		}
+
+		_, err := conn.ModifyIntegration(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Redshift Integration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		integration, err := waitIntegrationUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Redshift Integration (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(integration.Status)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *integrationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan integrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var state *integrationResourceModel
+	if !request.State.Raw.IsNull() {
+		state = &integrationResourceModel{}
+		response.Diagnostics.Append(request.State.Get(ctx, state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// The integration's KMS key cannot be modified, so changing it replaces the integration.
+		if !plan.KMSKeyID.IsUnknown() && !plan.KMSKeyID.Equal(state.KMSKeyID) {
+			detail := fmt.Sprintf("Changing kms_key_id from %q to %q replaces the integration. "+
+				"The new integration re-seeds the target with a full copy of the source data, "+
+				"and the data replicated by the current integration is no longer updated.", state.KMSKeyID.ValueString(), plan.KMSKeyID.ValueString())
+
+			if plan.PreventDestructiveKeyChange.ValueBool() {
+				response.Diagnostics.AddAttributeError(
+					path.Root(names.AttrKMSKeyID),
+					"Redshift Integration KMS key change prevented",
+					detail+" Set prevent_destructive_key_change to false to allow the replacement.",
+				)
+
+				return
+			}
+
+			response.Diagnostics.AddAttributeWarning(path.Root(names.AttrKMSKeyID), "Redshift Integration KMS key change", detail)
+		}
+	}
+
+	if plan.DeleteTargetDataOnDestroy.ValueBool() {
+		for _, v := range []struct {
+			name  string
+			value types.String
+		}{
+			{"target_database_name", plan.TargetDatabaseName},
+			{"target_workgroup_name", plan.TargetWorkgroupName},
+		} {
+			if v.value.IsNull() {
+				response.Diagnostics.AddAttributeError(
+					path.Root(v.name),
+					"Missing Redshift Integration target database",
+					fmt.Sprintf("%s must be set when delete_target_data_on_destroy is true.", v.name),
+				)
+			}
+		}
+	}
+
+	// The source and target may not be known until apply.
+	if plan.SourceARN.IsNull() || plan.SourceARN.IsUnknown() || plan.TargetARN.IsNull() || plan.TargetARN.IsUnknown() {
+		return
+	}
+
+	source, target := plan.SourceARN.ValueARN(), plan.TargetARN.ValueARN()
+
+	// The target database is dropped using the Redshift Data API, which can only connect to a provisioned cluster
+	// with a database user or a Secrets Manager secret. Only a Redshift Serverless workgroup target is supported.
+	if plan.DeleteTargetDataOnDestroy.ValueBool() && target.Service == "redshift" {
+		response.Diagnostics.AddAttributeError(
+			path.Root("delete_target_data_on_destroy"),
+			"Unsupported Redshift Integration target",
+			"delete_target_data_on_destroy is only supported for a Redshift Serverless target. "+
+				"Dropping the target database of a provisioned cluster requires a database user or secret.",
+		)
+
+		return
+	}
+
+	// Translate a provisioned cluster target to its namespace ARN.
+	// The namespace ARN is only resolved when the target changes; otherwise the value in state is kept.
+	// The cluster may not exist until apply, in which case the namespace ARN is resolved on create.
+	if state == nil || !plan.TargetARN.Equal(state.TargetARN) {
+		targetNamespaceARN := plan.TargetARN
+		if v, ok := clusterIdentifierFromARN(target); ok {
+			conn := r.Meta().RedshiftClient(ctx)
+
+			namespaceARN, err := findClusterNamespaceARNByID(ctx, conn, v)
+
+			switch {
+			case tfresource.NotFound(err):
+				targetNamespaceARN = fwtypes.ARNUnknown()
+			case err != nil:
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrTargetARN), "Invalid Redshift Integration target", fmt.Sprintf("resolving target cluster (%s) namespace: %s", v, err))
+
+				return
+			default:
+				targetNamespaceARN = fwtypes.ARNValue(namespaceARN)
+			}
+		}
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("target_namespace_arn"), targetNamespaceARN)...)
+	}
+
+	if source.Partition != target.Partition {
+		response.Diagnostics.AddAttributeError(
+			path.Root("source_arn"),
+			"Invalid Redshift Integration source",
+			fmt.Sprintf("source_arn is in partition %q and target_arn is in partition %q. An integration cannot replicate across partitions.", source.Partition, target.Partition),
+		)
+
+		return
+	}
+
+	// S3 bucket ARNs do not contain an account ID.
+	if source.AccountID != "" && source.AccountID != target.AccountID && !plan.SourceAccountAllowed.ValueBool() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("source_arn"),
+			"Cross-account Redshift Integration source",
+			fmt.Sprintf("source_arn is in AWS account %s and target_arn is in AWS account %s. "+
+				"Set source_account_allowed to true to create a cross-account integration. "+
+				"The target's resource policy must then allow redshift:CreateInboundIntegration for account %[1]s.", source.AccountID, target.AccountID),
+		)
+	}
+}
+
+func (r *integrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data integrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SkipDestroy.ValueBool() {
+		return
+	}
+
+	conn := r.Meta().RedshiftClient(ctx)
+
+	input := redshift.DeleteIntegrationInput{
+		IntegrationArn: fwflex.StringFromFramework(ctx, data.ID),
+	}
+	_, err := conn.DeleteIntegration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Redshift Integration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitIntegrationDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Redshift Integration (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if data.DeleteTargetDataOnDestroy.ValueBool() {
+		database := data.TargetDatabaseName.ValueString()
+
+		if err := dropIntegrationTargetDatabase(ctx, r.Meta().RedshiftDataClient(ctx), &data, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+			response.Diagnostics.AddError(
+				fmt.Sprintf("deleting Redshift Integration (%s) target database (%s)", data.ID.ValueString(), database),
+				fmt.Sprintf("%s\n\nThe integration has been deleted. Drop the database %q in the target manually.", err, database),
+			)
+
+			return
+		}
+	}
+}
+
+// ImportState imports an integration using its ARN, its integration ID or its name prefixed with "name:".
+// An integration imported using its integration ID or name is managed in the provider's Region.
+func (r *integrationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	id := request.ID
+
+	if name, ok := strings.CutPrefix(id, integrationImportIDNamePrefix); ok {
+		conn := r.Meta().RedshiftClient(ctx)
+
+		output, err := findIntegrationsByName(ctx, conn, name)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("importing Redshift Integration (%s)", id), err.Error())
+
+			return
+		}
+
+		switch n := len(output); n {
+		case 0:
+			response.Diagnostics.AddError(fmt.Sprintf("importing Redshift Integration (%s)", id), fmt.Sprintf("no Redshift Integration named %q found in %s", name, r.Meta().Region(ctx)))
+
+			return
+		case 1:
+			id = aws.ToString(output[0].IntegrationArn)
+		default:
+			arns := tfslices.ApplyToAll(output, func(v awstypes.Integration) string {
+				return aws.ToString(v.IntegrationArn)
+			})
+			response.Diagnostics.AddError(
+				fmt.Sprintf("importing Redshift Integration (%s)", id),
+				fmt.Sprintf("%d Redshift Integrations named %q found, import one of them using its ARN: %s", n, name, strings.Join(arns, ", ")),
+			)
+
+			return
+		}
+	} else if !arn.IsARN(id) {
+		id = r.Meta().RegionalARN(ctx, "redshift", "integration:"+id)
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), id)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The integration is managed in the Region in which it was created.
+	if v, err := arn.Parse(id); err == nil {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), v.Region)...)
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("delete_target_data_on_destroy"), false)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("prevent_destructive_key_change"), false)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("source_account_allowed"), false)...)
+}
+
+func findIntegrationByARN(ctx context.Context, conn *redshift.Client, arn string) (*awstypes.Integration, error) {
+	input := &redshift.DescribeIntegrationsInput{
+		IntegrationArn: aws.String(arn),
+	}
+
+	return findIntegration(ctx, conn, input, tfslices.PredicateTrue[*awstypes.Integration]())
+}
+
+// findIntegrationsByName returns the integrations with the specified name.
+// DescribeIntegrations cannot filter on the integration name, so the integrations are filtered client-side.
+func findIntegrationsByName(ctx context.Context, conn *redshift.Client, name string) ([]awstypes.Integration, error) {
+	input := &redshift.DescribeIntegrationsInput{}
+
+	return findIntegrations(ctx, conn, input, func(v *awstypes.Integration) bool {
+		return aws.ToString(v.IntegrationName) == name
+	})
+}
+
+func findIntegration(ctx context.Context, conn *redshift.Client, input *redshift.DescribeIntegrationsInput, filter tfslices.Predicate[*awstypes.Integration]) (*awstypes.Integration, error) {
+	output, err := findIntegrations(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findIntegrations(ctx context.Context, conn *redshift.Client, input *redshift.DescribeIntegrationsInput, filter tfslices.Predicate[*awstypes.Integration]) ([]awstypes.Integration, error) {
+	var output []awstypes.Integration
+
+	pages := redshift.NewDescribeIntegrationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Integrations {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// clusterIdentifierFromARN returns the cluster identifier from a provisioned cluster ARN.
+func clusterIdentifierFromARN(v arn.ARN) (string, bool) {
+	if v.Service != "redshift" {
+		return "", false
+	}
+
+	id, ok := strings.CutPrefix(v.Resource, "cluster:")
+
+	return id, ok && id != ""
+}
+
+func findClusterNamespaceARNByID(ctx context.Context, conn *redshift.Client, id string) (string, error) {
+	output, err := findClusterByID(ctx, conn, id)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output.ClusterNamespaceArn == nil {
+		return "", tfresource.NewEmptyResultError(id)
+	}
+
+	return aws.ToString(output.ClusterNamespaceArn), nil
+}
+
+// dropIntegrationTargetDatabase drops the database created from the integration in the target Redshift Serverless workgroup.
+func dropIntegrationTargetDatabase(ctx context.Context, conn *redshiftdata.Client, data *integrationResourceModel, timeout time.Duration) error {
+	database := "dev"
+	if v := data.TargetConnectionDatabaseName; !v.IsNull() {
+		database = v.ValueString()
+	}
+
+	input := redshiftdata.ExecuteStatementInput{
+		Database:      aws.String(database),
+		Sql:           aws.String("DROP DATABASE " + quoteIdentifier(data.TargetDatabaseName.ValueString())),
+		WorkgroupName: fwflex.StringFromFramework(ctx, data.TargetWorkgroupName),
+	}
+
+	output, err := conn.ExecuteStatement(ctx, &input)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = waitIntegrationTargetStatementFinished(ctx, conn, aws.ToString(output.Id), timeout)
+
+	return err
+}
+
+// quoteIdentifier returns the specified name as a quoted Redshift identifier.
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func statusIntegrationTargetStatement(ctx context.Context, conn *redshiftdata.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := tfredshiftdata.FindStatementByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitIntegrationTargetStatementFinished(ctx context.Context, conn *redshiftdata.Client, id string, timeout time.Duration) (*redshiftdata.DescribeStatementOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(redshiftdatatypes.StatusStringPicked, redshiftdatatypes.StatusStringStarted, redshiftdatatypes.StatusStringSubmitted),
+		Target:     enum.Slice(redshiftdatatypes.StatusStringFinished),
+		Refresh:    statusIntegrationTargetStatement(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*redshiftdata.DescribeStatementOutput); ok {
+		if output.Status == redshiftdatatypes.StatusStringFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func statusIntegration(ctx context.Context, conn *redshift.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findIntegrationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitIntegrationCreated(ctx context.Context, conn *redshift.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ZeroETLIntegrationStatusCreating, awstypes.ZeroETLIntegrationStatusModifying),
+		Target:  enum.Slice(awstypes.ZeroETLIntegrationStatusActive),
+		Refresh: statusIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Integration); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.Errors, integrationError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationUpdated(ctx context.Context, conn *redshift.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ZeroETLIntegrationStatusModifying),
+		Target:  enum.Slice(awstypes.ZeroETLIntegrationStatusActive),
+		Refresh: statusIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Integration); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.Errors, integrationError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationDeleted(ctx context.Context, conn *redshift.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ZeroETLIntegrationStatusDeleting, awstypes.ZeroETLIntegrationStatusActive),
+		Target:  []string{},
+		Refresh: statusIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Integration); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.Errors, integrationError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func integrationError(v awstypes.IntegrationError) error {
+	return fmt.Errorf("%s: %s", aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage))
+}
+
+type integrationResourceModel struct {
+	AccountID                    types.String                                          `tfsdk:"account_id"`
+	AdditionalEncryptionContext  fwtypes.EmptyAsNullMapOfString                        `tfsdk:"additional_encryption_context"`
+	CreateTime                   timetypes.RFC3339                                     `tfsdk:"create_time"`
+	DeleteTargetDataOnDestroy    types.Bool                                            `tfsdk:"delete_target_data_on_destroy"`
+	Description                  types.String                                          `tfsdk:"description"`
+	ID                           types.String                                          `tfsdk:"id"`
+	IntegrationARN               types.String                                          `tfsdk:"arn"`
+	IntegrationID                types.String                                          `tfsdk:"integration_id"`
+	IntegrationName              types.String                                          `tfsdk:"integration_name"`
+	KMSKeyID                     types.String                                          `tfsdk:"kms_key_id"`
+	PreventDestructiveKeyChange  types.Bool                                            `tfsdk:"prevent_destructive_key_change"`
+	Region                       types.String                                          `tfsdk:"region"`
+	SkipDestroy                  types.Bool                                            `tfsdk:"skip_destroy"`
+	SourceAccountAllowed         types.Bool                                            `tfsdk:"source_account_allowed"`
+	SourceARN                    fwtypes.ARN                                           `tfsdk:"source_arn"`
+	Status                       fwtypes.StringEnum[awstypes.ZeroETLIntegrationStatus] `tfsdk:"status"`
+	Tags                         tftags.Map                                            `tfsdk:"tags"`
+	TagsAll                      tftags.Map                                            `tfsdk:"tags_all"`
+	TargetARN                    fwtypes.ARN                                           `tfsdk:"target_arn"`
+	TargetConnectionDatabaseName types.String                                          `tfsdk:"target_connection_database_name"`
+	TargetDatabaseName           types.String                                          `tfsdk:"target_database_name"`
+	TargetNamespaceARN           fwtypes.ARN                                           `tfsdk:"target_namespace_arn"`
+	TargetWorkgroupName          types.String                                          `tfsdk:"target_workgroup_name"`
+	Timeouts                     timeouts.Value                                        `tfsdk:"timeouts"`
+}
+
+func (model *integrationResourceModel) InitFromID() error {
+	model.IntegrationARN = model.ID
+
+	return nil
+}
+
+func (model *integrationResourceModel) setID() {
+	model.ID = model.IntegrationARN
+}
+
+// setIntegrationID sets the integration ID, the last part of the integration ARN's resource.
+func (model *integrationResourceModel) setIntegrationID() error {
+	v, err := arn.Parse(model.IntegrationARN.ValueString())
+	if err != nil {
+		return err
+	}
+
+	id, ok := strings.CutPrefix(v.Resource, "integration:")
+	if !ok || id == "" {
+		return fmt.Errorf("unexpected integration ARN format: %s", model.IntegrationARN.ValueString())
+	}
+
+	model.IntegrationID = types.StringValue(id)
+
+	return nil
+}
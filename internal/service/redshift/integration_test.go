@@ -0,0 +1,1117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftIntegration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Integration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrAccountID), knownvalue.StringExact(acctest.AccountID(ctx))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrARN), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrCreateTime), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("integration_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("integration_name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrKMSKeyID), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrStatus), tfknownvalue.StringExact(awstypes.ZeroETLIntegrationStatusActive)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_dynamodb_table.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTargetARN, "aws_redshiftserverless_namespace.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.AttrImportStateIdFunc(resourceName, "integration_id"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccIntegrationImportStateIDFuncName(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Integration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfredshift.ResourceIntegration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Integration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_description(rName, rName, "original"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.StringExact("original")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("integration_name"), knownvalue.StringExact(rName)),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccIntegrationConfig_description(rName, rNameUpdated, "updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrDescription), knownvalue.StringExact("updated")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("integration_name"), knownvalue.StringExact(rNameUpdated)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrStatus), tfknownvalue.StringExact(awstypes.ZeroETLIntegrationStatusActive)),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_skipDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Integration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_skipDestroy(rName, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrSkipDestroy), knownvalue.Bool(true)),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrSkipDestroy},
+			},
+			{
+				// Disable skip_destroy so that the integration is cleaned up.
+				Config: testAccIntegrationConfig_skipDestroy(rName, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrSkipDestroy), knownvalue.Bool(false)),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_kmsKeyChange(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Integration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_kmsKey(rName, 0, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyID, "aws_kms_key.test.0", names.AttrARN),
+				),
+			},
+			{
+				Config:      testAccIntegrationConfig_kmsKey(rName, 1, true),
+				ExpectError: regexache.MustCompile(`Redshift Integration KMS key change prevented`),
+			},
+			{
+				Config: testAccIntegrationConfig_kmsKey(rName, 1, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyID, "aws_kms_key.test.1", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Integration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIntegrationConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1Updated),
+						acctest.CtKey2: knownvalue.StringExact(acctest.CtValue2),
+					})),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_region(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckIntegrationDestroyWithRegion(ctx, acctest.AlternateRegion()),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_region(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExistsWithRegion(ctx, resourceName, acctest.AlternateRegion()),
+					resource.TestMatchResourceAttr(resourceName, names.AttrARN, regexache.MustCompile(fmt.Sprintf(`^arn:[^:]+:redshift:%s:`, acctest.AlternateRegion()))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_deleteTargetDataOnDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Integration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_deleteTargetDataOnDestroy(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("delete_target_data_on_destroy"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("target_database_name"), knownvalue.StringExact("test_integration")),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "target_workgroup_name", "aws_redshiftserverless_workgroup.test", "workgroup_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_targetCluster(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Integration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_targetCluster(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTargetARN, "aws_redshift_cluster.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "target_namespace_arn", "aws_redshift_cluster.test", "cluster_namespace_arn"),
+				),
+			},
+			{
+				Config: testAccIntegrationConfig_targetCluster(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTargetARN},
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_targetClusterNamespace(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.Integration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_redshift_integration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationConfig_targetClusterNamespace(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTargetARN, "aws_redshift_cluster.test", "cluster_namespace_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_namespace_arn", "aws_redshift_cluster.test", "cluster_namespace_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_targetInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIntegrationConfig_targetInvalid(rName),
+				ExpectError: regexache.MustCompile(`value must be an ARN for`),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_sourceInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIntegrationConfig_sourceInvalid(rName),
+				ExpectError: regexache.MustCompile(`value must be an ARN for`),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_sourceAccountNotAllowed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIntegrationConfig_sourceAccount(rName, "111111111111", "222222222222"),
+				ExpectError: regexache.MustCompile(`Cross-account Redshift Integration source`),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftIntegration_deleteTargetDataOnDestroyTargetCluster(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RedshiftEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIntegrationConfig_deleteTargetDataOnDestroyTargetCluster(rName),
+				ExpectError: regexache.MustCompile(`Unsupported Redshift Integration target`),
+			},
+		},
+	})
+}
+
+func testAccCheckIntegrationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return testAccCheckIntegrationDestroyWithRegion(ctx, "")
+}
+
+func testAccCheckIntegrationDestroyWithRegion(ctx context.Context, region string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := conns.NewRegionOverrideContext(ctx, region)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_redshift_integration" {
+				continue
+			}
+
+			_, err := tfredshift.FindIntegrationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Redshift Integration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIntegrationExists(ctx context.Context, n string, v *awstypes.Integration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftClient(ctx)
+
+		output, err := tfredshift.FindIntegrationByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIntegrationExistsWithRegion(ctx context.Context, n, region string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		ctx := conns.NewRegionOverrideContext(ctx, region)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftClient(ctx)
+
+		_, err := tfredshift.FindIntegrationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccIntegrationImportStateIDFuncName(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return "name:" + rs.Primary.Attributes["integration_name"], nil
+	}
+}
+
+// testAccIntegrationConfig_baseSourceWithProvider returns a DynamoDB table source,
+// with the resource policy that authorizes the integration, managed by the specified provider.
+func testAccIntegrationConfig_baseSourceWithProvider(rName, provider string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {
+  provider = %[2]s
+}
+
+data "aws_partition" "current" {
+  provider = %[2]s
+}
+
+data "aws_region" "current" {
+  provider = %[2]s
+}
+
+resource "aws_dynamodb_table" "test" {
+  provider = %[2]s
+
+  name           = %[1]q
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = %[1]q
+
+  attribute {
+    name = %[1]q
+    type = "S"
+  }
+
+  point_in_time_recovery {
+    enabled = true
+  }
+}
+
+resource "aws_dynamodb_resource_policy" "test" {
+  provider = %[2]s
+
+  resource_arn = aws_dynamodb_table.test.arn
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "redshift.amazonaws.com"
+      }
+      Action = [
+        "dynamodb:ExportTableToPointInTime",
+        "dynamodb:DescribeTable",
+      ]
+      Resource = aws_dynamodb_table.test.arn
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+        ArnEquals = {
+          "aws:SourceArn" = "arn:${data.aws_partition.current.partition}:redshift:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:integration:*"
+        }
+      }
+      }, {
+      Effect = "Allow"
+      Principal = {
+        Service = "redshift.amazonaws.com"
+      }
+      Action   = "dynamodb:DescribeExport"
+      Resource = "${aws_dynamodb_table.test.arn}/export/*"
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+        ArnEquals = {
+          "aws:SourceArn" = "arn:${data.aws_partition.current.partition}:redshift:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:integration:*"
+        }
+      }
+    }]
+  })
+}
+`, rName, provider)
+}
+
+// testAccIntegrationConfig_baseWithProvider returns a DynamoDB table source and a Redshift Serverless namespace target,
+// with the resource policies that authorize the integration, managed by the specified provider.
+func testAccIntegrationConfig_baseWithProvider(rName, provider string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_baseSourceWithProvider(rName, provider), fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  provider = %[2]s
+
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  provider = %[2]s
+
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+  base_capacity  = 8
+
+  publicly_accessible = false
+
+  config_parameter {
+    parameter_key   = "auto_mv"
+    parameter_value = "true"
+  }
+  config_parameter {
+    parameter_key   = "datestyle"
+    parameter_value = "ISO, MDY"
+  }
+  config_parameter {
+    parameter_key   = "enable_case_sensitive_identifier"
+    parameter_value = "true"
+  }
+  config_parameter {
+    parameter_key   = "enable_user_activity_logging"
+    parameter_value = "true"
+  }
+  config_parameter {
+    parameter_key   = "max_query_execution_time"
+    parameter_value = "14400"
+  }
+  config_parameter {
+    parameter_key   = "query_group"
+    parameter_value = "default"
+  }
+  config_parameter {
+    parameter_key   = "require_ssl"
+    parameter_value = "true"
+  }
+  config_parameter {
+    parameter_key   = "search_path"
+    parameter_value = "$user, public"
+  }
+  config_parameter {
+    parameter_key   = "use_fips_ssl"
+    parameter_value = "false"
+  }
+}
+
+resource "aws_redshift_resource_policy" "test" {
+  provider = %[2]s
+
+  resource_arn = aws_redshiftserverless_namespace.test.arn
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "redshift:CreateInboundIntegration"
+      Resource = aws_redshiftserverless_namespace.test.arn
+      }, {
+      Effect = "Allow"
+      Principal = {
+        Service = "redshift.amazonaws.com"
+      }
+      Action   = "redshift:AuthorizeInboundIntegration"
+      Resource = aws_redshiftserverless_namespace.test.arn
+      Condition = {
+        StringEquals = {
+          "aws:SourceArn" = aws_dynamodb_table.test.arn
+        }
+      }
+    }]
+  })
+}
+`, rName, provider))
+}
+
+func testAccIntegrationConfig_base(rName string) string {
+	return testAccIntegrationConfig_baseWithProvider(rName, "aws")
+}
+
+func testAccIntegrationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = aws_dynamodb_table.test.arn
+  target_arn       = aws_redshiftserverless_namespace.test.arn
+
+  depends_on = [
+    aws_dynamodb_resource_policy.test,
+    aws_redshift_resource_policy.test,
+    aws_redshiftserverless_workgroup.test,
+  ]
+}
+`, rName))
+}
+
+func testAccIntegrationConfig_description(rName, integrationName, description string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  description      = %[2]q
+  source_arn       = aws_dynamodb_table.test.arn
+  target_arn       = aws_redshiftserverless_namespace.test.arn
+
+  depends_on = [
+    aws_dynamodb_resource_policy.test,
+    aws_redshift_resource_policy.test,
+    aws_redshiftserverless_workgroup.test,
+  ]
+}
+`, integrationName, description))
+}
+
+func testAccIntegrationConfig_skipDestroy(rName string, skipDestroy bool) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = aws_dynamodb_table.test.arn
+  target_arn       = aws_redshiftserverless_namespace.test.arn
+  skip_destroy     = %[2]t
+
+  depends_on = [
+    aws_dynamodb_resource_policy.test,
+    aws_redshift_resource_policy.test,
+    aws_redshiftserverless_workgroup.test,
+  ]
+}
+`, rName, skipDestroy))
+}
+
+func testAccIntegrationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = aws_dynamodb_table.test.arn
+  target_arn       = aws_redshiftserverless_namespace.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [
+    aws_dynamodb_resource_policy.test,
+    aws_redshift_resource_policy.test,
+    aws_redshiftserverless_workgroup.test,
+  ]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccIntegrationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = aws_dynamodb_table.test.arn
+  target_arn       = aws_redshiftserverless_namespace.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [
+    aws_dynamodb_resource_policy.test,
+    aws_redshift_resource_policy.test,
+    aws_redshiftserverless_workgroup.test,
+  ]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccIntegrationConfig_region(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), testAccIntegrationConfig_baseWithProvider(rName, "awsalternate"), fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  region = data.aws_region.current.name
+
+  integration_name = %[1]q
+  source_arn       = aws_dynamodb_table.test.arn
+  target_arn       = aws_redshiftserverless_namespace.test.arn
+
+  depends_on = [
+    aws_dynamodb_resource_policy.test,
+    aws_redshift_resource_policy.test,
+    aws_redshiftserverless_workgroup.test,
+  ]
+}
+`, rName))
+}
+
+func testAccIntegrationConfig_sourceAccount(rName, sourceAccountID, targetAccountID string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = "arn:${data.aws_partition.current.partition}:dynamodb:${data.aws_region.current.name}:%[2]s:table/%[1]s"
+  target_arn       = "arn:${data.aws_partition.current.partition}:redshift-serverless:${data.aws_region.current.name}:%[3]s:namespace/00000000-0000-0000-0000-000000000000"
+}
+`, rName, sourceAccountID, targetAccountID)
+}
+
+func testAccIntegrationConfig_deleteTargetDataOnDestroy(rName string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = aws_dynamodb_table.test.arn
+  target_arn       = aws_redshiftserverless_namespace.test.arn
+
+  delete_target_data_on_destroy = true
+  target_database_name          = "test_integration"
+  target_workgroup_name         = aws_redshiftserverless_workgroup.test.workgroup_name
+
+  depends_on = [
+    aws_dynamodb_resource_policy.test,
+    aws_redshift_resource_policy.test,
+  ]
+}
+
+resource "aws_redshiftdata_statement" "test" {
+  workgroup_name = aws_redshiftserverless_workgroup.test.workgroup_name
+  database       = "dev"
+  sql            = "CREATE DATABASE test_integration FROM INTEGRATION '${aws_redshift_integration.test.integration_id}'"
+}
+`, rName))
+}
+
+func testAccIntegrationConfig_deleteTargetDataOnDestroyTargetCluster(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = "arn:%[2]s:dynamodb:%[3]s:123456789012:table/%[1]s"
+  target_arn       = "arn:%[2]s:redshift:%[3]s:123456789012:namespace:00000000-0000-0000-0000-000000000000"
+
+  delete_target_data_on_destroy = true
+  target_database_name          = "test_integration"
+  target_workgroup_name         = %[1]q
+}
+`, rName, acctest.Partition(), acctest.Region())
+}
+
+func testAccIntegrationConfig_baseTargetCluster(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"),
+		testAccIntegrationConfig_baseSourceWithProvider(rName, "aws"),
+		fmt.Sprintf(`
+resource "aws_redshift_parameter_group" "test" {
+  name   = %[1]q
+  family = "redshift-1.0"
+
+  parameter {
+    name  = "enable_case_sensitive_identifier"
+    value = "true"
+  }
+}
+
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier                  = %[1]q
+  availability_zone                   = data.aws_availability_zones.available.names[0]
+  database_name                       = "mydb"
+  master_username                     = "foo_test"
+  master_password                     = "Mustbe8characters"
+  node_type                           = "ra3.xlplus"
+  cluster_parameter_group_name        = aws_redshift_parameter_group.test.name
+  automated_snapshot_retention_period = 1
+  allow_version_upgrade               = false
+  skip_final_snapshot                 = true
+  encrypted                           = true
+}
+
+resource "aws_redshift_resource_policy" "test" {
+  resource_arn = aws_redshift_cluster.test.cluster_namespace_arn
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "redshift:CreateInboundIntegration"
+      Resource = aws_redshift_cluster.test.cluster_namespace_arn
+      }, {
+      Effect = "Allow"
+      Principal = {
+        Service = "redshift.amazonaws.com"
+      }
+      Action   = "redshift:AuthorizeInboundIntegration"
+      Resource = aws_redshift_cluster.test.cluster_namespace_arn
+      Condition = {
+        StringEquals = {
+          "aws:SourceArn" = aws_dynamodb_table.test.arn
+        }
+      }
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccIntegrationConfig_targetCluster(rName string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_baseTargetCluster(rName), fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = aws_dynamodb_table.test.arn
+  target_arn       = aws_redshift_cluster.test.arn
+
+  depends_on = [
+    aws_dynamodb_resource_policy.test,
+    aws_redshift_resource_policy.test,
+  ]
+}
+`, rName))
+}
+
+func testAccIntegrationConfig_targetClusterNamespace(rName string) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_baseTargetCluster(rName), fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = aws_dynamodb_table.test.arn
+  target_arn       = aws_redshift_cluster.test.cluster_namespace_arn
+
+  depends_on = [
+    aws_dynamodb_resource_policy.test,
+    aws_redshift_resource_policy.test,
+  ]
+}
+`, rName))
+}
+
+func testAccIntegrationConfig_targetInvalid(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = "arn:%[2]s:dynamodb:%[3]s:123456789012:table/%[1]s"
+  target_arn       = "arn:%[2]s:redshift:%[3]s:123456789012:snapshot:%[1]s/%[1]s"
+}
+`, rName, acctest.Partition(), acctest.Region())
+}
+
+func testAccIntegrationConfig_kmsKey(rName string, keyIndex int, preventDestructiveKeyChange bool) string {
+	return acctest.ConfigCompose(testAccIntegrationConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  count = 2
+
+  description             = "%[1]s-${count.index}"
+  deletion_window_in_days = 7
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "kms:*"
+      Resource = "*"
+      }, {
+      Effect = "Allow"
+      Principal = {
+        Service = "redshift.amazonaws.com"
+      }
+      Action = [
+        "kms:Decrypt",
+        "kms:CreateGrant",
+      ]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = aws_dynamodb_table.test.arn
+  target_arn       = aws_redshiftserverless_namespace.test.arn
+  kms_key_id       = aws_kms_key.test[%[2]d].arn
+
+  prevent_destructive_key_change = %[3]t
+
+  depends_on = [
+    aws_dynamodb_resource_policy.test,
+    aws_redshift_resource_policy.test,
+    aws_redshiftserverless_workgroup.test,
+  ]
+}
+`, rName, keyIndex, preventDestructiveKeyChange))
+}
+
+func testAccIntegrationConfig_sourceInvalid(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_integration" "test" {
+  integration_name = %[1]q
+  source_arn       = "arn:%[2]s:rds:%[3]s:123456789012:cluster:%[1]s"
+  target_arn       = "arn:%[2]s:redshift-serverless:%[3]s:123456789012:namespace/00000000-0000-0000-0000-000000000000"
+}
+`, rName, acctest.Partition(), acctest.Region())
+}
@@ -30,6 +30,7 @@ import (
 )
 
 // @FrameworkResource("aws_redshift_logging", name="Logging")
+// @RegionOverride
 func newResourceLogging(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceLogging{}, nil
 }
@@ -69,6 +70,14 @@ func (r *resourceLogging) Schema(ctx context.Context, req resource.SchemaRequest
 					),
 				},
 			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			names.AttrS3KeyPrefix: schema.StringAttribute{
 				Optional: true,
 			},
@@ -269,6 +278,7 @@ type resourceLoggingData struct {
 	ID                 types.String                                    `tfsdk:"id"`
 	LogDestinationType fwtypes.StringEnum[awstypes.LogDestinationType] `tfsdk:"log_destination_type"`
 	LogExports         fwtypes.SetValueOf[types.String]                `tfsdk:"log_exports"`
+	Region             types.String                                    `tfsdk:"region"`
 	S3KeyPrefix        types.String                                    `tfsdk:"s3_key_prefix"`
 }
 
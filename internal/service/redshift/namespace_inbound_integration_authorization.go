@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Statement IDs of the resource policy statements managed by aws_redshift_namespace_inbound_integration_authorization.
+	inboundIntegrationAuthorizationSID = "TerraformAuthorizeInboundIntegration"
+	inboundIntegrationCreationSID      = "TerraformCreateInboundIntegration"
+)
+
+// @FrameworkResource("aws_redshift_namespace_inbound_integration_authorization", name="Namespace Inbound Integration Authorization")
+// @RegionOverride
+func newNamespaceInboundIntegrationAuthorizationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &namespaceInboundIntegrationAuthorizationResource{}, nil
+}
+
+type namespaceInboundIntegrationAuthorizationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *namespaceInboundIntegrationAuthorizationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorized_principals": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfARNType,
+				ElementType: fwtypes.ARNType,
+				Optional:    true,
+			},
+			"authorized_source_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfARNType,
+				ElementType: fwtypes.ARNType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"namespace_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *namespaceInboundIntegrationAuthorizationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data namespaceInboundIntegrationAuthorizationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftClient(ctx)
+
+	response.Diagnostics.Append(putNamespaceInboundIntegrationAuthorization(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(data.NamespaceARN.ValueString())
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *namespaceInboundIntegrationAuthorizationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data namespaceInboundIntegrationAuthorizationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftClient(ctx)
+
+	sourceARNs, principals, err := findNamespaceInboundIntegrationAuthorizationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Redshift Namespace Inbound Integration Authorization (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, principals, &data.AuthorizedPrincipals)...)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, sourceARNs, &data.AuthorizedSourceARNs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.NamespaceARN = fwtypes.ARNValue(data.ID.ValueString())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *namespaceInboundIntegrationAuthorizationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data namespaceInboundIntegrationAuthorizationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftClient(ctx)
+
+	response.Diagnostics.Append(putNamespaceInboundIntegrationAuthorization(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *namespaceInboundIntegrationAuthorizationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data namespaceInboundIntegrationAuthorizationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftClient(ctx)
+
+	namespaceARN := data.ID.ValueString()
+
+	conns.GlobalMutexKV.Lock(namespaceARN)
+	defer conns.GlobalMutexKV.Unlock(namespaceARN)
+
+	statements, err := findResourcePolicyStatementsByARN(ctx, conn, namespaceARN)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Redshift Resource Policy (%s)", namespaceARN), err.Error())
+
+		return
+	}
+
+	statements = slices.DeleteFunc(statements, isInboundIntegrationAuthorizationStatement)
+
+	if len(statements) == 0 {
+		input := redshift.DeleteResourcePolicyInput{
+			ResourceArn: aws.String(namespaceARN),
+		}
+		_, err = conn.DeleteResourcePolicy(ctx, &input)
+	} else {
+		err = putResourcePolicyStatements(ctx, conn, namespaceARN, statements)
+	}
+
+	if errs.IsA[*awstypes.ResourceNotFoundFault](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Redshift Namespace Inbound Integration Authorization (%s)", namespaceARN), err.Error())
+
+		return
+	}
+}
+
+// putNamespaceInboundIntegrationAuthorization replaces the statements managed by this resource in the namespace's resource policy.
+func putNamespaceInboundIntegrationAuthorization(ctx context.Context, conn *redshift.Client, data *namespaceInboundIntegrationAuthorizationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	namespaceARN := data.NamespaceARN.ValueString()
+
+	var sourceARNs, principals []string
+	diags.Append(fwflex.Expand(ctx, data.AuthorizedSourceARNs, &sourceARNs)...)
+	diags.Append(fwflex.Expand(ctx, data.AuthorizedPrincipals, &principals)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	conns.GlobalMutexKV.Lock(namespaceARN)
+	defer conns.GlobalMutexKV.Unlock(namespaceARN)
+
+	statements, err := findResourcePolicyStatementsByARN(ctx, conn, namespaceARN)
+
+	if err != nil && !tfresource.NotFound(err) {
+		diags.AddError(fmt.Sprintf("reading Redshift Resource Policy (%s)", namespaceARN), err.Error())
+
+		return diags
+	}
+
+	statements = slices.DeleteFunc(statements, isInboundIntegrationAuthorizationStatement)
+	statements = append(statements, map[string]any{
+		"Sid":    inboundIntegrationAuthorizationSID,
+		"Effect": "Allow",
+		"Principal": map[string]any{
+			"Service": "redshift.amazonaws.com",
+		},
+		"Action":   "redshift:AuthorizeInboundIntegration",
+		"Resource": namespaceARN,
+		"Condition": map[string]any{
+			"StringEquals": map[string]any{
+				"aws:SourceArn": sourceARNs,
+			},
+		},
+	})
+	if len(principals) > 0 {
+		statements = append(statements, map[string]any{
+			"Sid":    inboundIntegrationCreationSID,
+			"Effect": "Allow",
+			"Principal": map[string]any{
+				"AWS": principals,
+			},
+			"Action":   "redshift:CreateInboundIntegration",
+			"Resource": namespaceARN,
+		})
+	}
+
+	if err := putResourcePolicyStatements(ctx, conn, namespaceARN, statements); err != nil {
+		diags.AddError(fmt.Sprintf("setting Redshift Namespace Inbound Integration Authorization (%s)", namespaceARN), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+// findNamespaceInboundIntegrationAuthorizationByARN returns the authorized source ARNs and principals
+// from the statements managed by this resource in the namespace's resource policy.
+func findNamespaceInboundIntegrationAuthorizationByARN(ctx context.Context, conn *redshift.Client, arn string) ([]string, []string, error) {
+	statements, err := findResourcePolicyStatementsByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sourceARNs, principals []string
+	found := false
+
+	for _, statement := range statements {
+		switch statement["Sid"] {
+		case inboundIntegrationAuthorizationSID:
+			found = true
+			if condition, ok := statement["Condition"].(map[string]any); ok {
+				if stringEquals, ok := condition["StringEquals"].(map[string]any); ok {
+					sourceARNs = append(sourceARNs, policyStringOrSlice(stringEquals["aws:SourceArn"])...)
+				}
+			}
+		case inboundIntegrationCreationSID:
+			if principal, ok := statement["Principal"].(map[string]any); ok {
+				principals = append(principals, policyStringOrSlice(principal["AWS"])...)
+			}
+		}
+	}
+
+	if !found {
+		return nil, nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("statement %s not found in resource policy", inboundIntegrationAuthorizationSID),
+		}
+	}
+
+	return sourceARNs, principals, nil
+}
+
+// findResourcePolicyStatementsByARN returns the statements of the resource's policy as generic JSON objects,
+// so that statements not managed by Terraform are preserved when the policy is rewritten.
+func findResourcePolicyStatementsByARN(ctx context.Context, conn *redshift.Client, arn string) ([]map[string]any, error) {
+	output, err := findResourcePolicyByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	policy := aws.ToString(output.Policy)
+	if policy == "" {
+		return nil, &retry.NotFoundError{
+			Message: "empty resource policy",
+		}
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return nil, fmt.Errorf("parsing resource policy: %w", err)
+	}
+
+	var statements []map[string]any
+	switch v := document["Statement"].(type) {
+	case map[string]any:
+		statements = append(statements, v)
+	case []any:
+		for _, v := range v {
+			if v, ok := v.(map[string]any); ok {
+				statements = append(statements, v)
+			}
+		}
+	}
+
+	return statements, nil
+}
+
+func putResourcePolicyStatements(ctx context.Context, conn *redshift.Client, arn string, statements []map[string]any) error {
+	policy, err := json.Marshal(map[string]any{
+		"Version":   "2012-10-17",
+		"Statement": statements,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	input := &redshift.PutResourcePolicyInput{
+		Policy:      aws.String(string(policy)),
+		ResourceArn: aws.String(arn),
+	}
+
+	_, err = conn.PutResourcePolicy(ctx, input)
+
+	return err
+}
+
+func isInboundIntegrationAuthorizationStatement(statement map[string]any) bool {
+	sid, _ := statement["Sid"].(string)
+
+	return sid == inboundIntegrationAuthorizationSID || sid == inboundIntegrationCreationSID
+}
+
+func policyStringOrSlice(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	}
+
+	return nil
+}
+
+type namespaceInboundIntegrationAuthorizationResourceModel struct {
+	AuthorizedPrincipals fwtypes.SetOfARN `tfsdk:"authorized_principals"`
+	AuthorizedSourceARNs fwtypes.SetOfARN `tfsdk:"authorized_source_arns"`
+	ID                   types.String     `tfsdk:"id"`
+	NamespaceARN         fwtypes.ARN      `tfsdk:"namespace_arn"`
+	Region               types.String     `tfsdk:"region"`
+}
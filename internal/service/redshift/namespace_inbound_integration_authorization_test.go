@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftNamespaceInboundIntegrationAuthorization_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_redshift_namespace_inbound_integration_authorization.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNamespaceInboundIntegrationAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceInboundIntegrationAuthorizationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceInboundIntegrationAuthorizationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorized_principals.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "authorized_source_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "namespace_arn", "aws_redshift_cluster.test", "cluster_namespace_arn"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, acctest.Region()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNamespaceInboundIntegrationAuthorizationConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceInboundIntegrationAuthorizationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorized_principals.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authorized_source_arns.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftNamespaceInboundIntegrationAuthorization_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_redshift_namespace_inbound_integration_authorization.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNamespaceInboundIntegrationAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceInboundIntegrationAuthorizationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceInboundIntegrationAuthorizationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfredshift.ResourceNamespaceInboundIntegrationAuthorization, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNamespaceInboundIntegrationAuthorizationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_redshift_namespace_inbound_integration_authorization" {
+				continue
+			}
+
+			_, _, err := tfredshift.FindNamespaceInboundIntegrationAuthorizationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Redshift Namespace Inbound Integration Authorization %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNamespaceInboundIntegrationAuthorizationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftClient(ctx)
+
+		_, _, err := tfredshift.FindNamespaceInboundIntegrationAuthorizationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccNamespaceInboundIntegrationAuthorizationConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`)
+}
+
+func testAccNamespaceInboundIntegrationAuthorizationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccNamespaceInboundIntegrationAuthorizationConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshift_namespace_inbound_integration_authorization" "test" {
+  namespace_arn = aws_redshift_cluster.test.cluster_namespace_arn
+
+  authorized_source_arns = [
+    "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:cluster:%[1]s-1",
+  ]
+}
+`, rName))
+}
+
+func testAccNamespaceInboundIntegrationAuthorizationConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccNamespaceInboundIntegrationAuthorizationConfig_base(rName), fmt.Sprintf(`
+resource "aws_redshift_namespace_inbound_integration_authorization" "test" {
+  namespace_arn = aws_redshift_cluster.test.cluster_namespace_arn
+
+  authorized_principals = [
+    "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root",
+  ]
+
+  authorized_source_arns = [
+    "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:cluster:%[1]s-1",
+    "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:cluster:%[1]s-2",
+  ]
+}
+`, rName))
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// IsNotFoundError returns whether the API error indicates that a Redshift resource does not exist.
+// Resource Delete handlers that fail with such an error are treated as having succeeded.
+func (p *servicePackage) IsNotFoundError(_ context.Context, err error) bool {
+	return errs.IsA[*awstypes.IntegrationNotFoundFault](err)
+}
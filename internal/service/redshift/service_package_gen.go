@@ -32,24 +32,44 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
 		{
-			Factory:  newResourceDataShareAuthorization,
-			TypeName: "aws_redshift_data_share_authorization",
-			Name:     "Data Share Authorization",
+			Factory:        newResourceDataShareAuthorization,
+			TypeName:       "aws_redshift_data_share_authorization",
+			Name:           "Data Share Authorization",
+			RegionOverride: true,
 		},
 		{
-			Factory:  newResourceDataShareConsumerAssociation,
-			TypeName: "aws_redshift_data_share_consumer_association",
-			Name:     "Data Share Consumer Association",
+			Factory:        newResourceDataShareConsumerAssociation,
+			TypeName:       "aws_redshift_data_share_consumer_association",
+			Name:           "Data Share Consumer Association",
+			RegionOverride: true,
 		},
 		{
-			Factory:  newResourceLogging,
-			TypeName: "aws_redshift_logging",
-			Name:     "Logging",
+			Factory:  newIntegrationResource,
+			TypeName: "aws_redshift_integration",
+			Name:     "Integration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+			ComputedAccountID: true,
+			RegionOverride:    true,
+		},
+		{
+			Factory:        newResourceLogging,
+			TypeName:       "aws_redshift_logging",
+			Name:           "Logging",
+			RegionOverride: true,
+		},
+		{
+			Factory:        newNamespaceInboundIntegrationAuthorizationResource,
+			TypeName:       "aws_redshift_namespace_inbound_integration_authorization",
+			Name:           "Namespace Inbound Integration Authorization",
+			RegionOverride: true,
 		},
 		{
-			Factory:  newResourceSnapshotCopy,
-			TypeName: "aws_redshift_snapshot_copy",
-			Name:     "Snapshot Copy",
+			Factory:        newResourceSnapshotCopy,
+			TypeName:       "aws_redshift_snapshot_copy",
+			Name:           "Snapshot Copy",
+			RegionOverride: true,
 		},
 	}
 }
@@ -77,6 +97,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_redshift_service_account",
 			Name:     "Service Account",
 		},
+		{
+			Factory:  dataSourceSnapshotCopyGrant,
+			TypeName: "aws_redshift_snapshot_copy_grant",
+			Name:     "Snapshot Copy Grant",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  dataSourceSubnetGroup,
 			TypeName: "aws_redshift_subnet_group",
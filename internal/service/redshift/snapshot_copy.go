@@ -27,6 +27,7 @@ import (
 )
 
 // @FrameworkResource("aws_redshift_snapshot_copy", name="Snapshot Copy")
+// @RegionOverride
 func newResourceSnapshotCopy(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceSnapshotCopy{}, nil
 }
@@ -63,6 +64,14 @@ func (r *resourceSnapshotCopy) Schema(ctx context.Context, req resource.SchemaRe
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			names.AttrRetentionPeriod: schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
@@ -256,6 +265,7 @@ type resourceSnapshotCopyData struct {
 	ClusterIdentifier             types.String `tfsdk:"cluster_identifier"`
 	DestinationRegion             types.String `tfsdk:"destination_region"`
 	ManualSnapshotRetentionPeriod types.Int64  `tfsdk:"manual_snapshot_retention_period"`
+	Region                        types.String `tfsdk:"region"`
 	RetentionPeriod               types.Int64  `tfsdk:"retention_period"`
 	SnapshotCopyGrantName         types.String `tfsdk:"snapshot_copy_grant_name"`
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -165,22 +166,43 @@ func findSnapshotCopyGrantByName(ctx context.Context, conn *redshift.Client, nam
 		SnapshotCopyGrantName: aws.String(name),
 	}
 
-	output, err := conn.DescribeSnapshotCopyGrants(ctx, input)
+	return findSnapshotCopyGrant(ctx, conn, input, tfslices.PredicateTrue[*awstypes.SnapshotCopyGrant]())
+}
 
-	if errs.IsA[*awstypes.SnapshotCopyGrantNotFoundFault](err) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
-	}
+func findSnapshotCopyGrant(ctx context.Context, conn *redshift.Client, input *redshift.DescribeSnapshotCopyGrantsInput, filter tfslices.Predicate[*awstypes.SnapshotCopyGrant]) (*awstypes.SnapshotCopyGrant, error) {
+	output, err := findSnapshotCopyGrants(ctx, conn, input, filter)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if output == nil {
-		return nil, tfresource.NewEmptyResultError(input)
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findSnapshotCopyGrants(ctx context.Context, conn *redshift.Client, input *redshift.DescribeSnapshotCopyGrantsInput, filter tfslices.Predicate[*awstypes.SnapshotCopyGrant]) ([]awstypes.SnapshotCopyGrant, error) {
+	var output []awstypes.SnapshotCopyGrant
+
+	pages := redshift.NewDescribeSnapshotCopyGrantsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.SnapshotCopyGrantNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.SnapshotCopyGrants {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
 	}
 
-	return tfresource.AssertSingleValueResult(output.SnapshotCopyGrants)
+	return output, nil
 }
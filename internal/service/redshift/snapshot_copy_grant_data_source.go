@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_redshift_snapshot_copy_grant", name="Snapshot Copy Grant")
+// @Tags
+func dataSourceSnapshotCopyGrant() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSnapshotCopyGrantRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrKMSKeyID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_copy_grant_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceSnapshotCopyGrantRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
+
+	input := &redshift.DescribeSnapshotCopyGrantsInput{}
+
+	if v, ok := d.GetOk("snapshot_copy_grant_name"); ok {
+		input.SnapshotCopyGrantName = aws.String(v.(string))
+	}
+
+	tagsToMatch := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	// The API returns grants matching any of the keys or values, so results are filtered again below.
+	for k, v := range tagsToMatch.Map() {
+		input.TagKeys = append(input.TagKeys, k)
+		input.TagValues = append(input.TagValues, v)
+	}
+
+	grant, err := findSnapshotCopyGrant(ctx, conn, input, func(v *awstypes.SnapshotCopyGrant) bool {
+		return len(tagsToMatch) == 0 || keyValueTags(ctx, v.Tags).ContainsAll(tagsToMatch)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Redshift Snapshot Copy Grant", err))
+	}
+
+	d.SetId(aws.ToString(grant.SnapshotCopyGrantName))
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition(ctx),
+		Service:   names.Redshift,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
+		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
+		Resource:  fmt.Sprintf("snapshotcopygrant:%s", d.Id()),
+	}.String()
+	d.Set(names.AttrARN, arn)
+	d.Set(names.AttrKMSKeyID, grant.KmsKeyId)
+	d.Set("snapshot_copy_grant_name", grant.SnapshotCopyGrantName)
+
+	setTagsOut(ctx, grant.Tags)
+
+	return diags
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftSnapshotCopyGrantDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_redshift_snapshot_copy_grant.test"
+	resourceName := "aws_redshift_snapshot_copy_grant.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyGrantDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrKMSKeyID, resourceName, names.AttrKMSKeyID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshot_copy_grant_name", resourceName, "snapshot_copy_grant_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftSnapshotCopyGrantDataSource_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_redshift_snapshot_copy_grant.test"
+	resourceName := "aws_redshift_snapshot_copy_grant.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnapshotCopyGrantDataSourceConfig_tags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrKMSKeyID, resourceName, names.AttrKMSKeyID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshot_copy_grant_name", resourceName, "snapshot_copy_grant_name"),
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccSnapshotCopyGrantDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSnapshotCopyGrantConfig_basic(rName), `
+data "aws_redshift_snapshot_copy_grant" "test" {
+  snapshot_copy_grant_name = aws_redshift_snapshot_copy_grant.test.snapshot_copy_grant_name
+}
+`)
+}
+
+func testAccSnapshotCopyGrantDataSourceConfig_tags(rName string) string {
+	return acctest.ConfigCompose(testAccSnapshotCopyGrantConfig_tags2(rName, "Name", rName, "Environment", "test"), fmt.Sprintf(`
+data "aws_redshift_snapshot_copy_grant" "test" {
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_redshift_snapshot_copy_grant.test]
+}
+`, rName))
+}
@@ -92,6 +92,11 @@ func sweepClusterSnapshots(region string) error {
 		}
 
 		for _, v := range page.Snapshots {
+			if sweep.SkipByTags(keyValueTags(ctx, v.Tags).Map()) {
+				log.Printf("[INFO] Skipping Redshift Snapshot %s: missing sweep tag", aws.ToString(v.SnapshotIdentifier))
+				continue
+			}
+
 			r := resourceClusterSnapshot()
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.SnapshotIdentifier))
@@ -133,6 +138,11 @@ func sweepClusters(region string) error {
 		}
 
 		for _, v := range page.Clusters {
+			if sweep.SkipByTags(keyValueTags(ctx, v.Tags).Map()) {
+				log.Printf("[INFO] Skipping Redshift Cluster %s: missing sweep tag", aws.ToString(v.ClusterIdentifier))
+				continue
+			}
+
 			r := resourceCluster()
 			d := r.Data(nil)
 			d.Set("skip_final_snapshot", true)
@@ -175,6 +185,11 @@ func sweepEventSubscriptions(region string) error {
 		}
 
 		for _, v := range page.EventSubscriptionsList {
+			if sweep.SkipByTags(keyValueTags(ctx, v.Tags).Map()) {
+				log.Printf("[INFO] Skipping Redshift Event Subscription %s: missing sweep tag", aws.ToString(v.CustSubscriptionId))
+				continue
+			}
+
 			r := resourceEventSubscription()
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.CustSubscriptionId))
@@ -194,6 +209,12 @@ func sweepEventSubscriptions(region string) error {
 
 func sweepScheduledActions(region string) error {
 	ctx := sweep.Context(region)
+
+	if sweep.TagFilterEnabled() {
+		log.Printf("[WARN] Skipping Redshift Scheduled Action sweep for %s: resource does not support tags", region)
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
@@ -260,8 +281,13 @@ func sweepSnapshotSchedules(region string) error {
 		for _, v := range page.SnapshotSchedules {
 			id := aws.ToString(v.ScheduleIdentifier)
 
+			if sweep.SkipByTags(keyValueTags(ctx, v.Tags).Map()) {
+				log.Printf("[INFO] Skipping Redshift Snapshot Schedule %s: missing sweep tag", id)
+				continue
+			}
+
 			for _, prefix := range prefixesToSweep {
-				if !strings.HasPrefix(id, prefix) {
+				if strings.HasPrefix(id, prefix) {
 					r := resourceSnapshotSchedule()
 					d := r.Data(nil)
 					d.SetId(id)
@@ -314,6 +340,11 @@ func sweepSubnetGroups(region string) error {
 				continue
 			}
 
+			if sweep.SkipByTags(keyValueTags(ctx, v.Tags).Map()) {
+				log.Printf("[INFO] Skipping Redshift Subnet Group %s: missing sweep tag", name)
+				continue
+			}
+
 			r := resourceSubnetGroup()
 			d := r.Data(nil)
 			d.SetId(name)
@@ -357,6 +388,11 @@ func sweepHSMClientCertificates(region string) error {
 		}
 
 		for _, v := range page.HsmClientCertificates {
+			if sweep.SkipByTags(keyValueTags(ctx, v.Tags).Map()) {
+				log.Printf("[INFO] Skipping Redshift HSM Client Certificate %s: missing sweep tag", aws.ToString(v.HsmClientCertificateIdentifier))
+				continue
+			}
+
 			r := resourceHSMClientCertificate()
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.HsmClientCertificateIdentifier))
@@ -398,6 +434,11 @@ func sweepHSMConfigurations(region string) error {
 		}
 
 		for _, v := range page.HsmConfigurations {
+			if sweep.SkipByTags(keyValueTags(ctx, v.Tags).Map()) {
+				log.Printf("[INFO] Skipping Redshift HSM Client Configuration %s: missing sweep tag", aws.ToString(v.HsmConfigurationIdentifier))
+				continue
+			}
+
 			r := resourceHSMConfiguration()
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.HsmConfigurationIdentifier))
@@ -417,6 +458,12 @@ func sweepHSMConfigurations(region string) error {
 
 func sweepAuthenticationProfiles(region string) error {
 	ctx := sweep.Context(region)
+
+	if sweep.TagFilterEnabled() {
+		log.Printf("[WARN] Skipping Redshift Authentication Profile sweep for %s: resource does not support tags", region)
+		return nil
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
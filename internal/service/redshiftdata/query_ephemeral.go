@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftdata
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameQuery = "Ephemeral Resource Query"
+
+	queryTimeout = 10 * time.Minute
+)
+
+// @EphemeralResource("aws_redshiftdata_query", name="Query")
+func newEphemeralQuery(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralQuery{}, nil
+}
+
+type ephemeralQuery struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralQuery) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterIdentifier: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrDatabase: schema.StringAttribute{
+				Required: true,
+			},
+			"db_user": schema.StringAttribute{
+				Optional: true,
+			},
+			"has_result_set": schema.BoolAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"result_rows": schema.Int64Attribute{
+				Computed: true,
+			},
+			"secret_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"sql": schema.StringAttribute{
+				Required: true,
+			},
+			"statement_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"workgroup_name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrParameters: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sqlParameterModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *ephemeralQuery) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epQueryData
+	conn := e.Meta().RedshiftDataClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input redshiftdata.ExecuteStatementInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.ExecuteStatement(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RedshiftData, create.ErrActionOpening, ERNameQuery, data.Database.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	id := aws.ToString(output.Id)
+	statement, err := waitStatementFinished(ctx, conn, id, queryTimeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RedshiftData, create.ErrActionOpening, ERNameQuery, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.HasResultSet = fwflex.BoolToFramework(ctx, statement.HasResultSet)
+	data.ID = types.StringValue(id)
+	data.ResultRows = types.Int64Value(statement.ResultRows)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epQueryData struct {
+	ClusterIdentifier types.String                                       `tfsdk:"cluster_identifier"`
+	Database          types.String                                       `tfsdk:"database"`
+	DbUser            types.String                                       `tfsdk:"db_user"`
+	HasResultSet      types.Bool                                         `tfsdk:"has_result_set"`
+	ID                types.String                                       `tfsdk:"id"`
+	Parameters        fwtypes.ListNestedObjectValueOf[sqlParameterModel] `tfsdk:"parameters"`
+	ResultRows        types.Int64                                        `tfsdk:"result_rows"`
+	SecretARN         fwtypes.ARN                                        `tfsdk:"secret_arn"`
+	SQL               types.String                                       `tfsdk:"sql"`
+	StatementName     types.String                                       `tfsdk:"statement_name"`
+	WorkgroupName     types.String                                       `tfsdk:"workgroup_name"`
+}
+
+type sqlParameterModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
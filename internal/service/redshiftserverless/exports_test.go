@@ -5,19 +5,24 @@ package redshiftserverless
 
 // Exports for use in tests only.
 var (
-	ResourceCustomDomainAssociation = newCustomDomainAssociationResource
-	ResourceEndpointAccess          = resourceEndpointAccess
-	ResourceNamespace               = resourceNamespace
-	ResourceResourcePolicy          = resourceResourcePolicy
-	ResourceSnapshot                = resourceSnapshot
-	ResourceUsageLimit              = resourceUsageLimit
-	ResourceWorkgroup               = resourceWorkgroup
+	ResourceCustomDomainAssociation   = newCustomDomainAssociationResource
+	ResourceEndpointAccess            = resourceEndpointAccess
+	ResourceNamespace                 = resourceNamespace
+	ResourceNamespaceLogging          = newNamespaceLoggingResource
+	ResourceResourcePolicy            = resourceResourcePolicy
+	ResourceScheduledAction           = newScheduledActionResource
+	ResourceSnapshot                  = resourceSnapshot
+	ResourceSnapshotCopyConfiguration = newSnapshotCopyConfigurationResource
+	ResourceUsageLimit                = resourceUsageLimit
+	ResourceWorkgroup                 = resourceWorkgroup
 
 	FindCustomDomainAssociationByTwoPartKey = findCustomDomainAssociationByTwoPartKey
 	FindEndpointAccessByName                = findEndpointAccessByName
 	FindNamespaceByName                     = findNamespaceByName
 	FindResourcePolicyByARN                 = findResourcePolicyByARN
+	FindScheduledActionByName               = findScheduledActionByName
 	FindSnapshotByName                      = findSnapshotByName
+	FindSnapshotCopyConfigurationByID       = findSnapshotCopyConfigurationByID
 	FindUsageLimitByName                    = findUsageLimitByName
 	FindWorkgroupByName                     = findWorkgroupByName
 )
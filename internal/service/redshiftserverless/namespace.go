@@ -110,6 +110,7 @@ func resourceNamespace() *schema.Resource {
 			"log_exports": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
 					ValidateDiagFunc: enum.Validate[awstypes.LogExport](),
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_redshiftserverless_namespace_logging", name="Namespace Logging")
+func newNamespaceLoggingResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &namespaceLoggingResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type namespaceLoggingResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *namespaceLoggingResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"log_exports": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringEnumType[awstypes.LogExport](),
+				ElementType: fwtypes.StringEnumType[awstypes.LogExport](),
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"namespace_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *namespaceLoggingResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data namespaceLoggingResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	var logExports []awstypes.LogExport
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.LogExports, &logExports)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	namespaceName := data.NamespaceName.ValueString()
+	if err := updateNamespaceLogExports(ctx, conn, namespaceName, logExports, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Redshift Serverless Namespace Logging (%s)", namespaceName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(namespaceName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *namespaceLoggingResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data namespaceLoggingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	output, err := findNamespaceByName(ctx, conn, data.ID.ValueString())
+
+	if err == nil && len(output.LogExports) == 0 {
+		err = tfresource.NewEmptyResultError(data.ID.ValueString())
+	}
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Redshift Serverless Namespace Logging (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *namespaceLoggingResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new namespaceLoggingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	if !new.LogExports.Equal(old.LogExports) {
+		var logExports []awstypes.LogExport
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.LogExports, &logExports)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateNamespaceLogExports(ctx, conn, new.ID.ValueString(), logExports, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Redshift Serverless Namespace Logging (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *namespaceLoggingResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data namespaceLoggingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	err := updateNamespaceLogExports(ctx, conn, data.ID.ValueString(), []awstypes.LogExport{}, r.DeleteTimeout(ctx, data.Timeouts))
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Redshift Serverless Namespace Logging (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type namespaceLoggingResourceModel struct {
+	ID            types.String                                               `tfsdk:"id"`
+	LogExports    fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.LogExport]] `tfsdk:"log_exports"`
+	NamespaceName types.String                                               `tfsdk:"namespace_name"`
+	Timeouts      timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+func updateNamespaceLogExports(ctx context.Context, conn *redshiftserverless.Client, namespaceName string, logExports []awstypes.LogExport, timeout time.Duration) error {
+	input := &redshiftserverless.UpdateNamespaceInput{
+		LogExports:    logExports,
+		NamespaceName: aws.String(namespaceName),
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, timeout, func() (any, error) {
+		return conn.UpdateNamespace(ctx, input)
+	})
+
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		return err
+	}
+
+	if _, err := waitNamespaceLogExportsUpdated(ctx, conn, namespaceName, logExports, timeout); err != nil {
+		return fmt.Errorf("waiting for log exports update: %w", err)
+	}
+
+	return nil
+}
+
+func statusNamespaceLogExports(ctx context.Context, conn *redshiftserverless.Client, name string, logExports []awstypes.LogExport) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findNamespaceByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status != awstypes.NamespaceStatusAvailable {
+			return output, string(output.Status), nil
+		}
+
+		if want, got := itypes.Set[awstypes.LogExport](logExports), itypes.Set[awstypes.LogExport](output.LogExports); len(want.Difference(got)) > 0 || len(got.Difference(want)) > 0 {
+			return output, namespaceLogExportsStatusPending, nil
+		}
+
+		return output, namespaceLogExportsStatusUpdated, nil
+	}
+}
+
+const (
+	namespaceLogExportsStatusPending = "PENDING"
+	namespaceLogExportsStatusUpdated = "UPDATED"
+)
+
+func waitNamespaceLogExportsUpdated(ctx context.Context, conn *redshiftserverless.Client, name string, logExports []awstypes.LogExport, timeout time.Duration) (*awstypes.Namespace, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   append(enum.Slice(awstypes.NamespaceStatusModifying), namespaceLogExportsStatusPending),
+		Target:                    []string{namespaceLogExportsStatusUpdated},
+		Refresh:                   statusNamespaceLogExports(ctx, conn, name, logExports),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Namespace); ok {
+		return output, err
+	}
+
+	return nil, err
+}
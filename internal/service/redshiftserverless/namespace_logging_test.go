@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshiftserverless "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftServerlessNamespaceLogging_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_redshiftserverless_namespace_logging.test"
+	namespaceResourceName := "aws_redshiftserverless_namespace.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNamespaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceLoggingConfig_basic(rName, `"userlog"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNamespaceLoggingExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "namespace_name", namespaceResourceName, "namespace_name"),
+					resource.TestCheckResourceAttr(resourceName, "log_exports.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "log_exports.*", "userlog"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNamespaceLoggingConfig_basic(rName, `"connectionlog", "useractivitylog"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNamespaceLoggingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_exports.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "log_exports.*", "connectionlog"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "log_exports.*", "useractivitylog"),
+				),
+			},
+			{
+				Config: testAccNamespaceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNamespaceLoggingDestroy(ctx, namespaceResourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedshiftServerlessNamespaceLogging_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_redshiftserverless_namespace_logging.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNamespaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceLoggingConfig_basic(rName, `"userlog"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNamespaceLoggingExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfredshiftserverless.ResourceNamespaceLogging, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNamespaceLoggingDestroy(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessClient(ctx)
+
+		output, err := tfredshiftserverless.FindNamespaceByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.LogExports) > 0 {
+			return fmt.Errorf("Redshift Serverless Namespace Logging %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNamespaceLoggingExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessClient(ctx)
+
+		output, err := tfredshiftserverless.FindNamespaceByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.LogExports) == 0 {
+			return fmt.Errorf("Redshift Serverless Namespace Logging %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNamespaceLoggingConfig_basic(rName, logExports string) string {
+	return acctest.ConfigCompose(testAccNamespaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace_logging" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  log_exports    = [%[1]s]
+}
+`, logExports))
+}
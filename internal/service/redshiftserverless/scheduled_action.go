@@ -0,0 +1,426 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// scheduledActionAtTimeFormat is the format of the time in an "at(...)" schedule expression.
+	scheduledActionAtTimeFormat = "2006-01-02T15:04:05"
+)
+
+var (
+	reScheduledActionAt   = regexache.MustCompile(`^at\((\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2})\)$`)
+	reScheduledActionCron = regexache.MustCompile(`^cron(\(\S+( \S+){5}\))$`)
+)
+
+func init() {
+	fwflex.RegisterUnion[awstypes.TargetAction](
+		&awstypes.TargetActionMemberCreateSnapshot{},
+	)
+}
+
+// @FrameworkResource("aws_redshiftserverless_scheduled_action", name="Scheduled Action")
+func newScheduledActionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &scheduledActionResource{}, nil
+}
+
+type scheduledActionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *scheduledActionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrEnabled: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z-]{3,60}$`), "must be 3-60 lowercase alphanumeric characters or hyphens"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"namespace_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"next_invocations": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrSchedule: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.Any(
+						stringvalidator.RegexMatches(reScheduledActionAt, "must be an at expression, e.g. at(2026-01-01T00:00:00)"),
+						stringvalidator.RegexMatches(reScheduledActionCron, "must be a cron expression, e.g. cron(0 10 ? * MON *)"),
+					),
+				},
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.State](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"target_action": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[targetActionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"create_snapshot": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[createSnapshotScheduleActionParametersModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrRetentionPeriod: schema.Int32Attribute{
+										Optional: true,
+										Validators: []validator.Int32{
+											int32validator.Any(
+												int32validator.OneOf(-1),
+												int32validator.Between(1, 3653),
+											),
+										},
+									},
+									"snapshot_name_prefix": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 235),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *scheduledActionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data scheduledActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	name := data.Name.ValueString()
+	var input redshiftserverless.CreateScheduledActionInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix("ScheduledAction"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Schedule = expandSchedule(data.Schedule.ValueString())
+	setTargetActionNamespaceName(input.TargetAction, data.NamespaceName.ValueString())
+
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, namespaceUpdatedTimeout, func() (any, error) {
+		return conn.CreateScheduledAction(ctx, &input)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Redshift Serverless Scheduled Action (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	output := outputRaw.(*redshiftserverless.CreateScheduledActionOutput).ScheduledAction
+	data.ID = data.Name
+	data.NextInvocations = fwflex.FlattenFrameworkStringValueListOfString(ctx, flattenTimes(output.NextInvocations))
+	data.State = fwtypes.StringEnumValue(output.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *scheduledActionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data scheduledActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	output, err := findScheduledActionByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Redshift Serverless Scheduled Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("ScheduledAction"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Enabled = types.BoolValue(output.State == awstypes.StateActive)
+	data.NextInvocations = fwflex.FlattenFrameworkStringValueListOfString(ctx, flattenTimes(output.NextInvocations))
+	data.Schedule = types.StringValue(flattenSchedule(output.Schedule))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *scheduledActionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new scheduledActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("NextInvocations"), fwflex.WithIgnoredField("State"))
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input redshiftserverless.UpdateScheduledActionInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, append(diff.IgnoredFieldNamesOpts(), fwflex.WithFieldNamePrefix("ScheduledAction"))...)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ScheduledActionName = fwflex.StringFromFramework(ctx, new.ID)
+		if !new.Schedule.Equal(old.Schedule) {
+			input.Schedule = expandSchedule(new.Schedule.ValueString())
+		}
+		setTargetActionNamespaceName(input.TargetAction, new.NamespaceName.ValueString())
+
+		output, err := conn.UpdateScheduledAction(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Redshift Serverless Scheduled Action (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.NextInvocations = fwflex.FlattenFrameworkStringValueListOfString(ctx, flattenTimes(output.ScheduledAction.NextInvocations))
+		new.State = fwtypes.StringEnumValue(output.ScheduledAction.State)
+	} else {
+		new.NextInvocations = old.NextInvocations
+		new.State = old.State
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *scheduledActionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data scheduledActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RedshiftServerlessClient(ctx)
+
+	input := redshiftserverless.DeleteScheduledActionInput{
+		ScheduledActionName: fwflex.StringFromFramework(ctx, data.ID),
+	}
+	_, err := conn.DeleteScheduledAction(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Redshift Serverless Scheduled Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findScheduledActionByName(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.ScheduledActionResponse, error) {
+	input := &redshiftserverless.GetScheduledActionInput{
+		ScheduledActionName: aws.String(name),
+	}
+
+	output, err := conn.GetScheduledAction(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ScheduledAction == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ScheduledAction, nil
+}
+
+type scheduledActionResourceModel struct {
+	Description     types.String                                       `tfsdk:"description"`
+	Enabled         types.Bool                                         `tfsdk:"enabled"`
+	EndTime         timetypes.RFC3339                                  `tfsdk:"end_time"`
+	ID              types.String                                       `tfsdk:"id"`
+	Name            types.String                                       `tfsdk:"name"`
+	NamespaceName   types.String                                       `tfsdk:"namespace_name"`
+	NextInvocations fwtypes.ListOfString                               `tfsdk:"next_invocations" autoflex:"-"`
+	RoleARN         fwtypes.ARN                                        `tfsdk:"role_arn"`
+	Schedule        types.String                                       `tfsdk:"schedule" autoflex:"-"`
+	StartTime       timetypes.RFC3339                                  `tfsdk:"start_time"`
+	State           fwtypes.StringEnum[awstypes.State]                 `tfsdk:"state"`
+	TargetAction    fwtypes.ListNestedObjectValueOf[targetActionModel] `tfsdk:"target_action"`
+}
+
+func (data *scheduledActionResourceModel) InitFromID() error {
+	data.Name = data.ID
+
+	return nil
+}
+
+// targetActionModel models the TargetAction union.
+type targetActionModel struct {
+	CreateSnapshot fwtypes.ListNestedObjectValueOf[createSnapshotScheduleActionParametersModel] `tfsdk:"create_snapshot"`
+}
+
+// createSnapshotScheduleActionParametersModel omits NamespaceName, which is the scheduled action's namespace.
+type createSnapshotScheduleActionParametersModel struct {
+	RetentionPeriod    types.Int32  `tfsdk:"retention_period"`
+	SnapshotNamePrefix types.String `tfsdk:"snapshot_name_prefix"`
+}
+
+// setTargetActionNamespaceName sets the namespace acted on by the target action.
+func setTargetActionNamespaceName(apiObject awstypes.TargetAction, namespaceName string) {
+	switch v := apiObject.(type) {
+	case *awstypes.TargetActionMemberCreateSnapshot:
+		v.Value.NamespaceName = aws.String(namespaceName)
+	}
+}
+
+func expandSchedule(v string) awstypes.Schedule {
+	if m := reScheduledActionAt.FindStringSubmatch(v); m != nil {
+		t, _ := time.Parse(scheduledActionAtTimeFormat, m[1])
+
+		return &awstypes.ScheduleMemberAt{
+			Value: t,
+		}
+	}
+
+	// The API expects the cron expression without the "cron" prefix, e.g. "(0 10 ? * MON *)".
+	return &awstypes.ScheduleMemberCron{
+		Value: strings.TrimPrefix(v, "cron"),
+	}
+}
+
+func flattenSchedule(apiObject awstypes.Schedule) string {
+	switch v := apiObject.(type) {
+	case *awstypes.ScheduleMemberAt:
+		return fmt.Sprintf("at(%s)", v.Value.UTC().Format(scheduledActionAtTimeFormat))
+	case *awstypes.ScheduleMemberCron:
+		if strings.HasPrefix(v.Value, "cron") {
+			return v.Value
+		}
+
+		return "cron" + v.Value
+	}
+
+	return ""
+}
+
+func flattenTimes(apiObjects []time.Time) []string {
+	tfList := make([]string, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, apiObject.Format(time.RFC3339))
+	}
+
+	return tfList
+}
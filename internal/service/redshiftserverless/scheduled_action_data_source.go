@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_redshiftserverless_scheduled_action", name="Scheduled Action")
+func newScheduledActionDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &scheduledActionDataSource{}, nil
+}
+
+type scheduledActionDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *scheduledActionDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrEnabled: schema.BoolAttribute{
+				Computed: true,
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"namespace_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"next_invocations": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+			names.AttrSchedule: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.State](),
+				Computed:   true,
+			},
+			"target_action": framework.DataSourceComputedListOfObjectAttribute[targetActionModel](ctx),
+		},
+	}
+}
+
+func (d *scheduledActionDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data scheduledActionDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().RedshiftServerlessClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findScheduledActionByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Redshift Serverless Scheduled Action (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("ScheduledAction"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Enabled = types.BoolValue(output.State == awstypes.StateActive)
+	data.ID = types.StringValue(name)
+	data.NextInvocations = fwflex.FlattenFrameworkStringValueListOfString(ctx, flattenTimes(output.NextInvocations))
+	data.Schedule = types.StringValue(flattenSchedule(output.Schedule))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type scheduledActionDataSourceModel struct {
+	Description     types.String                                       `tfsdk:"description"`
+	Enabled         types.Bool                                         `tfsdk:"enabled"`
+	EndTime         timetypes.RFC3339                                  `tfsdk:"end_time"`
+	ID              types.String                                       `tfsdk:"id"`
+	Name            types.String                                       `tfsdk:"name"`
+	NamespaceName   types.String                                       `tfsdk:"namespace_name"`
+	NextInvocations fwtypes.ListOfString                               `tfsdk:"next_invocations" autoflex:"-"`
+	RoleARN         fwtypes.ARN                                        `tfsdk:"role_arn"`
+	Schedule        types.String                                       `tfsdk:"schedule" autoflex:"-"`
+	StartTime       timetypes.RFC3339                                  `tfsdk:"start_time"`
+	State           fwtypes.StringEnum[awstypes.State]                 `tfsdk:"state"`
+	TargetAction    fwtypes.ListNestedObjectValueOf[targetActionModel] `tfsdk:"target_action"`
+}
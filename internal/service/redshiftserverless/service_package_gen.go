@@ -15,7 +15,13 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newScheduledActionDataSource,
+			TypeName: "aws_redshiftserverless_scheduled_action",
+			Name:     "Scheduled Action",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -25,6 +31,21 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_redshiftserverless_custom_domain_association",
 			Name:     "Custom Domain Association",
 		},
+		{
+			Factory:  newNamespaceLoggingResource,
+			TypeName: "aws_redshiftserverless_namespace_logging",
+			Name:     "Namespace Logging",
+		},
+		{
+			Factory:  newScheduledActionResource,
+			TypeName: "aws_redshiftserverless_scheduled_action",
+			Name:     "Scheduled Action",
+		},
+		{
+			Factory:  newSnapshotCopyConfigurationResource,
+			TypeName: "aws_redshiftserverless_snapshot_copy_configuration",
+			Name:     "Snapshot Copy Configuration",
+		},
 	}
 }
 
@@ -40,6 +61,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_redshiftserverless_namespace",
 			Name:     "Namespace",
 		},
+		{
+			Factory:  dataSourceUsageLimits,
+			TypeName: "aws_redshiftserverless_usage_limits",
+			Name:     "Usage Limits",
+		},
 		{
 			Factory:  dataSourceWorkgroup,
 			TypeName: "aws_redshiftserverless_workgroup",
@@ -19,10 +19,16 @@ func RegisterSweepers() {
 		Name: "aws_redshiftserverless_namespace",
 		F:    sweepNamespaces,
 		Dependencies: []string{
+			"aws_redshiftserverless_snapshot_copy_configuration",
 			"aws_redshiftserverless_workgroup",
 		},
 	})
 
+	resource.AddTestSweepers("aws_redshiftserverless_snapshot_copy_configuration", &resource.Sweeper{
+		Name: "aws_redshiftserverless_snapshot_copy_configuration",
+		F:    sweepSnapshotCopyConfigurations,
+	})
+
 	resource.AddTestSweepers("aws_redshiftserverless_workgroup", &resource.Sweeper{
 		Name: "aws_redshiftserverless_workgroup",
 		F:    sweepWorkgroups,
@@ -156,3 +162,45 @@ func sweepSnapshots(region string) error {
 
 	return nil
 }
+
+func sweepSnapshotCopyConfigurations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.RedshiftServerlessClient(ctx)
+	input := &redshiftserverless.ListSnapshotCopyConfigurationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := redshiftserverless.NewListSnapshotCopyConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Redshift Serverless Snapshot Copy Configuration sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Redshift Serverless Snapshot Copy Configurations (%s): %w", region, err)
+		}
+
+		for _, v := range page.SnapshotCopyConfigurations {
+			r := resourceSnapshotCopyConfiguration()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.SnapshotCopyConfigurationId))
+			d.Set("namespace_name", v.NamespaceName)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Redshift Serverless Snapshot Copy Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
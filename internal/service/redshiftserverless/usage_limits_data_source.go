@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_redshiftserverless_usage_limits", name="Usage Limits")
+func dataSourceUsageLimits() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUsageLimitsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrResourceARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"usage_limits": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amount": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"breach_action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"period": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"usage_limit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"usage_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"usage_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.UsageLimitUsageType](),
+			},
+		},
+	}
+}
+
+func dataSourceUsageLimitsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
+
+	input := &redshiftserverless.ListUsageLimitsInput{}
+
+	if v, ok := d.GetOk(names.AttrResourceARN); ok {
+		input.ResourceArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("usage_type"); ok {
+		input.UsageType = awstypes.UsageLimitUsageType(v.(string))
+	}
+
+	usageLimits, err := findUsageLimits(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Serverless Usage Limits: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	if err := d.Set("usage_limits", flattenUsageLimits(usageLimits)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting usage_limits: %s", err)
+	}
+
+	return diags
+}
+
+func findUsageLimits(ctx context.Context, conn *redshiftserverless.Client, input *redshiftserverless.ListUsageLimitsInput) ([]awstypes.UsageLimit, error) {
+	var output []awstypes.UsageLimit
+
+	pages := redshiftserverless.NewListUsageLimitsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.UsageLimits...)
+	}
+
+	return output, nil
+}
+
+func flattenUsageLimits(apiObjects []awstypes.UsageLimit) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"amount":              aws.ToInt64(apiObject.Amount),
+			names.AttrARN:         aws.ToString(apiObject.UsageLimitArn),
+			"breach_action":       string(apiObject.BreachAction),
+			"period":              string(apiObject.Period),
+			names.AttrResourceARN: aws.ToString(apiObject.ResourceArn),
+			"usage_limit_id":      aws.ToString(apiObject.UsageLimitId),
+			"usage_type":          string(apiObject.UsageType),
+		})
+	}
+
+	return tfList
+}
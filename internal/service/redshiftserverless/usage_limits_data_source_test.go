@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftServerlessUsageLimitsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_redshiftserverless_usage_limits.test"
+	resourceName := "aws_redshiftserverless_usage_limit.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageLimitsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "usage_limits.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "usage_limits.0.amount", resourceName, "amount"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "usage_limits.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "usage_limits.0.breach_action", resourceName, "breach_action"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "usage_limits.0.period", resourceName, "period"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "usage_limits.0.resource_arn", resourceName, names.AttrResourceARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "usage_limits.0.usage_limit_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "usage_limits.0.usage_type", resourceName, "usage_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsageLimitsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccUsageLimitConfig_basic(rName, 60), `
+data "aws_redshiftserverless_usage_limits" "test" {
+  resource_arn = aws_redshiftserverless_usage_limit.test.resource_arn
+  usage_type   = "serverless-compute"
+}
+`)
+}
@@ -169,6 +169,27 @@ func resourceWorkgroup() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			"price_performance_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"level": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							// https://docs.aws.amazon.com/redshift-serverless/latest/APIReference/API_PerformanceTarget.html.
+							ValidateFunc: validation.IntInSlice([]int{1, 25, 50, 75, 100}),
+						},
+					},
+				},
+			},
 			names.AttrPubliclyAccessible: {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -189,6 +210,11 @@ func resourceWorkgroup() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"track_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"workgroup_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -235,6 +261,10 @@ func resourceWorkgroupCreate(ctx context.Context, d *schema.ResourceData, meta a
 		input.Port = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk("price_performance_target"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.PricePerformanceTarget = expandPerformanceTarget(v.([]any)[0].(map[string]any))
+	}
+
 	if v, ok := d.GetOk(names.AttrPubliclyAccessible); ok {
 		input.PubliclyAccessible = aws.Bool(v.(bool))
 	}
@@ -247,6 +277,10 @@ func resourceWorkgroupCreate(ctx context.Context, d *schema.ResourceData, meta a
 		input.SubnetIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("track_name"); ok {
+		input.TrackName = aws.String(v.(string))
+	}
+
 	output, err := conn.CreateWorkgroup(ctx, &input)
 
 	if err != nil {
@@ -290,9 +324,23 @@ func resourceWorkgroupRead(ctx context.Context, d *schema.ResourceData, meta any
 	d.Set(names.AttrMaxCapacity, out.MaxCapacity)
 	d.Set("namespace_name", out.NamespaceName)
 	d.Set(names.AttrPort, flattenEndpoint(out.Endpoint)[names.AttrPort])
+	if out.PricePerformanceTarget != nil {
+		if err := d.Set("price_performance_target", []any{flattenPerformanceTarget(out.PricePerformanceTarget)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting price_performance_target: %s", err)
+		}
+	} else {
+		d.Set("price_performance_target", nil)
+	}
 	d.Set(names.AttrPubliclyAccessible, out.PubliclyAccessible)
 	d.Set(names.AttrSecurityGroupIDs, flex.FlattenStringValueSet(out.SecurityGroupIds))
 	d.Set(names.AttrSubnetIDs, flex.FlattenStringValueSet(out.SubnetIds))
+	// A track change is applied during the workgroup's next maintenance window.
+	// Report the requested track so that the change isn't planned again in the meantime.
+	if v := aws.ToString(out.PendingTrackName); v != "" {
+		d.Set("track_name", v)
+	} else {
+		d.Set("track_name", out.TrackName)
+	}
 	d.Set("workgroup_id", out.WorkgroupId)
 	d.Set("workgroup_name", out.WorkgroupName)
 
@@ -395,6 +443,19 @@ func resourceWorkgroupUpdate(ctx context.Context, d *schema.ResourceData, meta a
 		}
 	}
 
+	if d.HasChange("price_performance_target") {
+		if v, ok := d.GetOk("price_performance_target"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			input := &redshiftserverless.UpdateWorkgroupInput{
+				PricePerformanceTarget: expandPerformanceTarget(v.([]any)[0].(map[string]any)),
+				WorkgroupName:          aws.String(d.Id()),
+			}
+
+			if err := updateWorkgroup(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	if d.HasChange(names.AttrPort) {
 		input := &redshiftserverless.UpdateWorkgroupInput{
 			Port:          aws.Int32(int32(d.Get(names.AttrPort).(int))),
@@ -439,6 +500,17 @@ func resourceWorkgroupUpdate(ctx context.Context, d *schema.ResourceData, meta a
 		}
 	}
 
+	if d.HasChange("track_name") {
+		input := &redshiftserverless.UpdateWorkgroupInput{
+			TrackName:     aws.String(d.Get("track_name").(string)),
+			WorkgroupName: aws.String(d.Id()),
+		}
+
+		if err := updateWorkgroup(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceWorkgroupRead(ctx, d, meta)...)
 }
 
@@ -640,6 +712,42 @@ func flattenConfigParameters(apiObjects []awstypes.ConfigParameter) []any {
 	return tfList
 }
 
+func expandPerformanceTarget(tfMap map[string]any) *awstypes.PerformanceTarget {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PerformanceTarget{
+		Status: awstypes.PerformanceTargetStatusDisabled,
+	}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok && v {
+		apiObject.Status = awstypes.PerformanceTargetStatusEnabled
+	}
+
+	if v, ok := tfMap["level"].(int); ok && v != 0 {
+		apiObject.Level = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenPerformanceTarget(apiObject *awstypes.PerformanceTarget) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		names.AttrEnabled: apiObject.Status == awstypes.PerformanceTargetStatusEnabled,
+	}
+
+	if v := apiObject.Level; v != nil {
+		tfMap["level"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
 func flattenEndpoint(apiObject *awstypes.Endpoint) map[string]any {
 	if apiObject == nil {
 		return nil
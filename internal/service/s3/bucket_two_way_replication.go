@@ -0,0 +1,497 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	bucketTwoWayReplicationIDPartCount = 2
+	// S3 Replication Time Control replicates objects within 15 minutes; 15 is the only supported value.
+	replicationTimeControlMinutes = 15
+)
+
+// @FrameworkResource("aws_s3_bucket_two_way_replication", name="Bucket Two-Way Replication")
+func newBucketTwoWayReplicationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bucketTwoWayReplicationResource{}
+
+	return r, nil
+}
+
+type bucketTwoWayReplicationResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *bucketTwoWayReplicationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"delete_marker_replication": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"peer_bucket": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"peer_region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrPrefix: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"replication_time_control": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrRole: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"role_policy": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *bucketTwoWayReplicationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bucketTwoWayReplicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket, peerBucket := data.Bucket.ValueString(), data.PeerBucket.ValueString()
+	id, err := flex.FlattenResourceId([]string{bucket, peerBucket}, bucketTwoWayReplicationIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating S3 Bucket Two-Way Replication", err.Error())
+
+		return
+	}
+
+	if data.PeerRegion.IsUnknown() {
+		region, err := findBucketRegion(ctx, r.Meta(), peerBucket)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket (%s) Region", peerBucket), err.Error())
+
+			return
+		}
+
+		data.PeerRegion = types.StringValue(region)
+	}
+
+	// If replication to the bucket from its peer can't be configured, remove replication from the bucket to its peer
+	// so that no half-configured replication is left behind.
+	rollback := func(ctx context.Context) error {
+		return deleteBucketReplication(ctx, conn, bucket)
+	}
+
+	if err := r.putReplicationConfigurations(ctx, conn, &data, rollback); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket Two-Way Replication (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+	data.RolePolicy, err = r.rolePolicy(ctx, bucket, peerBucket)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket Two-Way Replication (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketTwoWayReplicationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bucketTwoWayReplicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), bucketTwoWayReplicationIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket, peerBucket := parts[0], parts[1]
+	if data.PeerRegion.IsNull() {
+		region, err := findBucketRegion(ctx, r.Meta(), peerBucket)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket (%s) Region", peerBucket), err.Error())
+
+			return
+		}
+
+		data.PeerRegion = types.StringValue(region)
+	}
+
+	rule, rc, err := findTwoWayReplicationRule(ctx, conn, bucket, peerBucket)
+
+	if err == nil {
+		_, _, err = findTwoWayReplicationRule(ctx, conn, peerBucket, bucket, peerRegionOptFn(data.PeerRegion.ValueString()))
+	}
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket Two-Way Replication (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	data.Bucket = types.StringValue(bucket)
+	data.DeleteMarkerReplication = types.BoolValue(rule.DeleteMarkerReplication != nil && rule.DeleteMarkerReplication.Status == awstypes.DeleteMarkerReplicationStatusEnabled)
+	data.PeerBucket = types.StringValue(peerBucket)
+	if rule.Filter != nil {
+		data.Prefix = types.StringValue(aws.ToString(rule.Filter.Prefix))
+	}
+	data.ReplicationTimeControl = types.BoolValue(rule.Destination != nil && rule.Destination.ReplicationTime != nil && rule.Destination.ReplicationTime.Status == awstypes.ReplicationTimeStatusEnabled)
+	data.Role = fwtypes.ARNValue(aws.ToString(rc.Role))
+	data.RolePolicy, err = r.rolePolicy(ctx, bucket, peerBucket)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket Two-Way Replication (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketTwoWayReplicationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new bucketTwoWayReplicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	// If the peer bucket's replication can't be updated, restore the bucket's previous replication configuration.
+	rollback := func(ctx context.Context) error {
+		return putBucketReplication(ctx, conn, expandTwoWayReplicationConfiguration(ctx, r.Meta().Partition(ctx), &old, old.Bucket.ValueString(), old.PeerBucket.ValueString()))
+	}
+
+	if err := r.putReplicationConfigurations(ctx, conn, &new, rollback); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Bucket Two-Way Replication (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *bucketTwoWayReplicationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bucketTwoWayReplicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	err := errors.Join(
+		deleteBucketReplication(ctx, conn, data.PeerBucket.ValueString(), peerRegionOptFn(data.PeerRegion.ValueString())),
+		deleteBucketReplication(ctx, conn, data.Bucket.ValueString()),
+	)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket Two-Way Replication (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *bucketTwoWayReplicationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+// putReplicationConfigurations configures replication from the bucket to its peer and then from the peer to the bucket.
+// If the second step fails, rollback is called to undo the first.
+func (r *bucketTwoWayReplicationResource) putReplicationConfigurations(ctx context.Context, conn *s3.Client, data *bucketTwoWayReplicationResourceModel, rollback func(context.Context) error) error {
+	partition := r.Meta().Partition(ctx)
+	bucket, peerBucket := data.Bucket.ValueString(), data.PeerBucket.ValueString()
+
+	if err := putBucketReplication(ctx, conn, expandTwoWayReplicationConfiguration(ctx, partition, data, bucket, peerBucket)); err != nil {
+		return fmt.Errorf("putting S3 Bucket (%s) Replication Configuration: %w", bucket, err)
+	}
+
+	if err := putBucketReplication(ctx, conn, expandTwoWayReplicationConfiguration(ctx, partition, data, peerBucket, bucket), peerRegionOptFn(data.PeerRegion.ValueString())); err != nil {
+		err = fmt.Errorf("putting S3 Bucket (%s) Replication Configuration: %w", peerBucket, err)
+
+		if rollbackErr := rollback(ctx); rollbackErr != nil {
+			err = errors.Join(err, fmt.Errorf("rolling back S3 Bucket (%s) Replication Configuration: %w", bucket, rollbackErr))
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// rolePolicy returns an IAM policy document granting the permissions S3 needs to replicate objects in both directions between the buckets.
+func (r *bucketTwoWayReplicationResource) rolePolicy(ctx context.Context, bucket, peerBucket string) (types.String, error) {
+	partition := r.Meta().Partition(ctx)
+	bucketARNs := []string{bucketARN(partition, bucket), bucketARN(partition, peerBucket)}
+	objectARNs := []string{bucketARN(partition, bucket) + "/*", bucketARN(partition, peerBucket) + "/*"}
+
+	policy := map[string]any{
+		"Version": "2012-10-17",
+		"Statement": []any{
+			map[string]any{
+				"Effect":   "Allow",
+				"Action":   []string{"s3:GetReplicationConfiguration", "s3:ListBucket"},
+				"Resource": bucketARNs,
+			},
+			map[string]any{
+				"Effect":   "Allow",
+				"Action":   []string{"s3:GetObjectVersionForReplication", "s3:GetObjectVersionAcl", "s3:GetObjectVersionTagging"},
+				"Resource": objectARNs,
+			},
+			map[string]any{
+				"Effect":   "Allow",
+				"Action":   []string{"s3:ReplicateObject", "s3:ReplicateDelete", "s3:ReplicateTags"},
+				"Resource": objectARNs,
+			},
+		},
+	}
+
+	v, err := tfjson.EncodeToString(policy)
+
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	return types.StringValue(v), nil
+}
+
+func putBucketReplication(ctx context.Context, conn *s3.Client, input *s3.PutBucketReplicationInput, optFns ...func(*s3.Options)) error {
+	_, err := tfresource.RetryWhen(ctx, bucketPropagationTimeout,
+		func() (any, error) {
+			return conn.PutBucketReplication(ctx, input, optFns...)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) || tfawserr.ErrMessageContains(err, errCodeInvalidRequest, "Versioning must be 'Enabled' on the bucket") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	return err
+}
+
+func deleteBucketReplication(ctx context.Context, conn *s3.Client, bucket string, optFns ...func(*s3.Options)) error {
+	input := s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(bucket),
+	}
+	_, err := conn.DeleteBucketReplication(ctx, &input, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeReplicationConfigurationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting S3 Bucket (%s) Replication Configuration: %w", bucket, err)
+	}
+
+	return nil
+}
+
+// findTwoWayReplicationRule returns the replication rule, and the enclosing configuration, that replicates from the source to the destination bucket.
+func findTwoWayReplicationRule(ctx context.Context, conn *s3.Client, sourceBucket, destinationBucket string, optFns ...func(*s3.Options)) (*awstypes.ReplicationRule, *awstypes.ReplicationConfiguration, error) {
+	input := s3.GetBucketReplicationInput{
+		Bucket: aws.String(sourceBucket),
+	}
+	output, err := conn.GetBucketReplication(ctx, &input, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeReplicationConfigurationNotFound) {
+		return nil, nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if output == nil || output.ReplicationConfiguration == nil {
+		return nil, nil, tfresource.NewEmptyResultError(input)
+	}
+
+	id := twoWayReplicationRuleID(destinationBucket)
+	for _, rule := range output.ReplicationConfiguration.Rules {
+		if aws.ToString(rule.ID) == id {
+			return &rule, output.ReplicationConfiguration, nil
+		}
+	}
+
+	return nil, nil, &retry.NotFoundError{
+		Message: fmt.Sprintf("S3 Bucket (%s) replication rule (%s) not found", sourceBucket, id),
+	}
+}
+
+func expandTwoWayReplicationConfiguration(ctx context.Context, partition string, data *bucketTwoWayReplicationResourceModel, sourceBucket, destinationBucket string) *s3.PutBucketReplicationInput {
+	rule := awstypes.ReplicationRule{
+		DeleteMarkerReplication: &awstypes.DeleteMarkerReplication{
+			Status: awstypes.DeleteMarkerReplicationStatusDisabled,
+		},
+		Destination: &awstypes.Destination{
+			Bucket: aws.String(bucketARN(partition, destinationBucket)),
+		},
+		Filter: &awstypes.ReplicationRuleFilter{
+			Prefix: aws.String(data.Prefix.ValueString()),
+		},
+		ID:       aws.String(twoWayReplicationRuleID(destinationBucket)),
+		Priority: aws.Int32(0),
+		// Keep metadata changes made to replicas in sync in both directions.
+		SourceSelectionCriteria: &awstypes.SourceSelectionCriteria{
+			ReplicaModifications: &awstypes.ReplicaModifications{
+				Status: awstypes.ReplicaModificationsStatusEnabled,
+			},
+		},
+		Status: awstypes.ReplicationRuleStatusEnabled,
+	}
+
+	if data.DeleteMarkerReplication.ValueBool() {
+		rule.DeleteMarkerReplication.Status = awstypes.DeleteMarkerReplicationStatusEnabled
+	}
+
+	if data.ReplicationTimeControl.ValueBool() {
+		rule.Destination.Metrics = &awstypes.Metrics{
+			EventThreshold: &awstypes.ReplicationTimeValue{
+				Minutes: aws.Int32(replicationTimeControlMinutes),
+			},
+			Status: awstypes.MetricsStatusEnabled,
+		}
+		rule.Destination.ReplicationTime = &awstypes.ReplicationTime{
+			Status: awstypes.ReplicationTimeStatusEnabled,
+			Time: &awstypes.ReplicationTimeValue{
+				Minutes: aws.Int32(replicationTimeControlMinutes),
+			},
+		}
+	}
+
+	return &s3.PutBucketReplicationInput{
+		Bucket: aws.String(sourceBucket),
+		ReplicationConfiguration: &awstypes.ReplicationConfiguration{
+			Role:  fwflex.StringFromFramework(ctx, data.Role),
+			Rules: []awstypes.ReplicationRule{rule},
+		},
+	}
+}
+
+func twoWayReplicationRuleID(destinationBucket string) string {
+	return "replicate-to-" + destinationBucket
+}
+
+func bucketARN(partition, bucket string) string {
+	return arn.ARN{
+		Partition: partition,
+		Service:   "s3",
+		Resource:  bucket,
+	}.String()
+}
+
+func peerRegionOptFn(region string) func(*s3.Options) {
+	return func(o *s3.Options) {
+		o.Region = region
+	}
+}
+
+type bucketTwoWayReplicationResourceModel struct {
+	Bucket                  types.String `tfsdk:"bucket"`
+	DeleteMarkerReplication types.Bool   `tfsdk:"delete_marker_replication"`
+	ID                      types.String `tfsdk:"id"`
+	PeerBucket              types.String `tfsdk:"peer_bucket"`
+	PeerRegion              types.String `tfsdk:"peer_region"`
+	Prefix                  types.String `tfsdk:"prefix"`
+	ReplicationTimeControl  types.Bool   `tfsdk:"replication_time_control"`
+	Role                    fwtypes.ARN  `tfsdk:"role"`
+	RolePolicy              types.String `tfsdk:"role_policy"`
+}
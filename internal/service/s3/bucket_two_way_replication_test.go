@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketTwoWayReplication_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_two_way_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketTwoWayReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketTwoWayReplicationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketTwoWayReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "delete_marker_replication", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_bucket", "aws_s3_bucket.peer", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "peer_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPrefix, ""),
+					resource.TestCheckResourceAttr(resourceName, "replication_time_control", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRole, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "role_policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketTwoWayReplication_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_two_way_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketTwoWayReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketTwoWayReplicationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketTwoWayReplicationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketTwoWayReplication, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketTwoWayReplication_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_two_way_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketTwoWayReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketTwoWayReplicationConfig_full(rName, "logs/", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketTwoWayReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delete_marker_replication", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPrefix, "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "replication_time_control", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketTwoWayReplicationConfig_full(rName, "data/", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketTwoWayReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delete_marker_replication", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPrefix, "data/"),
+					resource.TestCheckResourceAttr(resourceName, "replication_time_control", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketTwoWayReplication_crossRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_two_way_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckBucketTwoWayReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketTwoWayReplicationConfig_crossRegion(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketTwoWayReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "peer_region", acctest.AlternateRegion()),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketTwoWayReplicationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_two_way_replication" {
+				continue
+			}
+
+			_, _, err := tfs3.FindTwoWayReplicationRule(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["peer_bucket"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Two-Way Replication %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketTwoWayReplicationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		bucket, peerBucket := rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["peer_bucket"]
+		if _, _, err := tfs3.FindTwoWayReplicationRule(ctx, conn, bucket, peerBucket); err != nil {
+			return err
+		}
+
+		_, _, err := tfs3.FindTwoWayReplicationRule(ctx, conn, peerBucket, bucket, func(o *s3.Options) {
+			o.Region = rs.Primary.Attributes["peer_region"]
+		})
+
+		return err
+	}
+}
+
+func testAccBucketTwoWayReplicationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "current" {
+  service_name = "s3"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = data.aws_service_principal.current.name
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name   = %[1]q
+  role   = aws_iam_role.test.id
+  policy = aws_s3_bucket_two_way_replication.test.role_policy
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = "%[1]s-a"
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+`, rName)
+}
+
+func testAccBucketTwoWayReplicationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketTwoWayReplicationConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "peer" {
+  bucket = "%[1]s-b"
+}
+
+resource "aws_s3_bucket_versioning" "peer" {
+  bucket = aws_s3_bucket.peer.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_two_way_replication" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.test,
+    aws_s3_bucket_versioning.peer,
+  ]
+
+  bucket      = aws_s3_bucket.test.bucket
+  peer_bucket = aws_s3_bucket.peer.bucket
+  role        = aws_iam_role.test.arn
+}
+`, rName))
+}
+
+func testAccBucketTwoWayReplicationConfig_full(rName, prefix string, enabled bool) string {
+	return acctest.ConfigCompose(testAccBucketTwoWayReplicationConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "peer" {
+  bucket = "%[1]s-b"
+}
+
+resource "aws_s3_bucket_versioning" "peer" {
+  bucket = aws_s3_bucket.peer.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_two_way_replication" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.test,
+    aws_s3_bucket_versioning.peer,
+  ]
+
+  bucket      = aws_s3_bucket.test.bucket
+  peer_bucket = aws_s3_bucket.peer.bucket
+  role        = aws_iam_role.test.arn
+
+  prefix                    = %[2]q
+  delete_marker_replication = %[3]t
+  replication_time_control  = %[3]t
+}
+`, rName, prefix, enabled))
+}
+
+func testAccBucketTwoWayReplicationConfig_crossRegion(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), testAccBucketTwoWayReplicationConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "peer" {
+  provider = "awsalternate"
+
+  bucket = "%[1]s-b"
+}
+
+resource "aws_s3_bucket_versioning" "peer" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.peer.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_two_way_replication" "test" {
+  depends_on = [
+    aws_s3_bucket_versioning.test,
+    aws_s3_bucket_versioning.peer,
+  ]
+
+  bucket      = aws_s3_bucket.test.bucket
+  peer_bucket = aws_s3_bucket.peer.bucket
+  peer_region = %[2]q
+  role        = aws_iam_role.test.arn
+}
+`, rName, acctest.AlternateRegion()))
+}
@@ -23,9 +23,11 @@ var (
 	ResourceBucketReplicationConfiguration          = resourceBucketReplicationConfiguration
 	ResourceBucketRequestPaymentConfiguration       = resourceBucketRequestPaymentConfiguration
 	ResourceBucketServerSideEncryptionConfiguration = resourceBucketServerSideEncryptionConfiguration
+	ResourceBucketTwoWayReplication                 = newBucketTwoWayReplicationResource
 	ResourceBucketVersioning                        = resourceBucketVersioning
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
+	ResourceMetadataTableConfiguration              = newMetadataTableConfigurationResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
 	BucketUpdateTags                      = bucketUpdateTags
@@ -47,6 +49,7 @@ var (
 	FindIntelligentTieringConfiguration   = findIntelligentTieringConfiguration
 	FindInventoryConfiguration            = findInventoryConfiguration
 	FindLoggingEnabled                    = findLoggingEnabled
+	FindMetadataTableConfiguration        = findMetadataTableConfiguration
 	FindMetricsConfiguration              = findMetricsConfiguration
 	FindObjectByBucketAndKey              = findObjectByBucketAndKey
 	FindObjectLockConfiguration           = findObjectLockConfiguration
@@ -54,6 +57,7 @@ var (
 	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
 	FindReplicationConfiguration          = findReplicationConfiguration
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
+	FindTwoWayReplicationRule             = findTwoWayReplicationRule
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
 	ObjectListTags                        = objectListTags
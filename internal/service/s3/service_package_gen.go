@@ -31,11 +31,21 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_s3_bucket_lifecycle_configuration",
 			Name:     "Bucket Lifecycle Configuration",
 		},
+		{
+			Factory:  newBucketTwoWayReplicationResource,
+			TypeName: "aws_s3_bucket_two_way_replication",
+			Name:     "Bucket Two-Way Replication",
+		},
 		{
 			Factory:  newDirectoryBucketResource,
 			TypeName: "aws_s3_directory_bucket",
 			Name:     "Directory Bucket",
 		},
+		{
+			Factory:  newMetadataTableConfigurationResource,
+			TypeName: "aws_s3_metadata_table_configuration",
+			Name:     "Metadata Table Configuration",
+		},
 	}
 }
 
@@ -27,6 +27,7 @@ var (
 	ResourceMonitoringSchedule                     = resourceMonitoringSchedule
 	ResourceNotebookInstance                       = resourceNotebookInstance
 	ResourceNotebookInstanceLifeCycleConfiguration = resourceNotebookInstanceLifeCycleConfiguration
+	ResourcePartnerApp                             = newPartnerAppResource
 	ResourcePipeline                               = resourcePipeline
 	ResourceProject                                = resourceProject
 	ResourceSpace                                  = resourceSpace
@@ -57,6 +58,7 @@ var (
 	FindMonitoringScheduleByName              = findMonitoringScheduleByName
 	FindNotebookInstanceByName                = findNotebookInstanceByName
 	FindNotebookInstanceLifecycleConfigByName = findNotebookInstanceLifecycleConfigByName
+	FindPartnerAppByARN                       = findPartnerAppByARN
 	FindPipelineByName                        = findPipelineByName
 	FindProjectByName                         = findProjectByName
 	FindServicecatalogPortfolioStatus         = findServicecatalogPortfolioStatus
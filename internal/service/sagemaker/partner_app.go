@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_sagemaker_partner_app", name="Partner App")
+// @Tags(identifierAttribute="arn")
+func newPartnerAppResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &partnerAppResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type partnerAppResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *partnerAppResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"auth_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PartnerAppAuthType](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.PartnerAppAuthTypeIam)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_iam_session_based_identity": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrExecutionRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9]+$`), "must contain only alphanumeric characters"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tier": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PartnerAppType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"application_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[partnerAppConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"admin_users": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"arguments": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"maintenance_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[partnerAppMaintenanceConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"maintenance_window_start": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *partnerAppResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data partnerAppResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SageMakerClient(ctx)
+
+	name := data.Name.ValueString()
+	var input sagemaker.CreatePartnerAppInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePartnerApp(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SageMaker AI Partner App (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.ID = data.ARN
+
+	app, err := waitPartnerAppCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SageMaker AI Partner App (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.BaseURL = fwflex.StringToFramework(ctx, app.BaseUrl)
+	data.Version = fwflex.StringToFramework(ctx, app.Version)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *partnerAppResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data partnerAppResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SageMakerClient(ctx)
+
+	output, err := findPartnerAppByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SageMaker AI Partner App (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// An unconfigured maintenance window is returned as an empty configuration.
+	if v := output.MaintenanceConfig; v != nil && v.MaintenanceWindowStart == nil {
+		output.MaintenanceConfig = nil
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *partnerAppResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new partnerAppResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SageMakerClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Version"))
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input sagemaker.UpdatePartnerAppInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, diff.IgnoredFieldNamesOpts()...)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Arn = fwflex.StringFromFramework(ctx, new.ID)
+		// Removing a configuration block clears the configuration.
+		if !new.ApplicationConfig.Equal(old.ApplicationConfig) && input.ApplicationConfig == nil {
+			input.ApplicationConfig = &awstypes.PartnerAppConfig{}
+		}
+		if !new.MaintenanceConfig.Equal(old.MaintenanceConfig) && input.MaintenanceConfig == nil {
+			input.MaintenanceConfig = &awstypes.PartnerAppMaintenanceConfig{}
+		}
+
+		_, err := conn.UpdatePartnerApp(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SageMaker AI Partner App (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		app, err := waitPartnerAppUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for SageMaker AI Partner App (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Version = fwflex.StringToFramework(ctx, app.Version)
+	} else {
+		new.Version = old.Version
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *partnerAppResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data partnerAppResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SageMakerClient(ctx)
+
+	input := sagemaker.DeletePartnerAppInput{
+		Arn: fwflex.StringFromFramework(ctx, data.ID),
+	}
+	_, err := conn.DeletePartnerApp(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFound](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SageMaker AI Partner App (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitPartnerAppDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SageMaker AI Partner App (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findPartnerAppByARN(ctx context.Context, conn *sagemaker.Client, arn string) (*sagemaker.DescribePartnerAppOutput, error) {
+	input := &sagemaker.DescribePartnerAppInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribePartnerApp(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Status; status == awstypes.PartnerAppStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+type partnerAppResourceModel struct {
+	ApplicationConfig             fwtypes.ListNestedObjectValueOf[partnerAppConfigModel]            `tfsdk:"application_config"`
+	ARN                           types.String                                                      `tfsdk:"arn"`
+	AuthType                      fwtypes.StringEnum[awstypes.PartnerAppAuthType]                   `tfsdk:"auth_type"`
+	BaseURL                       types.String                                                      `tfsdk:"base_url"`
+	EnableIAMSessionBasedIdentity types.Bool                                                        `tfsdk:"enable_iam_session_based_identity"`
+	ExecutionRoleARN              fwtypes.ARN                                                       `tfsdk:"execution_role_arn"`
+	ID                            types.String                                                      `tfsdk:"id"`
+	MaintenanceConfig             fwtypes.ListNestedObjectValueOf[partnerAppMaintenanceConfigModel] `tfsdk:"maintenance_config"`
+	Name                          types.String                                                      `tfsdk:"name"`
+	Tags                          tftags.Map                                                        `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                                        `tfsdk:"tags_all"`
+	Tier                          types.String                                                      `tfsdk:"tier"`
+	Timeouts                      timeouts.Value                                                    `tfsdk:"timeouts"`
+	Type                          fwtypes.StringEnum[awstypes.PartnerAppType]                       `tfsdk:"type"`
+	Version                       types.String                                                      `tfsdk:"version"`
+}
+
+type partnerAppConfigModel struct {
+	AdminUsers fwtypes.SetOfString `tfsdk:"admin_users"`
+	Arguments  fwtypes.MapOfString `tfsdk:"arguments"`
+}
+
+type partnerAppMaintenanceConfigModel struct {
+	MaintenanceWindowStart types.String `tfsdk:"maintenance_window_start"`
+}
@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsagemaker "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSageMakerPartnerApp_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v sagemaker.DescribePartnerAppOutput
+	rName := "tfacctest" + sdkacctest.RandString(8)
+	resourceName := "aws_sagemaker_partner_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnerAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnerAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "sagemaker", regexache.MustCompile(`partner-app/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "IAM"),
+					resource.TestCheckResourceAttrSet(resourceName, "base_url"),
+					resource.TestCheckResourceAttr(resourceName, "enable_iam_session_based_identity", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrExecutionRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "tier", "small"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "lakera-guard"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrVersion),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerPartnerApp_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v sagemaker.DescribePartnerAppOutput
+	rName := "tfacctest" + sdkacctest.RandString(8)
+	resourceName := "aws_sagemaker_partner_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnerAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnerAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsagemaker.ResourcePartnerApp, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerPartnerApp_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v sagemaker.DescribePartnerAppOutput
+	rName := "tfacctest" + sdkacctest.RandString(8)
+	resourceName := "aws_sagemaker_partner_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnerAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnerAppConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_config.#", "0"),
+				),
+			},
+			{
+				Config: testAccPartnerAppConfig_full(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_config.0.admin_users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enable_iam_session_based_identity", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_config.0.maintenance_window_start", "Sun:01:00"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerPartnerApp_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v sagemaker.DescribePartnerAppOutput
+	rName := "tfacctest" + sdkacctest.RandString(8)
+	resourceName := "aws_sagemaker_partner_app.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnerAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnerAppConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPartnerAppConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPartnerAppConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnerAppExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPartnerAppDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sagemaker_partner_app" {
+				continue
+			}
+
+			_, err := tfsagemaker.FindPartnerAppByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SageMaker AI Partner App %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPartnerAppExists(ctx context.Context, n string, v *sagemaker.DescribePartnerAppOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerClient(ctx)
+
+		output, err := tfsagemaker.FindPartnerAppByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPartnerAppConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "current" {
+  service_name = "sagemaker"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = data.aws_service_principal.current.name
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccPartnerAppConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPartnerAppConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_partner_app" "test" {
+  name               = %[1]q
+  type               = "lakera-guard"
+  tier               = "small"
+  execution_role_arn = aws_iam_role.test.arn
+}
+`, rName))
+}
+
+func testAccPartnerAppConfig_full(rName string) string {
+	return acctest.ConfigCompose(testAccPartnerAppConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_partner_app" "test" {
+  name               = %[1]q
+  type               = "lakera-guard"
+  tier               = "small"
+  execution_role_arn = aws_iam_role.test.arn
+
+  enable_iam_session_based_identity = true
+
+  application_config {
+    admin_users = [aws_iam_role.test.arn]
+  }
+
+  maintenance_config {
+    maintenance_window_start = "Sun:01:00"
+  }
+}
+`, rName))
+}
+
+func testAccPartnerAppConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPartnerAppConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_partner_app" "test" {
+  name               = %[1]q
+  type               = "lakera-guard"
+  tier               = "small"
+  execution_role_arn = aws_iam_role.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPartnerAppConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPartnerAppConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_partner_app" "test" {
+  name               = %[1]q
+  type               = "lakera-guard"
+  tier               = "small"
+  execution_role_arn = aws_iam_role.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -255,3 +255,19 @@ func statusHub(ctx context.Context, conn *sagemaker.Client, name string) retry.S
 		return output, string(output.HubStatus), nil
 	}
 }
+
+func statusPartnerApp(ctx context.Context, conn *sagemaker.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findPartnerAppByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
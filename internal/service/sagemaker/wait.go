@@ -746,3 +746,66 @@ func waitHubUpdated(ctx context.Context, conn *sagemaker.Client, name string) (*
 
 	return nil, err
 }
+
+func waitPartnerAppCreated(ctx context.Context, conn *sagemaker.Client, arn string, timeout time.Duration) (*sagemaker.DescribePartnerAppOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PartnerAppStatusCreating),
+		Target:  enum.Slice(awstypes.PartnerAppStatusAvailable),
+		Refresh: statusPartnerApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribePartnerAppOutput); ok {
+		if output.Status == awstypes.PartnerAppStatusFailed && output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPartnerAppUpdated(ctx context.Context, conn *sagemaker.Client, arn string, timeout time.Duration) (*sagemaker.DescribePartnerAppOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PartnerAppStatusUpdating),
+		Target:  enum.Slice(awstypes.PartnerAppStatusAvailable),
+		Refresh: statusPartnerApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribePartnerAppOutput); ok {
+		if output.Status == awstypes.PartnerAppStatusUpdateFailed && output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPartnerAppDeleted(ctx context.Context, conn *sagemaker.Client, arn string, timeout time.Duration) (*sagemaker.DescribePartnerAppOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PartnerAppStatusDeleting),
+		Target:  []string{},
+		Refresh: statusPartnerApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribePartnerAppOutput); ok {
+		if output.Status == awstypes.PartnerAppStatusFailed && output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -45,6 +45,10 @@ func resourceSecretRotation() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"next_rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"rotate_immediately": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -142,6 +146,11 @@ func resourceSecretRotationRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret Rotation (%s): %s", d.Id(), err)
 	}
 
+	if output.NextRotationDate != nil {
+		d.Set("next_rotation_date", aws.ToTime(output.NextRotationDate).Format(time.RFC3339))
+	} else {
+		d.Set("next_rotation_date", nil)
+	}
 	rotationEnabled := aws.ToBool(output.RotationEnabled)
 	d.Set("rotation_enabled", rotationEnabled)
 	if rotationEnabled {
@@ -192,9 +201,26 @@ func resourceSecretRotationDelete(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
 
+	secretID := d.Get("secret_id").(string)
+	output, err := findSecretByID(ctx, conn, secretID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret (%s): %s", secretID, err)
+	}
+
+	// Managed rotation of a secret owned by another service, e.g. RDS or Redshift admin credentials, can't be turned off.
+	if v := aws.ToString(output.OwningService); v != "" {
+		log.Printf("[WARN] Secrets Manager Secret (%s) is managed by %s, rotation remains enabled. Removing from state only", d.Id(), v)
+		return diags
+	}
+
 	log.Printf("[DEBUG] Deleting Secrets Manager Secret Rotation: %s", d.Id())
-	_, err := conn.CancelRotateSecret(ctx, &secretsmanager.CancelRotateSecretInput{
-		SecretId: aws.String(d.Get("secret_id").(string)),
+	_, err = conn.CancelRotateSecret(ctx, &secretsmanager.CancelRotateSecretInput{
+		SecretId: aws.String(secretID),
 	})
 
 	if err != nil {
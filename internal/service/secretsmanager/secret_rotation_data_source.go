@@ -5,6 +5,7 @@ package secretsmanager
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,6 +21,10 @@ func dataSourceSecretRotation() *schema.Resource {
 		ReadWithoutTimeout: dataSourceSecretRotationRead,
 
 		Schema: map[string]*schema.Schema{
+			"next_rotation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"rotation_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -68,6 +73,11 @@ func dataSourceSecretRotationRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	d.SetId(aws.ToString(output.ARN))
+	if output.NextRotationDate != nil {
+		d.Set("next_rotation_date", aws.ToTime(output.NextRotationDate).Format(time.RFC3339))
+	} else {
+		d.Set("next_rotation_date", nil)
+	}
 	d.Set("rotation_enabled", output.RotationEnabled)
 	d.Set("rotation_lambda_arn", output.RotationLambdaARN)
 	if err := d.Set("rotation_rules", flattenRotationRules(output.RotationRules)); err != nil {
@@ -32,6 +32,7 @@ func TestAccSecretsManagerSecretRotationDataSource_basic(t *testing.T) {
 			{
 				Config: testAccSecretRotationDataSourceConfig_default(rName, 7),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "next_rotation_date", resourceName, "next_rotation_date"),
 					resource.TestCheckResourceAttrPair(datasourceName, "rotation_enabled", resourceName, "rotation_enabled"),
 					resource.TestCheckResourceAttrPair(datasourceName, "rotation_lambda_arn", resourceName, "rotation_lambda_arn"),
 					resource.TestCheckResourceAttrPair(datasourceName, "rotation_rules.#", resourceName, "rotation_rules.#"),
@@ -5,6 +5,7 @@ package secretsmanager
 
 import (
 	"context"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
@@ -51,6 +52,11 @@ func (d *dataSourceSecretVersions) Schema(ctx context.Context, req datasource.Sc
 			"secret_id": schema.StringAttribute{
 				Required: true,
 			},
+			"version_stages": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"versions": schema.ListAttribute{
 				Computed:   true,
 				CustomType: fwtypes.NewListNestedObjectTypeOf[dsVersionsData](ctx),
@@ -69,8 +75,10 @@ func (d *dataSourceSecretVersions) Read(ctx context.Context, req datasource.Read
 	}
 
 	paginator := secretsmanager.NewListSecretVersionIdsPaginator(conn, &secretsmanager.ListSecretVersionIdsInput{
-		SecretId: data.SecretID.ValueStringPointer(),
+		IncludeDeprecated: data.IncludeDeprecated.ValueBoolPointer(),
+		SecretId:          data.SecretID.ValueStringPointer(),
 	})
+	stages := flex.ExpandFrameworkStringValueSet(ctx, data.VersionStages)
 
 	var out secretsmanager.ListSecretVersionIdsOutput
 	commonFieldsSet := false
@@ -90,7 +98,16 @@ func (d *dataSourceSecretVersions) Read(ctx context.Context, req datasource.Read
 				out.Name = page.Name
 				commonFieldsSet = true
 			}
-			out.Versions = append(out.Versions, page.Versions...)
+			for _, v := range page.Versions {
+				// If staging labels are specified, return only versions with at least one of them.
+				if len(stages) > 0 && !slices.ContainsFunc(v.VersionStages, func(stage string) bool {
+					return slices.Contains(stages, stage)
+				}) {
+					continue
+				}
+
+				out.Versions = append(out.Versions, v)
+			}
 		}
 	}
 
@@ -107,6 +124,7 @@ type dsSecretVersionsData struct {
 	Name              types.String                                    `tfsdk:"name"`
 	IncludeDeprecated types.Bool                                      `tfsdk:"include_deprecated"`
 	SecretID          types.String                                    `tfsdk:"secret_id"`
+	VersionStages     fwtypes.SetOfString                             `tfsdk:"version_stages"`
 	Versions          fwtypes.ListNestedObjectValueOf[dsVersionsData] `tfsdk:"versions"`
 }
 
@@ -64,6 +64,33 @@ func TestAccSecretsManagerSecretVersionsDataSource_emptyVer(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecretVersionsDataSource_versionStages(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_secretsmanager_secret_version.test"
+	dataSourceName := "data.aws_secretsmanager_secret_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionsDataSourceConfig_versionStages(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0.version_id", resource1Name, "version_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.version_stages.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.version_stages.0", "AWSPREVIOUS"),
+				),
+			},
+		},
+	})
+}
+
 func testAccSecretVersionsDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_secretsmanager_secret" "test" {
@@ -98,3 +125,28 @@ data "aws_secretsmanager_secret_versions" "test" {
 }
 `, rName)
 }
+
+func testAccSecretVersionsDataSourceConfig_versionStages(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+
+resource "aws_secretsmanager_secret_version" "test2" {
+  depends_on    = [aws_secretsmanager_secret_version.test]
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string2"
+}
+
+data "aws_secretsmanager_secret_versions" "test" {
+  depends_on     = [aws_secretsmanager_secret_version.test2]
+  secret_id      = aws_secretsmanager_secret.test.id
+  version_stages = ["AWSPREVIOUS"]
+}
+`, rName)
+}
@@ -7,12 +7,14 @@ package servicediscovery
 var (
 	ResourceHTTPNamespace       = resourceHTTPNamespace
 	ResourceInstance            = resourceInstance
+	ResourceInstances           = newInstancesResource
 	ResourcePrivateDNSNamespace = resourcePrivateDNSNamespace
 	ResourcePublicDNSNamespace  = resourcePublicDNSNamespace
 	ResourceService             = resourceService
 
-	FindInstanceByTwoPartKey = findInstanceByTwoPartKey
-	FindNamespaceByID        = findNamespaceByID
-	FindServiceByID          = findServiceByID
-	ValidNamespaceName       = validNamespaceName
+	FindInstanceByTwoPartKey         = findInstanceByTwoPartKey
+	FindInstanceSummariesByServiceID = findInstanceSummariesByServiceID
+	FindNamespaceByID                = findNamespaceByID
+	FindServiceByID                  = findServiceByID
+	ValidNamespaceName               = validNamespaceName
 )
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -50,6 +51,11 @@ func resourceInstance() *schema.Resource {
 					validation.MapValueMatch(regexache.MustCompile(`^([0-9A-Za-z!-~][0-9A-Za-z \t!-~]*){0,1}[0-9A-Za-z!-~]{0,1}$`), ""),
 				),
 			},
+			"custom_health_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.CustomHealthStatus](),
+			},
 			names.AttrInstanceID: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -73,25 +79,30 @@ func resourceInstancePut(ctx context.Context, d *schema.ResourceData, meta any)
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceDiscoveryClient(ctx)
 
-	instanceID := d.Get(names.AttrInstanceID).(string)
-	input := &servicediscovery.RegisterInstanceInput{
-		Attributes:       flex.ExpandStringValueMap(d.Get(names.AttrAttributes).(map[string]any)),
-		CreatorRequestId: aws.String(id.UniqueId()),
-		InstanceId:       aws.String(instanceID),
-		ServiceId:        aws.String(d.Get("service_id").(string)),
-	}
-
-	output, err := conn.RegisterInstance(ctx, input)
+	instanceID, serviceID := d.Get(names.AttrInstanceID).(string), d.Get("service_id").(string)
+	// Re-registering an instance resets its custom health status, so only do so when the attributes change.
+	registered := false
+	if d.HasChange(names.AttrAttributes) {
+		if err := registerInstance(ctx, conn, serviceID, instanceID, flex.ExpandStringValueMap(d.Get(names.AttrAttributes).(map[string]any))); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "registering Service Discovery Instance (%s): %s", instanceID, err)
+		registered = true
 	}
 
 	d.SetId(instanceID)
 
-	if output != nil && output.OperationId != nil {
-		if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Service Discovery Instance (%s) create: %s", d.Id(), err)
+	if v, ok := d.GetOk("custom_health_status"); ok && (registered || d.HasChange("custom_health_status")) {
+		input := &servicediscovery.UpdateInstanceCustomHealthStatusInput{
+			InstanceId: aws.String(instanceID),
+			ServiceId:  aws.String(serviceID),
+			Status:     awstypes.CustomHealthStatus(v.(string)),
+		}
+
+		_, err := conn.UpdateInstanceCustomHealthStatus(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Service Discovery Instance (%s) custom health status: %s", d.Id(), err)
 		}
 	}
 
@@ -124,6 +135,17 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 	d.Set(names.AttrAttributes, attributes)
 	d.Set(names.AttrInstanceID, instance.Id)
 
+	// Only services configured with a custom health check report a custom health status.
+	if _, ok := d.GetOk("custom_health_status"); ok {
+		status, err := findInstanceHealthStatusByTwoPartKey(ctx, conn, d.Get("service_id").(string), d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Service Discovery Instance (%s) health status: %s", d.Id(), err)
+		}
+
+		d.Set("custom_health_status", status)
+	}
+
 	return diags
 }
 
@@ -155,6 +177,29 @@ func resourceInstanceImport(ctx context.Context, d *schema.ResourceData, meta an
 	return []*schema.ResourceData{d}, nil
 }
 
+func registerInstance(ctx context.Context, conn *servicediscovery.Client, serviceID, instanceID string, attributes map[string]string) error {
+	input := &servicediscovery.RegisterInstanceInput{
+		Attributes:       attributes,
+		CreatorRequestId: aws.String(id.UniqueId()),
+		InstanceId:       aws.String(instanceID),
+		ServiceId:        aws.String(serviceID),
+	}
+
+	output, err := conn.RegisterInstance(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("registering Service Discovery Service (%s) Instance (%s): %w", serviceID, instanceID, err)
+	}
+
+	if output != nil && output.OperationId != nil {
+		if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId)); err != nil {
+			return fmt.Errorf("waiting for Service Discovery Service (%s) Instance (%s) create: %w", serviceID, instanceID, err)
+		}
+	}
+
+	return nil
+}
+
 func deregisterInstance(ctx context.Context, conn *servicediscovery.Client, serviceID, instanceID string) error {
 	input := &servicediscovery.DeregisterInstanceInput{
 		InstanceId: aws.String(instanceID),
@@ -202,3 +247,35 @@ func findInstanceByTwoPartKey(ctx context.Context, conn *servicediscovery.Client
 
 	return output.Instance, nil
 }
+
+func findInstanceHealthStatusByTwoPartKey(ctx context.Context, conn *servicediscovery.Client, serviceID, instanceID string) (awstypes.HealthStatus, error) {
+	input := &servicediscovery.GetInstancesHealthStatusInput{
+		Instances: []string{instanceID},
+		ServiceId: aws.String(serviceID),
+	}
+
+	output, err := conn.GetInstancesHealthStatus(ctx, input)
+
+	if errs.IsA[*awstypes.InstanceNotFound](err) || errs.IsA[*awstypes.ServiceNotFound](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	status, ok := output.Status[instanceID]
+
+	if !ok {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return status, nil
+}
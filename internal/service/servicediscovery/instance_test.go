@@ -154,6 +154,49 @@ func TestAccServiceDiscoveryInstance_http(t *testing.T) {
 	})
 }
 
+func TestAccServiceDiscoveryInstance_customHealthStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_service_discovery_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceDiscoveryEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceDiscoveryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_customHealthStatus(rName, domainName, "UNHEALTHY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_health_status", "UNHEALTHY"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "10.0.0.1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccInstanceImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"custom_health_status"},
+			},
+			{
+				Config: testAccInstanceConfig_customHealthStatus(rName, domainName, "HEALTHY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_health_status", "HEALTHY"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "10.0.0.1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -329,3 +372,20 @@ resource "aws_service_discovery_instance" "test" {
   }
 }`, instanceID, attributes)
 }
+
+func testAccInstanceConfig_customHealthStatus(rName, domainName, status string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_base(rName),
+		testAccInstanceConfig_privateNamespace(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_service_discovery_instance" "test" {
+  service_id           = aws_service_discovery_service.test.id
+  instance_id          = %[1]q
+  custom_health_status = %[2]q
+
+  attributes = {
+    AWS_INSTANCE_IPV4 = "10.0.0.1"
+  }
+}`, rName, status),
+	)
+}
@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicediscovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicediscovery/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_service_discovery_instances", name="Instances")
+func newInstancesResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &instancesResource{}, nil
+}
+
+const (
+	instancesDefaultMaxConcurrency = 10
+)
+
+type instancesResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *instancesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"max_concurrency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(instancesDefaultMaxConcurrency),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"instance": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[instanceModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrAttributes: schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Map{
+								mapvalidator.KeysAre(
+									stringvalidator.LengthBetween(1, 255),
+									stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z!-~]+$`), ""),
+								),
+								mapvalidator.ValueStringsAre(
+									stringvalidator.LengthBetween(0, 1024),
+									stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9A-Za-z!-~][0-9A-Za-z \t!-~]*){0,1}[0-9A-Za-z!-~]{0,1}$`), ""),
+								),
+							},
+						},
+						names.AttrInstanceID: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 64),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_/:.@-]+$`), ""),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *instancesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data instancesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ServiceDiscoveryClient(ctx)
+
+	serviceID := data.ServiceID.ValueString()
+	response.Diagnostics.Append(putInstances(ctx, conn, serviceID, int(data.MaxConcurrency.ValueInt64()), fwtypes.NewSetNestedObjectValueOfNull[instanceModel](ctx), data.Instances)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(serviceID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *instancesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data instancesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ServiceDiscoveryClient(ctx)
+
+	instances, err := findInstanceSummariesByServiceID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Service Discovery Instances (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Only track the instances managed by this resource, unless importing.
+	managed, diags := expandInstanceAttributes(ctx, data.Instances)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	instances = tfslices.Filter(instances, func(v awstypes.InstanceSummary) bool {
+		_, ok := managed[aws.ToString(v.Id)]
+		return len(managed) == 0 || ok
+	})
+
+	if len(instances) == 0 {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(tfresource.NewEmptyResultError(data.ID.ValueString())))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	for _, instance := range instances {
+		// https://docs.aws.amazon.com/cloud-map/latest/api/API_RegisterInstance.html#cloudmap-RegisterInstance-request-Attributes.
+		if _, ok := instance.Attributes["AWS_EC2_INSTANCE_ID"]; ok {
+			delete(instance.Attributes, "AWS_INSTANCE_IPV4")
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, instances, &data.Instances, fwflex.WithFieldNamePrefix("Instance"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ServiceID = data.ID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *instancesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new instancesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ServiceDiscoveryClient(ctx)
+
+	if !new.Instances.Equal(old.Instances) {
+		response.Diagnostics.Append(putInstances(ctx, conn, new.ServiceID.ValueString(), int(new.MaxConcurrency.ValueInt64()), old.Instances, new.Instances)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *instancesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data instancesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ServiceDiscoveryClient(ctx)
+
+	instances, diags := expandInstanceAttributes(ctx, data.Instances)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := data.ServiceID.ValueString()
+	if err := forEachInstance(ctx, int(data.MaxConcurrency.ValueInt64()), tfmaps.Keys(instances), func(ctx context.Context, instanceID string) error {
+		err := deregisterInstance(ctx, conn, serviceID, instanceID)
+
+		if errs.IsA[*awstypes.InstanceNotFound](err) || errs.IsA[*awstypes.ServiceNotFound](err) {
+			return nil
+		}
+
+		return err
+	}); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Service Discovery Instances (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *instancesResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("max_concurrency"), instancesDefaultMaxConcurrency)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("service_id"), request.ID)...)
+}
+
+// putInstances deregisters the instances removed from the resource and (re-)registers those added or changed.
+func putInstances(ctx context.Context, conn *servicediscovery.Client, serviceID string, maxConcurrency int, o, n fwtypes.SetNestedObjectValueOf[instanceModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	os, d := expandInstanceAttributes(ctx, o)
+	diags.Append(d...)
+	ns, d := expandInstanceAttributes(ctx, n)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var del []string
+	for instanceID := range os {
+		if _, ok := ns[instanceID]; !ok {
+			del = append(del, instanceID)
+		}
+	}
+
+	add := make(map[string]map[string]string)
+	for instanceID, attributes := range ns {
+		if v, ok := os[instanceID]; !ok || !maps.Equal(v, attributes) {
+			add[instanceID] = attributes
+		}
+	}
+
+	if err := forEachInstance(ctx, maxConcurrency, del, func(ctx context.Context, instanceID string) error {
+		err := deregisterInstance(ctx, conn, serviceID, instanceID)
+
+		if errs.IsA[*awstypes.InstanceNotFound](err) {
+			return nil
+		}
+
+		return err
+	}); err != nil {
+		diags.AddError(fmt.Sprintf("deregistering Service Discovery Instances (%s)", serviceID), err.Error())
+
+		return diags
+	}
+
+	if err := forEachInstance(ctx, maxConcurrency, tfmaps.Keys(add), func(ctx context.Context, instanceID string) error {
+		return registerInstance(ctx, conn, serviceID, instanceID, add[instanceID])
+	}); err != nil {
+		diags.AddError(fmt.Sprintf("registering Service Discovery Instances (%s)", serviceID), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+// forEachInstance calls f for each instance ID, with at most maxConcurrency calls in flight.
+// Cloud Map has no batch registration API, so bounding concurrency avoids throttling when
+// registering or deregistering many instances.
+func forEachInstance(ctx context.Context, maxConcurrency int, instanceIDs []string, f func(context.Context, string) error) error {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		errList []error
+	)
+
+	sem := make(chan struct{}, maxConcurrency)
+	for _, instanceID := range instanceIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f(ctx, instanceID); err != nil {
+				mu.Lock()
+				errList = append(errList, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errList...)
+}
+
+func findInstanceSummariesByServiceID(ctx context.Context, conn *servicediscovery.Client, serviceID string) ([]awstypes.InstanceSummary, error) {
+	input := &servicediscovery.ListInstancesInput{
+		ServiceId: aws.String(serviceID),
+	}
+	var output []awstypes.InstanceSummary
+
+	pages := servicediscovery.NewListInstancesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ServiceNotFound](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Instances...)
+	}
+
+	return output, nil
+}
+
+// expandInstanceAttributes returns the attributes of each instance keyed by instance ID.
+func expandInstanceAttributes(ctx context.Context, tfSet fwtypes.SetNestedObjectValueOf[instanceModel]) (map[string]map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	instances, d := tfSet.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObject := make(map[string]map[string]string, len(instances))
+	for _, instance := range instances {
+		var attributes map[string]string
+		diags.Append(fwflex.Expand(ctx, instance.Attributes, &attributes)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject[instance.InstanceID.ValueString()] = attributes
+	}
+
+	return apiObject, diags
+}
+
+type instancesResourceModel struct {
+	ID             types.String                                  `tfsdk:"id"`
+	Instances      fwtypes.SetNestedObjectValueOf[instanceModel] `tfsdk:"instance"`
+	MaxConcurrency types.Int64                                   `tfsdk:"max_concurrency"`
+	ServiceID      types.String                                  `tfsdk:"service_id"`
+}
+
+type instanceModel struct {
+	Attributes fwtypes.MapOfString `tfsdk:"attributes"`
+	InstanceID types.String        `tfsdk:"instance_id"`
+}
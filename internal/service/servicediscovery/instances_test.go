@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicediscovery_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicediscovery "github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceDiscoveryInstances_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_service_discovery_instances.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceDiscoveryEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceDiscoveryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstancesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesConfig_basic(rName, domainName, 25),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancesExists(ctx, resourceName, 25),
+					resource.TestCheckResourceAttr(resourceName, "instance.#", "25"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "10"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", "aws_service_discovery_service.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstancesConfig_basic(rName, domainName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancesExists(ctx, resourceName, 5),
+					resource.TestCheckResourceAttr(resourceName, "instance.#", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceDiscoveryInstances_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_service_discovery_instances.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceDiscoveryEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceDiscoveryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstancesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesConfig_basic(rName, domainName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancesExists(ctx, resourceName, 3),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfservicediscovery.ResourceInstances, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInstancesExists(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceDiscoveryClient(ctx)
+
+		output, err := tfservicediscovery.FindInstanceSummariesByServiceID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("Service Discovery Instances (%s) count = %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInstancesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceDiscoveryClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_service_discovery_instances" {
+				continue
+			}
+
+			output, err := tfservicediscovery.FindInstanceSummariesByServiceID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("Service Discovery Instances %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccInstancesConfig_basic(rName, domainName string, count int) string {
+	var instances strings.Builder
+	for i := range count {
+		fmt.Fprintf(&instances, `
+  instance {
+    instance_id = "%[1]s-%[2]d"
+
+    attributes = {
+      AWS_INSTANCE_IPV4 = "10.0.1.%[2]d"
+    }
+  }
+`, rName, i+1)
+	}
+
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_base(rName),
+		testAccInstanceConfig_privateNamespace(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_service_discovery_instances" "test" {
+  service_id = aws_service_discovery_service.test.id
+%[1]s}
+`, instances.String()))
+}
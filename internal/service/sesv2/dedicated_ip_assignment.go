@@ -30,6 +30,7 @@ func resourceDedicatedIPAssignment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDedicatedIPAssignmentCreate,
 		ReadWithoutTimeout:   resourceDedicatedIPAssignmentRead,
+		UpdateWithoutTimeout: resourceDedicatedIPAssignmentUpdate,
 		DeleteWithoutTimeout: resourceDedicatedIPAssignmentDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -38,6 +39,7 @@ func resourceDedicatedIPAssignment() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -45,7 +47,6 @@ func resourceDedicatedIPAssignment() *schema.Resource {
 			"destination_pool_name": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"ip": {
 				Type:         schema.TypeString,
@@ -53,6 +54,14 @@ func resourceDedicatedIPAssignment() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IsIPAddress,
 			},
+			"warmup_percentage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"warmup_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -106,10 +115,36 @@ func resourceDedicatedIPAssignmentRead(ctx context.Context, d *schema.ResourceDa
 
 	d.Set("destination_pool_name", out.PoolName)
 	d.Set("ip", out.Ip)
+	d.Set("warmup_percentage", out.WarmupPercentage)
+	d.Set("warmup_status", out.WarmupStatus)
 
 	return diags
 }
 
+func resourceDedicatedIPAssignmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	if d.HasChange("destination_pool_name") {
+		ip, destinationPoolName := d.Get("ip").(string), d.Get("destination_pool_name").(string)
+		input := &sesv2.PutDedicatedIpInPoolInput{
+			DestinationPoolName: aws.String(destinationPoolName),
+			Ip:                  aws.String(ip),
+		}
+
+		_, err := conn.PutDedicatedIpInPool(ctx, input)
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.SESV2, create.ErrActionUpdating, resNameDedicatedIPAssignment, d.Id(), err)
+		}
+
+		// The resource ID includes the pool name.
+		d.SetId(dedicatedIPAssignmentCreateResourceID(ip, destinationPoolName))
+	}
+
+	return append(diags, resourceDedicatedIPAssignmentRead(ctx, d, meta)...)
+}
+
 func resourceDedicatedIPAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
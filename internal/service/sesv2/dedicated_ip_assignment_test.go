@@ -11,6 +11,7 @@ import (
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -23,8 +24,9 @@ func TestAccSESV2DedicatedIPAssignment_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(t *testing.T){
-		acctest.CtBasic:      testAccSESV2DedicatedIPAssignment_basic,
-		acctest.CtDisappears: testAccSESV2DedicatedIPAssignment_disappears,
+		acctest.CtBasic:       testAccSESV2DedicatedIPAssignment_basic,
+		acctest.CtDisappears:  testAccSESV2DedicatedIPAssignment_disappears,
+		"destinationPoolName": testAccSESV2DedicatedIPAssignment_destinationPoolName,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -52,6 +54,7 @@ func testAccSESV2DedicatedIPAssignment_basic(t *testing.T) { // nosemgrep:ci.ses
 					testAccCheckDedicatedIPAssignmentExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "ip", ip),
 					resource.TestCheckResourceAttr(resourceName, "destination_pool_name", poolName),
+					resource.TestCheckResourceAttrSet(resourceName, "warmup_status"),
 				),
 			},
 			{
@@ -91,6 +94,51 @@ func testAccSESV2DedicatedIPAssignment_disappears(t *testing.T) { // nosemgrep:c
 	})
 }
 
+func testAccSESV2DedicatedIPAssignment_destinationPoolName(t *testing.T) { // nosemgrep:ci.sesv2-in-func-name
+	ctx := acctest.Context(t)
+	if os.Getenv("SES_DEDICATED_IP") == "" {
+		t.Skip("Environment variable SES_DEDICATED_IP is not set")
+	}
+
+	ip := os.Getenv("SES_DEDICATED_IP")
+	poolName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	poolName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_dedicated_ip_assignment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SESV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDedicatedIPAssignmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedIPAssignmentConfig_destinationPoolName(ip, poolName1, poolName2, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedIPAssignmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_pool_name", poolName1),
+				),
+			},
+			{
+				Config: testAccDedicatedIPAssignmentConfig_destinationPoolName(ip, poolName1, poolName2, "test2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDedicatedIPAssignmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_pool_name", poolName2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDedicatedIPAssignmentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Client(ctx)
@@ -144,3 +192,20 @@ resource "aws_sesv2_dedicated_ip_assignment" "test" {
 }
 `, ip, poolName)
 }
+
+func testAccDedicatedIPAssignmentConfig_destinationPoolName(ip, poolName1, poolName2, destinationPool string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_dedicated_ip_pool" "test1" {
+  pool_name = %[2]q
+}
+
+resource "aws_sesv2_dedicated_ip_pool" "test2" {
+  pool_name = %[3]q
+}
+
+resource "aws_sesv2_dedicated_ip_assignment" "test" {
+  ip                    = %[1]q
+  destination_pool_name = aws_sesv2_dedicated_ip_pool.%[4]s.pool_name
+}
+`, ip, poolName1, poolName2, destinationPool)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sesv2"
 	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -37,11 +38,36 @@ func resourceDedicatedIPPool() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		// Only migration from STANDARD to MANAGED is supported in-place.
+		CustomizeDiff: customdiff.ForceNewIfChange("scaling_mode", func(_ context.Context, old, new, meta any) bool {
+			return old.(string) != "" && (old.(string) != string(types.ScalingModeStandard) || new.(string) != string(types.ScalingModeManaged))
+		}),
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"dedicated_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"warmup_percentage": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"warmup_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"pool_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -51,7 +77,6 @@ func resourceDedicatedIPPool() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ForceNew:         true,
 				ValidateDiagFunc: enum.Validate[types.ScalingMode](),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
@@ -109,12 +134,32 @@ func resourceDedicatedIPPoolRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("pool_name", poolName)
 	d.Set("scaling_mode", out.ScalingMode)
 
+	outIP, err := findDedicatedIPsByPoolName(ctx, conn, poolName)
+	if err != nil {
+		return create.AppendDiagError(diags, names.SESV2, create.ErrActionReading, resNameDedicatedIPPool, d.Id(), err)
+	}
+	d.Set("dedicated_ips", flattenDedicatedIPs(outIP))
+
 	return diags
 }
 
 func resourceDedicatedIPPoolUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	// Tags only.
-	return resourceDedicatedIPPoolRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	if d.HasChange("scaling_mode") {
+		in := &sesv2.PutDedicatedIpPoolScalingAttributesInput{
+			PoolName:    aws.String(d.Id()),
+			ScalingMode: types.ScalingMode(d.Get("scaling_mode").(string)),
+		}
+
+		_, err := conn.PutDedicatedIpPoolScalingAttributes(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.SESV2, create.ErrActionUpdating, resNameDedicatedIPPool, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDedicatedIPPoolRead(ctx, d, meta)...)
 }
 
 func resourceDedicatedIPPoolDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
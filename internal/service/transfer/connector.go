@@ -110,6 +110,11 @@ func resourceConnector() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`^TransferSFTPConnectorSecurityPolicy-[A-Za-z0-9-]+$`), "must be in the format matching TransferSFTPConnectorSecurityPolicy-[A-Za-z0-9-]+"),
 				),
 			},
+			"service_managed_egress_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"sftp_config": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -205,6 +210,7 @@ func resourceConnectorRead(ctx context.Context, d *schema.ResourceData, meta any
 	d.Set("connector_id", output.ConnectorId)
 	d.Set("logging_role", output.LoggingRole)
 	d.Set("security_policy_name", output.SecurityPolicyName)
+	d.Set("service_managed_egress_ip_addresses", output.ServiceManagedEgressIpAddresses)
 	if err := d.Set("sftp_config", flattenSftpConnectorConfig(output.SftpConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting sftp_config: %s", err)
 	}
@@ -242,6 +248,11 @@ func resourceConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta a
 
 		if d.HasChange("sftp_config") {
 			input.SftpConfig = expandSftpConnectorConfig(d.Get("sftp_config").([]any))
+
+			// Removing all trusted host keys must be sent explicitly or the existing keys are retained.
+			if input.SftpConfig != nil && input.SftpConfig.TrustedHostKeys == nil && d.HasChange("sftp_config.0.trusted_host_keys") {
+				input.SftpConfig.TrustedHostKeys = []string{}
+			}
 		}
 
 		if d.HasChange(names.AttrURL) {
@@ -332,14 +343,16 @@ func expandSftpConnectorConfig(tfList []any) *awstypes.SftpConnectorConfig {
 
 	tfMap := tfList[0].(map[string]any)
 
-	apiObject := &awstypes.SftpConnectorConfig{
-		UserSecretId: aws.String(tfMap["user_secret_id"].(string)),
-	}
+	apiObject := &awstypes.SftpConnectorConfig{}
 
-	if v, ok := tfMap["trusted_host_keys"].(*schema.Set); ok && len(v.List()) > 0 {
+	if v, ok := tfMap["trusted_host_keys"].(*schema.Set); ok && v.Len() > 0 {
 		apiObject.TrustedHostKeys = flex.ExpandStringValueSet(v)
 	}
 
+	if v, ok := tfMap["user_secret_id"].(string); ok && v != "" {
+		apiObject.UserSecretId = aws.String(v)
+	}
+
 	return apiObject
 }
 
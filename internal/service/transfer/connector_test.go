@@ -84,6 +84,10 @@ func TestAccTransferConnector_sftpConfig(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckConnectorExists(ctx, resourceName, &conf),
 					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "transfer", "connector/{id}"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_managed_egress_ip_addresses.#"),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.0.trusted_host_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "sftp_config.0.trusted_host_keys.*", publicKey),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrURL, "sftp://s-fakeserver.server.transfer.test.amazonaws.com"),
 				),
@@ -97,6 +101,58 @@ func TestAccTransferConnector_sftpConfig(t *testing.T) {
 	})
 }
 
+func TestAccTransferConnector_sftpConfigTrustedHostKeys(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.DescribedConnector
+	resourceName := "aws_transfer_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	url := "sftp://s-fakeserver.server.transfer.test.amazonaws.com"
+	publicKey1 := "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDNt3kA/dBkS6ZyU/sVDiGMuWJQaRPmLNbs/25K/e/fIl07ZWUgqqsFkcycLLMNFGD30Cmgp6XCXfNlIjzFWhNam+4cBb4DPpvieUw44VgsHK5JQy3JKlUfglmH5rs4G5pLiVfZpFU6jqvTsu4mE1CHCP0sXJlJhGxMG3QbsqYWNKiqGFEhuzGMs6fQlMkNiXsFoDmh33HAcXCbaFSC7V7xIqT1hlKu0iOL+GNjMj4R3xy0o3jafhO4MG2s3TwCQQCyaa5oyjL8iP8p3L9yp6cbIcXaS72SIgbCSGCyrcQPIKP2lJJHvE1oVWzLVBhR4eSzrlFDv7K4IErzaJmHqdiz" // nosemgrep:ci.ssh-key
+	publicKey2 := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGm0oJ3kF5mPUBVu7/gsmYb4rLvp/DsYXBgYz8ZlmAvQ"                                                                                                                                                                                                                                                                                                             // nosemgrep:ci.ssh-key
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.TransferEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_sftpConfigTrustedHostKeys2(rName, url, publicKey1, publicKey2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.0.trusted_host_keys.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "sftp_config.0.trusted_host_keys.*", publicKey1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "sftp_config.0.trusted_host_keys.*", publicKey2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConnectorConfig_sftpConfig(rName, url, publicKey2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.0.trusted_host_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "sftp_config.0.trusted_host_keys.*", publicKey2),
+				),
+			},
+			{
+				Config: testAccConnectorConfig_sftpConfigNoTrustedHostKeys(rName, url),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.0.trusted_host_keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccTransferConnector_securityPolicyName(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.DescribedConnector
@@ -370,6 +426,43 @@ resource "aws_secretsmanager_secret" "test" {
 `, rName, url, publickey))
 }
 
+func testAccConnectorConfig_sftpConfigTrustedHostKeys2(rName, url, publickey1, publickey2 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(rName), fmt.Sprintf(`
+resource "aws_transfer_connector" "test" {
+  access_role = aws_iam_role.test.arn
+
+  sftp_config {
+    trusted_host_keys = [%[3]q, %[4]q]
+    user_secret_id    = aws_secretsmanager_secret.test.id
+  }
+
+  url = %[2]q
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+`, rName, url, publickey1, publickey2))
+}
+
+func testAccConnectorConfig_sftpConfigNoTrustedHostKeys(rName, url string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(rName), fmt.Sprintf(`
+resource "aws_transfer_connector" "test" {
+  access_role = aws_iam_role.test.arn
+
+  sftp_config {
+    user_secret_id = aws_secretsmanager_secret.test.id
+  }
+
+  url = %[2]q
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+`, rName, url))
+}
+
 func testAccConnectorConfig_tags1(rName, url, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccConnectorConfig_base(rName), fmt.Sprintf(`
 resource "aws_transfer_connector" "test" {
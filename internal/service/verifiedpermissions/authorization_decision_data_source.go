@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_verifiedpermissions_authorization_decision", name="Authorization Decision")
+func newDataSourceAuthorizationDecision(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceAuthorizationDecision{}, nil
+}
+
+const (
+	DSNameAuthorizationDecision = "Authorization Decision Data Source"
+)
+
+type dataSourceAuthorizationDecision struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceAuthorizationDecision) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	entityIdentifierBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[entityIdentifierModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"entity_id": schema.StringAttribute{
+					Required: true,
+				},
+				"entity_type": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"context_json": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			"decision": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Decision](),
+				Computed:   true,
+			},
+			"determining_policy_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"entities_json": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			"errors": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrAction: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[actionIdentifierModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"action_id": schema.StringAttribute{
+							Required: true,
+						},
+						"action_type": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrPrincipal: entityIdentifierBlock,
+			"resource":          entityIdentifierBlock,
+		},
+	}
+}
+
+func (d *dataSourceAuthorizationDecision) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().VerifiedPermissionsClient(ctx)
+
+	var data dataSourceAuthorizationDecisionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &verifiedpermissions.IsAuthorizedInput{
+		PolicyStoreId: fwflex.StringFromFramework(ctx, data.PolicyStoreID),
+	}
+
+	if !data.Action.IsNull() {
+		action, diags := data.Action.ToPtr(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		input.Action = &awstypes.ActionIdentifier{
+			ActionId:   fwflex.StringFromFramework(ctx, action.ActionID),
+			ActionType: fwflex.StringFromFramework(ctx, action.ActionType),
+		}
+	}
+
+	if !data.Principal.IsNull() {
+		principal, diags := data.Principal.ToPtr(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		input.Principal = &awstypes.EntityIdentifier{
+			EntityId:   fwflex.StringFromFramework(ctx, principal.EntityID),
+			EntityType: fwflex.StringFromFramework(ctx, principal.EntityType),
+		}
+	}
+
+	if !data.Resource.IsNull() {
+		res, diags := data.Resource.ToPtr(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		input.Resource = &awstypes.EntityIdentifier{
+			EntityId:   fwflex.StringFromFramework(ctx, res.EntityID),
+			EntityType: fwflex.StringFromFramework(ctx, res.EntityType),
+		}
+	}
+
+	if !data.ContextJSON.IsNull() {
+		input.Context = &awstypes.ContextDefinitionMemberCedarJson{
+			Value: data.ContextJSON.ValueString(),
+		}
+	}
+
+	if !data.EntitiesJSON.IsNull() {
+		input.Entities = &awstypes.EntitiesDefinitionMemberCedarJson{
+			Value: data.EntitiesJSON.ValueString(),
+		}
+	}
+
+	out, err := conn.IsAuthorized(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, DSNameAuthorizationDecision, data.PolicyStoreID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	var policyIDs, errors []string
+	for _, v := range out.DeterminingPolicies {
+		policyIDs = append(policyIDs, aws.ToString(v.PolicyId))
+	}
+	for _, v := range out.Errors {
+		errors = append(errors, aws.ToString(v.ErrorDescription))
+	}
+
+	data.Decision = fwtypes.StringEnumValue(out.Decision)
+	data.DeterminingPolicyIDs = fwflex.FlattenFrameworkStringValueListOfString(ctx, policyIDs)
+	data.Errors = fwflex.FlattenFrameworkStringValueListOfString(ctx, errors)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceAuthorizationDecisionData struct {
+	Action               fwtypes.ListNestedObjectValueOf[actionIdentifierModel] `tfsdk:"action"`
+	ContextJSON          jsontypes.Normalized                                   `tfsdk:"context_json"`
+	Decision             fwtypes.StringEnum[awstypes.Decision]                  `tfsdk:"decision"`
+	DeterminingPolicyIDs fwtypes.ListOfString                                   `tfsdk:"determining_policy_ids"`
+	EntitiesJSON         jsontypes.Normalized                                   `tfsdk:"entities_json"`
+	Errors               fwtypes.ListOfString                                   `tfsdk:"errors"`
+	PolicyStoreID        types.String                                           `tfsdk:"policy_store_id"`
+	Principal            fwtypes.ListNestedObjectValueOf[entityIdentifierModel] `tfsdk:"principal"`
+	Resource             fwtypes.ListNestedObjectValueOf[entityIdentifierModel] `tfsdk:"resource"`
+}
+
+type actionIdentifierModel struct {
+	ActionID   types.String `tfsdk:"action_id"`
+	ActionType types.String `tfsdk:"action_type"`
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsAuthorizationDecisionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	allowName := "data.aws_verifiedpermissions_authorization_decision.allow"
+	denyName := "data.aws_verifiedpermissions_authorization_decision.deny"
+	policyName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizationDecisionDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(allowName, "decision", "ALLOW"),
+					resource.TestCheckResourceAttr(allowName, "determining_policy_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(allowName, "determining_policy_ids.0", policyName, "policy_id"),
+					resource.TestCheckResourceAttr(denyName, "decision", "DENY"),
+					resource.TestCheckResourceAttr(denyName, "determining_policy_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAuthorizationDecisionDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPolicyConfig_basic(rName, "permit (principal == User::\"alice\", action == Action::\"view\", resource in Album::\"test_album\");"),
+		fmt.Sprintf(`
+data "aws_verifiedpermissions_authorization_decision" "allow" {
+  policy_store_id = aws_verifiedpermissions_policy.test.policy_store_id
+
+  principal {
+    entity_id   = "alice"
+    entity_type = "User"
+  }
+
+  action {
+    action_id   = "view"
+    action_type = "Action"
+  }
+
+  resource {
+    entity_id   = "photo.jpg"
+    entity_type = "Photo"
+  }
+
+  entities_json = jsonencode([{
+    uid     = { type = "Photo", id = "photo.jpg" }
+    attrs   = {}
+    parents = [{ type = "Album", id = "test_album" }]
+  }])
+}
+
+data "aws_verifiedpermissions_authorization_decision" "deny" {
+  policy_store_id = aws_verifiedpermissions_policy.test.policy_store_id
+
+  principal {
+    entity_id   = %[1]q
+    entity_type = "User"
+  }
+
+  action {
+    action_id   = "view"
+    action_type = "Action"
+  }
+
+  resource {
+    entity_id   = "photo.jpg"
+    entity_type = "Photo"
+  }
+}
+`, rName))
+}
@@ -16,6 +16,11 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newDataSourceAuthorizationDecision,
+			TypeName: "aws_verifiedpermissions_authorization_decision",
+			Name:     "Authorization Decision",
+		},
 		{
 			Factory:  newDataSourcePolicyStore,
 			TypeName: "aws_verifiedpermissions_policy_store",
@@ -51,6 +56,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_verifiedpermissions_schema",
 			Name:     "Schema",
 		},
+		{
+			Factory:  newResourceTemplateLinkedPolicies,
+			TypeName: "aws_verifiedpermissions_template_linked_policies",
+			Name:     "Template Linked Policies",
+		},
 	}
 }
 
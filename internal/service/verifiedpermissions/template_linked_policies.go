@@ -0,0 +1,459 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_verifiedpermissions_template_linked_policies", name="Template Linked Policies")
+func newResourceTemplateLinkedPolicies(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTemplateLinkedPolicies{}
+
+	return r, nil
+}
+
+const (
+	ResNameTemplateLinkedPolicies = "Template Linked Policies"
+)
+
+type resourceTemplateLinkedPolicies struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceTemplateLinkedPolicies) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	entityIdentifierBlock := schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[entityIdentifierModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"entity_id": schema.StringAttribute{
+					Required: true,
+				},
+				"entity_type": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policies": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[templateLinkedPolicyModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[templateLinkedPolicyModel](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_template_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrPrincipal: entityIdentifierBlock,
+			"resource":          entityIdentifierBlock,
+		},
+	}
+}
+
+func (r *resourceTemplateLinkedPolicies) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var plan resourceTemplateLinkedPoliciesData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	links, diags := plan.expandLinks(ctx)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = fwflex.StringValueToFramework(ctx, fmt.Sprintf("%s:%s", plan.PolicyStoreID.ValueString(), plan.PolicyTemplateID.ValueString()))
+
+	var policies []*templateLinkedPolicyModel
+	for _, link := range links {
+		policyID, err := createTemplateLinkedPolicy(ctx, conn, plan.PolicyStoreID.ValueString(), plan.PolicyTemplateID.ValueString(), link)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameTemplateLinkedPolicies, plan.ID.ValueString(), err),
+				err.Error(),
+			)
+
+			// Record the policies created so far so that they're cleaned up when the tainted resource is replaced.
+			plan.Policies = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, policies)
+			response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+			return
+		}
+
+		link.PolicyID = fwflex.StringValueToFramework(ctx, policyID)
+		policies = append(policies, link)
+	}
+
+	plan.Policies = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, policies)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceTemplateLinkedPolicies) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourceTemplateLinkedPoliciesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policies, diags := state.Policies.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Policies deleted outside Terraform are dropped here and recreated by the next apply.
+	var found []*templateLinkedPolicyModel
+	for _, policy := range policies {
+		_, err := findPolicyByID(ctx, conn, policy.PolicyID.ValueString(), state.PolicyStoreID.ValueString())
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNameTemplateLinkedPolicies, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		found = append(found, policy)
+	}
+
+	state.Policies = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, found)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceTemplateLinkedPolicies) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state, plan resourceTemplateLinkedPoliciesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	links, diags := plan.expandLinks(ctx)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	existing, diags := state.Policies.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	existingByKey := make(map[string]*templateLinkedPolicyModel, len(existing))
+	for _, v := range existing {
+		existingByKey[v.key()] = v
+	}
+
+	var policies []*templateLinkedPolicyModel
+	for _, link := range links {
+		if v, ok := existingByKey[link.key()]; ok {
+			delete(existingByKey, link.key())
+			policies = append(policies, v)
+			continue
+		}
+
+		policyID, err := createTemplateLinkedPolicy(ctx, conn, plan.PolicyStoreID.ValueString(), plan.PolicyTemplateID.ValueString(), link)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameTemplateLinkedPolicies, plan.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		link.PolicyID = fwflex.StringValueToFramework(ctx, policyID)
+		policies = append(policies, link)
+	}
+
+	for _, v := range existingByKey {
+		if err := deleteTemplateLinkedPolicy(ctx, conn, plan.PolicyStoreID.ValueString(), v.PolicyID.ValueString()); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameTemplateLinkedPolicies, plan.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.Policies = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, policies)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceTemplateLinkedPolicies) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourceTemplateLinkedPoliciesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policies, diags := state.Policies.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Verified Permissions Template Linked Policies", map[string]any{
+		names.AttrID: state.ID.ValueString(),
+	})
+
+	for _, policy := range policies {
+		if err := deleteTemplateLinkedPolicy(ctx, conn, state.PolicyStoreID.ValueString(), policy.PolicyID.ValueString()); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNameTemplateLinkedPolicies, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// ModifyPlan marks the linked policies as changing whenever the principal/resource combinations
+// in the plan no longer match the policies recorded in state, including policies deleted out of band.
+func (r *resourceTemplateLinkedPolicies) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state resourceTemplateLinkedPoliciesData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Principals.IsUnknown() || plan.Resources.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("policies"), fwtypes.NewListNestedObjectValueOfUnknown[templateLinkedPolicyModel](ctx))...)
+		return
+	}
+
+	links, diags := plan.expandLinks(ctx)
+	response.Diagnostics.Append(diags...)
+
+	existing, diags := state.Policies.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	keys := make(map[string]struct{}, len(existing))
+	for _, v := range existing {
+		keys[v.key()] = struct{}{}
+	}
+
+	changed := len(links) != len(keys)
+	for _, link := range links {
+		if _, ok := keys[link.key()]; !ok {
+			changed = true
+			break
+		}
+	}
+
+	if changed {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("policies"), fwtypes.NewListNestedObjectValueOfUnknown[templateLinkedPolicyModel](ctx))...)
+	}
+}
+
+func createTemplateLinkedPolicy(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyTemplateID string, link *templateLinkedPolicyModel) (string, error) {
+	value := awstypes.TemplateLinkedPolicyDefinition{
+		PolicyTemplateId: aws.String(policyTemplateID),
+	}
+
+	if !link.PrincipalEntityID.IsNull() {
+		value.Principal = &awstypes.EntityIdentifier{
+			EntityId:   fwflex.StringFromFramework(ctx, link.PrincipalEntityID),
+			EntityType: fwflex.StringFromFramework(ctx, link.PrincipalEntityType),
+		}
+	}
+
+	if !link.ResourceEntityID.IsNull() {
+		value.Resource = &awstypes.EntityIdentifier{
+			EntityId:   fwflex.StringFromFramework(ctx, link.ResourceEntityID),
+			EntityType: fwflex.StringFromFramework(ctx, link.ResourceEntityType),
+		}
+	}
+
+	input := &verifiedpermissions.CreatePolicyInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		Definition:    &awstypes.PolicyDefinitionMemberTemplateLinked{Value: value},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("linking policy (%s): %w", link.key(), err)
+	}
+
+	return aws.ToString(output.PolicyId), nil
+}
+
+func deleteTemplateLinkedPolicy(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyID string) error {
+	input := &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	_, err := conn.DeletePolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting policy (%s): %w", policyID, err)
+	}
+
+	return nil
+}
+
+type resourceTemplateLinkedPoliciesData struct {
+	ID               types.String                                               `tfsdk:"id"`
+	Policies         fwtypes.ListNestedObjectValueOf[templateLinkedPolicyModel] `tfsdk:"policies"`
+	PolicyStoreID    types.String                                               `tfsdk:"policy_store_id"`
+	PolicyTemplateID types.String                                               `tfsdk:"policy_template_id"`
+	Principals       fwtypes.SetNestedObjectValueOf[entityIdentifierModel]      `tfsdk:"principal"`
+	Resources        fwtypes.SetNestedObjectValueOf[entityIdentifierModel]      `tfsdk:"resource"`
+}
+
+// expandLinks returns one template-linked policy for every combination of the configured principals and resources.
+// A template that only has a principal (or resource) placeholder is linked once per principal (or resource).
+func (data *resourceTemplateLinkedPoliciesData) expandLinks(ctx context.Context) ([]*templateLinkedPolicyModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	principals, d := data.Principals.ToSlice(ctx)
+	diags.Append(d...)
+	resources, d := data.Resources.ToSlice(ctx)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(principals) == 0 {
+		principals = []*entityIdentifierModel{nil}
+	}
+	if len(resources) == 0 {
+		resources = []*entityIdentifierModel{nil}
+	}
+
+	var links []*templateLinkedPolicyModel
+	for _, principal := range principals {
+		for _, resource := range resources {
+			if principal == nil && resource == nil {
+				continue
+			}
+
+			link := &templateLinkedPolicyModel{
+				PolicyID:            types.StringNull(),
+				PrincipalEntityID:   types.StringNull(),
+				PrincipalEntityType: types.StringNull(),
+				ResourceEntityID:    types.StringNull(),
+				ResourceEntityType:  types.StringNull(),
+			}
+			if principal != nil {
+				link.PrincipalEntityID = principal.EntityID
+				link.PrincipalEntityType = principal.EntityType
+			}
+			if resource != nil {
+				link.ResourceEntityID = resource.EntityID
+				link.ResourceEntityType = resource.EntityType
+			}
+			links = append(links, link)
+		}
+	}
+
+	return links, diags
+}
+
+type entityIdentifierModel struct {
+	EntityID   types.String `tfsdk:"entity_id"`
+	EntityType types.String `tfsdk:"entity_type"`
+}
+
+type templateLinkedPolicyModel struct {
+	PolicyID            types.String `tfsdk:"policy_id"`
+	PrincipalEntityID   types.String `tfsdk:"principal_entity_id"`
+	PrincipalEntityType types.String `tfsdk:"principal_entity_type"`
+	ResourceEntityID    types.String `tfsdk:"resource_entity_id"`
+	ResourceEntityType  types.String `tfsdk:"resource_entity_type"`
+}
+
+func (m *templateLinkedPolicyModel) key() string {
+	return fmt.Sprintf("%s::%q,%s::%q", m.PrincipalEntityType.ValueString(), m.PrincipalEntityID.ValueString(), m.ResourceEntityType.ValueString(), m.ResourceEntityID.ValueString())
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsTemplateLinkedPolicies_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_template_linked_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateLinkedPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateLinkedPoliciesConfig_basic(rName, []string{"alice", "bob"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateLinkedPoliciesExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "policies.*", map[string]string{
+						"principal_entity_id":   "alice",
+						"principal_entity_type": "User",
+						"resource_entity_id":    "test_album",
+						"resource_entity_type":  "Album",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "policies.*", map[string]string{
+						"principal_entity_id":   "bob",
+						"principal_entity_type": "User",
+						"resource_entity_id":    "test_album",
+						"resource_entity_type":  "Album",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resource.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsTemplateLinkedPolicies_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_template_linked_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateLinkedPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateLinkedPoliciesConfig_basic(rName, []string{"alice", "bob"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateLinkedPoliciesExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "2"),
+				),
+			},
+			{
+				Config: testAccTemplateLinkedPoliciesConfig_basic(rName, []string{"bob", "carol", "dave"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateLinkedPoliciesExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "policies.*", map[string]string{
+						"principal_entity_id": "carol",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateLinkedPoliciesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_template_linked_policies" {
+				continue
+			}
+
+			for _, policyID := range testAccTemplateLinkedPolicyIDs(rs) {
+				_, err := tfverifiedpermissions.FindPolicyByID(ctx, conn, policyID, rs.Primary.Attributes["policy_store_id"])
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return create.Error(names.VerifiedPermissions, create.ErrActionCheckingDestroyed, tfverifiedpermissions.ResNameTemplateLinkedPolicies, rs.Primary.ID, err)
+				}
+
+				return create.Error(names.VerifiedPermissions, create.ErrActionCheckingDestroyed, tfverifiedpermissions.ResNameTemplateLinkedPolicies, rs.Primary.ID, fmt.Errorf("policy %s not destroyed", policyID))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateLinkedPoliciesExist(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNameTemplateLinkedPolicies, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNameTemplateLinkedPolicies, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, policyID := range testAccTemplateLinkedPolicyIDs(rs) {
+			if _, err := tfverifiedpermissions.FindPolicyByID(ctx, conn, policyID, rs.Primary.Attributes["policy_store_id"]); err != nil {
+				return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNameTemplateLinkedPolicies, rs.Primary.ID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTemplateLinkedPolicyIDs(rs *terraform.ResourceState) []string {
+	n, _ := strconv.Atoi(rs.Primary.Attributes["policies.#"])
+	policyIDs := make([]string, 0, n)
+
+	for i := range n {
+		policyIDs = append(policyIDs, rs.Primary.Attributes[fmt.Sprintf("policies.%d.policy_id", i)])
+	}
+
+	return policyIDs
+}
+
+func testAccTemplateLinkedPoliciesConfig_basic(rName string, principals []string) string {
+	var principalBlocks string
+	for _, principal := range principals {
+		principalBlocks += fmt.Sprintf(`
+  principal {
+    entity_id   = %[1]q
+    entity_type = "User"
+  }
+`, principal)
+	}
+
+	return acctest.ConfigCompose(
+		testAccPolicyConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_template" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  statement   = "permit (principal in ?principal, action in PhotoFlash::Action::\"FullPhotoAccess\", resource == ?resource) unless { resource.IsPrivate };"
+  description = %[1]q
+}
+
+resource "aws_verifiedpermissions_template_linked_policies" "test" {
+  policy_store_id    = aws_verifiedpermissions_policy_store.test.id
+  policy_template_id = aws_verifiedpermissions_policy_template.test.policy_template_id
+%[2]s
+  resource {
+    entity_id   = "test_album"
+    entity_type = "Album"
+  }
+}
+`, rName, principalBlocks))
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+const (
+	organizationStateActive    = "Active"
+	organizationStateCreating  = "Creating"
+	organizationStateDeleted   = "Deleted"
+	organizationStateDeleting  = "Deleting"
+	organizationStateFailed    = "Failed"
+	organizationStateRequested = "Requested"
+)
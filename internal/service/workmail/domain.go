@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_domain", name="Domain")
+func newDomainResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &domainResource{}
+
+	return r, nil
+}
+
+type domainResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (r *domainResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dkim_verification_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DnsRecordVerificationStatus](),
+				Computed:   true,
+			},
+			names.AttrDomainName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"is_default": schema.BoolAttribute{
+				Computed: true,
+			},
+			"is_test_domain": schema.BoolAttribute{
+				Computed: true,
+			},
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ownership_verification_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DnsRecordVerificationStatus](),
+				Computed:   true,
+			},
+			"records": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dnsRecordModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[dnsRecordModel](ctx),
+				},
+			},
+		},
+	}
+}
+
+func (r *domainResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data domainResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	input := &workmail.RegisterMailDomainInput{
+		ClientToken:    aws.String(sdkid.UniqueId()),
+		DomainName:     fwflex.StringFromFramework(ctx, data.DomainName),
+		OrganizationId: fwflex.StringFromFramework(ctx, data.OrganizationID),
+	}
+
+	_, err := conn.RegisterMailDomain(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail Domain (%s)", data.DomainName.ValueString()), err.Error())
+
+		return
+	}
+
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail Domain (%s)", data.DomainName.ValueString()), err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	output, err := findDomainByTwoPartKey(ctx, conn, data.OrganizationID.ValueString(), data.DomainName.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Domain (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *domainResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data domainResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	output, err := findDomainByTwoPartKey(ctx, conn, data.OrganizationID.ValueString(), data.DomainName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Domain (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *domainResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data domainResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	input := workmail.DeregisterMailDomainInput{
+		DomainName:     fwflex.StringFromFramework(ctx, data.DomainName),
+		OrganizationId: fwflex.StringFromFramework(ctx, data.OrganizationID),
+	}
+	_, err := conn.DeregisterMailDomain(ctx, &input)
+
+	if errs.IsA[*awstypes.MailDomainNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkMail Domain (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type domainResourceModel struct {
+	DKIMVerificationStatus      fwtypes.StringEnum[awstypes.DnsRecordVerificationStatus] `tfsdk:"dkim_verification_status"`
+	DomainName                  types.String                                             `tfsdk:"domain_name"`
+	ID                          types.String                                             `tfsdk:"id"`
+	IsDefault                   types.Bool                                               `tfsdk:"is_default"`
+	IsTestDomain                types.Bool                                               `tfsdk:"is_test_domain"`
+	OrganizationID              types.String                                             `tfsdk:"organization_id"`
+	OwnershipVerificationStatus fwtypes.StringEnum[awstypes.DnsRecordVerificationStatus] `tfsdk:"ownership_verification_status"`
+	Records                     fwtypes.ListNestedObjectValueOf[dnsRecordModel]          `tfsdk:"records"`
+}
+
+type dnsRecordModel struct {
+	Hostname types.String `tfsdk:"hostname"`
+	Type     types.String `tfsdk:"type"`
+	Value    types.String `tfsdk:"value"`
+}
+
+const (
+	domainResourceIDPartCount = 2
+)
+
+func (m *domainResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, domainResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.OrganizationID = types.StringValue(parts[0])
+	m.DomainName = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *domainResourceModel) setID() (string, error) {
+	parts := []string{
+		m.OrganizationID.ValueString(),
+		m.DomainName.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, domainResourceIDPartCount, false)
+}
+
+func findDomainByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, domainName string) (*workmail.GetMailDomainOutput, error) {
+	input := &workmail.GetMailDomainInput{
+		DomainName:     aws.String(domainName),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	output, err := conn.GetMailDomain(ctx, input)
+
+	if errs.IsA[*awstypes.MailDomainNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
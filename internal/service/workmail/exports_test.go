@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+// Exports for use in tests only.
+var (
+	ResourceDomain       = newDomainResource
+	ResourceGroup        = newGroupResource
+	ResourceOrganization = newOrganizationResource
+	ResourceUser         = newUserResource
+
+	FindDomainByTwoPartKey = findDomainByTwoPartKey
+	FindGroupByTwoPartKey  = findGroupByTwoPartKey
+	FindOrganizationByID   = findOrganizationByID
+	FindUserByTwoPartKey   = findUserByTwoPartKey
+)
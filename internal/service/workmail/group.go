@@ -0,0 +1,422 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_group", name="Group")
+func newGroupResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &groupResource{}
+
+	return r, nil
+}
+
+type groupResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *groupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrEmail: schema.StringAttribute{
+				Optional: true,
+			},
+			"group_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"member_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EntityState](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *groupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data groupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &workmail.CreateGroupInput{
+		Name:           aws.String(name),
+		OrganizationId: fwflex.StringFromFramework(ctx, data.OrganizationID),
+	}
+
+	output, err := conn.CreateGroup(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail Group (%s)", name), err.Error())
+
+		return
+	}
+
+	data.GroupID = fwflex.StringToFramework(ctx, output.GroupId)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail Group (%s)", name), err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	organizationID, groupID := data.OrganizationID.ValueString(), data.GroupID.ValueString()
+
+	if email := data.Email.ValueString(); email != "" {
+		if err := registerEntity(ctx, conn, organizationID, groupID, email); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("registering WorkMail Group (%s) to WorkMail", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if err := updateGroupMembers(ctx, conn, organizationID, groupID, fwflex.ExpandFrameworkStringValueSet(ctx, data.MemberIDs), nil); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("adding WorkMail Group (%s) members", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	group, err := findGroupByTwoPartKey(ctx, conn, organizationID, groupID)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.State = fwtypes.StringEnumValue(group.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *groupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data groupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, groupID := data.OrganizationID.ValueString(), data.GroupID.ValueString()
+
+	output, err := findGroupByTwoPartKey(ctx, conn, organizationID, groupID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Email = fwflex.StringToFramework(ctx, output.Email)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.State = fwtypes.StringEnumValue(output.State)
+
+	members, err := findGroupMembersByTwoPartKey(ctx, conn, organizationID, groupID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Group (%s) members", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	var memberIDs []string
+	for _, v := range members {
+		memberIDs = append(memberIDs, aws.ToString(v.Id))
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, memberIDs, &data.MemberIDs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *groupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new groupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, groupID := new.OrganizationID.ValueString(), new.GroupID.ValueString()
+
+	if !new.Email.Equal(old.Email) {
+		if err := updateEmail(ctx, conn, organizationID, groupID, old.Email.ValueString(), new.Email.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail Group (%s) email", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.MemberIDs.Equal(old.MemberIDs) {
+		oldMemberIDs, newMemberIDs := fwflex.ExpandFrameworkStringValueSet(ctx, old.MemberIDs), fwflex.ExpandFrameworkStringValueSet(ctx, new.MemberIDs)
+
+		if err := updateGroupMembers(ctx, conn, organizationID, groupID, newMemberIDs.Difference(oldMemberIDs), oldMemberIDs.Difference(newMemberIDs)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail Group (%s) members", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findGroupByTwoPartKey(ctx, conn, organizationID, groupID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Group (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.State = fwtypes.StringEnumValue(output.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *groupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data groupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, groupID := data.OrganizationID.ValueString(), data.GroupID.ValueString()
+
+	// A group must be deregistered from WorkMail before it can be deleted.
+	if data.State.ValueEnum() == awstypes.EntityStateEnabled {
+		if err := deregisterEntity(ctx, conn, organizationID, groupID); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deregistering WorkMail Group (%s) from WorkMail", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	input := workmail.DeleteGroupInput{
+		GroupId:        aws.String(groupID),
+		OrganizationId: aws.String(organizationID),
+	}
+	_, err := conn.DeleteGroup(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkMail Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type groupResourceModel struct {
+	Email          types.String                             `tfsdk:"email"`
+	GroupID        types.String                             `tfsdk:"group_id"`
+	ID             types.String                             `tfsdk:"id"`
+	MemberIDs      fwtypes.SetOfString                      `tfsdk:"member_ids"`
+	Name           types.String                             `tfsdk:"name"`
+	OrganizationID types.String                             `tfsdk:"organization_id"`
+	State          fwtypes.StringEnum[awstypes.EntityState] `tfsdk:"state"`
+}
+
+const (
+	groupResourceIDPartCount = 2
+)
+
+func (m *groupResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, groupResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.OrganizationID = types.StringValue(parts[0])
+	m.GroupID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *groupResourceModel) setID() (string, error) {
+	parts := []string{
+		m.OrganizationID.ValueString(),
+		m.GroupID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, groupResourceIDPartCount, false)
+}
+
+func findGroupByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, groupID string) (*workmail.DescribeGroupOutput, error) {
+	input := &workmail.DescribeGroupInput{
+		GroupId:        aws.String(groupID),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	output, err := conn.DescribeGroup(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.State; state == awstypes.EntityStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findGroupMembersByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, groupID string) ([]awstypes.Member, error) {
+	input := &workmail.ListGroupMembersInput{
+		GroupId:        aws.String(groupID),
+		OrganizationId: aws.String(organizationID),
+	}
+	var output []awstypes.Member
+
+	pages := workmail.NewListGroupMembersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Members {
+			if v.State != awstypes.EntityStateDeleted {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func updateGroupMembers(ctx context.Context, conn *workmail.Client, organizationID, groupID string, add, remove []string) error {
+	for _, memberID := range remove {
+		input := &workmail.DisassociateMemberFromGroupInput{
+			GroupId:        aws.String(groupID),
+			MemberId:       aws.String(memberID),
+			OrganizationId: aws.String(organizationID),
+		}
+
+		_, err := conn.DisassociateMemberFromGroup(ctx, input)
+
+		if errs.IsA[*awstypes.EntityNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("removing member (%s): %w", memberID, err)
+		}
+	}
+
+	for _, memberID := range add {
+		input := &workmail.AssociateMemberToGroupInput{
+			GroupId:        aws.String(groupID),
+			MemberId:       aws.String(memberID),
+			OrganizationId: aws.String(organizationID),
+		}
+
+		if _, err := conn.AssociateMemberToGroup(ctx, input); err != nil {
+			return fmt.Errorf("adding member (%s): %w", memberID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_organization", name="Organization")
+// @Tags(identifierAttribute="arn")
+func newOrganizationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &organizationResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type organizationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[organizationResourceModel]
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (r *organizationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAlias: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 62),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-z0-9](([a-z0-9-]{0,60})[a-z0-9])?$`), "must contain only lowercase alphanumeric characters and hyphens, and must begin and end with an alphanumeric character"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"default_mail_domain": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_directory": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"directory_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"directory_type": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *organizationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data organizationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	alias := data.Alias.ValueString()
+	input := &workmail.CreateOrganizationInput{
+		Alias:       aws.String(alias),
+		ClientToken: aws.String(sdkid.UniqueId()),
+		DirectoryId: fwflex.StringFromFramework(ctx, data.DirectoryID),
+		KmsKeyArn:   fwflex.StringFromFramework(ctx, data.KMSKeyARN),
+	}
+
+	output, err := conn.CreateOrganization(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail Organization (%s)", alias), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.OrganizationId)
+
+	org, err := waitOrganizationCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkMail Organization (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The CreateOrganization API doesn't accept tags.
+	if err := createTags(ctx, conn, aws.ToString(org.ARN), getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("setting WorkMail Organization (%s) tags", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setFromDescribeOrganizationOutput(org)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *organizationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data organizationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	output, err := findOrganizationByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail Organization (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.setFromDescribeOrganizationOutput(output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *organizationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data organizationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	input := workmail.DeleteOrganizationInput{
+		ClientToken:     aws.String(sdkid.UniqueId()),
+		DeleteDirectory: data.DeleteDirectory.ValueBool(),
+		OrganizationId:  fwflex.StringFromFramework(ctx, data.ID),
+	}
+	_, err := conn.DeleteOrganization(ctx, &input)
+
+	if errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkMail Organization (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitOrganizationDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkMail Organization (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type organizationResourceModel struct {
+	Alias             types.String   `tfsdk:"alias"`
+	ARN               types.String   `tfsdk:"arn"`
+	DefaultMailDomain types.String   `tfsdk:"default_mail_domain"`
+	DeleteDirectory   types.Bool     `tfsdk:"delete_directory"`
+	DirectoryID       types.String   `tfsdk:"directory_id"`
+	DirectoryType     types.String   `tfsdk:"directory_type"`
+	ID                types.String   `tfsdk:"id"`
+	KMSKeyARN         fwtypes.ARN    `tfsdk:"kms_key_arn"`
+	State             types.String   `tfsdk:"state"`
+	Tags              tftags.Map     `tfsdk:"tags"`
+	TagsAll           tftags.Map     `tfsdk:"tags_all"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *organizationResourceModel) setFromDescribeOrganizationOutput(output *workmail.DescribeOrganizationOutput) {
+	m.Alias = types.StringPointerValue(output.Alias)
+	m.ARN = types.StringPointerValue(output.ARN)
+	m.DefaultMailDomain = types.StringPointerValue(output.DefaultMailDomain)
+	m.DirectoryID = types.StringPointerValue(output.DirectoryId)
+	m.DirectoryType = types.StringPointerValue(output.DirectoryType)
+	m.State = types.StringPointerValue(output.State)
+	if m.DeleteDirectory.IsNull() {
+		m.DeleteDirectory = types.BoolValue(false)
+	}
+}
+
+func findOrganizationByID(ctx context.Context, conn *workmail.Client, id string) (*workmail.DescribeOrganizationOutput, error) {
+	input := &workmail.DescribeOrganizationInput{
+		OrganizationId: aws.String(id),
+	}
+
+	output, err := conn.DescribeOrganization(ctx, input)
+
+	if errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := aws.ToString(output.State); state == organizationStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusOrganization(ctx context.Context, conn *workmail.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findOrganizationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.State), nil
+	}
+}
+
+func waitOrganizationCreated(ctx context.Context, conn *workmail.Client, id string, timeout time.Duration) (*workmail.DescribeOrganizationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{organizationStateRequested, organizationStateCreating},
+		Target:  []string{organizationStateActive},
+		Refresh: statusOrganization(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*workmail.DescribeOrganizationOutput); ok {
+		if aws.ToString(output.State) == organizationStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOrganizationDeleted(ctx context.Context, conn *workmail.Client, id string, timeout time.Duration) (*workmail.DescribeOrganizationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{organizationStateActive, organizationStateDeleting},
+		Target:  []string{},
+		Refresh: statusOrganization(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*workmail.DescribeOrganizationOutput); ok {
+		if aws.ToString(output.State) == organizationStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
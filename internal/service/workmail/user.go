@@ -0,0 +1,459 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workmail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workmail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workmail/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workmail_user", name="User")
+func newUserResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &userResource{}
+
+	return r, nil
+}
+
+type userResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *userResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			names.AttrEmail: schema.StringAttribute{
+				Optional: true,
+			},
+			"first_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(64),
+				},
+			},
+			"hidden_from_global_address_list": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(64),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			names.AttrRole: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserRole](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EntityState](),
+				Computed:   true,
+			},
+			"user_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *userResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data userResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &workmail.CreateUserInput{
+		DisplayName:                 fwflex.StringFromFramework(ctx, data.DisplayName),
+		FirstName:                   fwflex.StringFromFramework(ctx, data.FirstName),
+		HiddenFromGlobalAddressList: data.HiddenFromGlobalAddressList.ValueBool(),
+		LastName:                    fwflex.StringFromFramework(ctx, data.LastName),
+		Name:                        aws.String(name),
+		OrganizationId:              fwflex.StringFromFramework(ctx, data.OrganizationID),
+		Password:                    fwflex.StringFromFramework(ctx, data.Password),
+		Role:                        data.Role.ValueEnum(),
+	}
+
+	output, err := conn.CreateUser(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail User (%s)", name), err.Error())
+
+		return
+	}
+
+	data.UserID = fwflex.StringToFramework(ctx, output.UserId)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkMail User (%s)", name), err.Error())
+
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	if email := data.Email.ValueString(); email != "" {
+		if err := registerEntity(ctx, conn, data.OrganizationID.ValueString(), data.UserID.ValueString(), email); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("registering WorkMail User (%s) to WorkMail", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	user, err := findUserByTwoPartKey(ctx, conn, data.OrganizationID.ValueString(), data.UserID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail User (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.HiddenFromGlobalAddressList = types.BoolValue(user.HiddenFromGlobalAddressList)
+	data.Role = fwtypes.StringEnumValue(user.UserRole)
+	data.State = fwtypes.StringEnumValue(user.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *userResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data userResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	output, err := findUserByTwoPartKey(ctx, conn, data.OrganizationID.ValueString(), data.UserID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail User (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.DisplayName = fwflex.StringToFramework(ctx, output.DisplayName)
+	data.Email = fwflex.StringToFramework(ctx, output.Email)
+	data.FirstName = fwflex.StringToFramework(ctx, output.FirstName)
+	data.HiddenFromGlobalAddressList = types.BoolValue(output.HiddenFromGlobalAddressList)
+	data.LastName = fwflex.StringToFramework(ctx, output.LastName)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.Role = fwtypes.StringEnumValue(output.UserRole)
+	data.State = fwtypes.StringEnumValue(output.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *userResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new userResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, userID := new.OrganizationID.ValueString(), new.UserID.ValueString()
+
+	if !new.DisplayName.Equal(old.DisplayName) ||
+		!new.FirstName.Equal(old.FirstName) ||
+		!new.HiddenFromGlobalAddressList.Equal(old.HiddenFromGlobalAddressList) ||
+		!new.LastName.Equal(old.LastName) ||
+		!new.Role.Equal(old.Role) {
+		input := &workmail.UpdateUserInput{
+			DisplayName:                 aws.String(new.DisplayName.ValueString()),
+			FirstName:                   aws.String(new.FirstName.ValueString()),
+			HiddenFromGlobalAddressList: fwflex.BoolFromFramework(ctx, new.HiddenFromGlobalAddressList),
+			LastName:                    aws.String(new.LastName.ValueString()),
+			OrganizationId:              aws.String(organizationID),
+			Role:                        new.Role.ValueEnum(),
+			UserId:                      aws.String(userID),
+		}
+
+		_, err := conn.UpdateUser(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail User (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Password.Equal(old.Password) && !new.Password.IsNull() {
+		input := &workmail.ResetPasswordInput{
+			OrganizationId: aws.String(organizationID),
+			Password:       fwflex.StringFromFramework(ctx, new.Password),
+			UserId:         aws.String(userID),
+		}
+
+		_, err := conn.ResetPassword(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("resetting WorkMail User (%s) password", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Email.Equal(old.Email) {
+		if err := updateEmail(ctx, conn, organizationID, userID, old.Email.ValueString(), new.Email.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkMail User (%s) email", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findUserByTwoPartKey(ctx, conn, organizationID, userID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkMail User (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.State = fwtypes.StringEnumValue(output.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *userResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data userResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkMailClient(ctx)
+
+	organizationID, userID := data.OrganizationID.ValueString(), data.UserID.ValueString()
+
+	// A user must be deregistered from WorkMail before it can be deleted.
+	if data.State.ValueEnum() == awstypes.EntityStateEnabled {
+		if err := deregisterEntity(ctx, conn, organizationID, userID); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deregistering WorkMail User (%s) from WorkMail", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	input := workmail.DeleteUserInput{
+		OrganizationId: aws.String(organizationID),
+		UserId:         aws.String(userID),
+	}
+	_, err := conn.DeleteUser(ctx, &input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkMail User (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type userResourceModel struct {
+	DisplayName                 types.String                             `tfsdk:"display_name"`
+	Email                       types.String                             `tfsdk:"email"`
+	FirstName                   types.String                             `tfsdk:"first_name"`
+	HiddenFromGlobalAddressList types.Bool                               `tfsdk:"hidden_from_global_address_list"`
+	ID                          types.String                             `tfsdk:"id"`
+	LastName                    types.String                             `tfsdk:"last_name"`
+	Name                        types.String                             `tfsdk:"name"`
+	OrganizationID              types.String                             `tfsdk:"organization_id"`
+	Password                    types.String                             `tfsdk:"password"`
+	Role                        fwtypes.StringEnum[awstypes.UserRole]    `tfsdk:"role"`
+	State                       fwtypes.StringEnum[awstypes.EntityState] `tfsdk:"state"`
+	UserID                      types.String                             `tfsdk:"user_id"`
+}
+
+const (
+	userResourceIDPartCount = 2
+)
+
+func (m *userResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, userResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.OrganizationID = types.StringValue(parts[0])
+	m.UserID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *userResourceModel) setID() (string, error) {
+	parts := []string{
+		m.OrganizationID.ValueString(),
+		m.UserID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, userResourceIDPartCount, false)
+}
+
+func findUserByTwoPartKey(ctx context.Context, conn *workmail.Client, organizationID, userID string) (*workmail.DescribeUserOutput, error) {
+	input := &workmail.DescribeUserInput{
+		OrganizationId: aws.String(organizationID),
+		UserId:         aws.String(userID),
+	}
+
+	output, err := conn.DescribeUser(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.OrganizationNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.State; state == awstypes.EntityStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func registerEntity(ctx context.Context, conn *workmail.Client, organizationID, entityID, email string) error {
+	input := &workmail.RegisterToWorkMailInput{
+		Email:          aws.String(email),
+		EntityId:       aws.String(entityID),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	_, err := conn.RegisterToWorkMail(ctx, input)
+
+	return err
+}
+
+func deregisterEntity(ctx context.Context, conn *workmail.Client, organizationID, entityID string) error {
+	input := &workmail.DeregisterFromWorkMailInput{
+		EntityId:       aws.String(entityID),
+		OrganizationId: aws.String(organizationID),
+	}
+
+	_, err := conn.DeregisterFromWorkMail(ctx, input)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsA[*awstypes.EntityStateException](err) {
+		return nil
+	}
+
+	return err
+}
+
+// updateEmail registers, re-addresses or deregisters a user or group depending on the change to its primary email address.
+func updateEmail(ctx context.Context, conn *workmail.Client, organizationID, entityID, old, new string) error {
+	switch {
+	case old == "":
+		return registerEntity(ctx, conn, organizationID, entityID, new)
+	case new == "":
+		return deregisterEntity(ctx, conn, organizationID, entityID)
+	default:
+		input := &workmail.UpdatePrimaryEmailAddressInput{
+			Email:          aws.String(new),
+			EntityId:       aws.String(entityID),
+			OrganizationId: aws.String(organizationID),
+		}
+
+		_, err := conn.UpdatePrimaryEmailAddress(ctx, input)
+
+		return err
+	}
+}
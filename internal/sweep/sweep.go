@@ -100,6 +100,32 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	return client, nil
 }
 
+// TagFilterEnabled returns whether sweepers are restricted to resources with a specific tag.
+func TagFilterEnabled() bool {
+	return os.Getenv(envvar.SweepTagKey) != ""
+}
+
+// SkipByTags returns whether a resource with the specified tags should be left alone by a sweeper.
+// Unless TF_AWS_SWEEP_TAG_KEY is set no resources are skipped. Otherwise the resource must have
+// that tag key and, if TF_AWS_SWEEP_TAG_VALUE is also set, that tag value.
+func SkipByTags(tags map[string]string) bool {
+	key := os.Getenv(envvar.SweepTagKey)
+	if key == "" {
+		return false
+	}
+
+	v, ok := tags[key]
+	if !ok {
+		return true
+	}
+
+	if value := os.Getenv(envvar.SweepTagValue); value != "" && v != value {
+		return true
+	}
+
+	return false
+}
+
 type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
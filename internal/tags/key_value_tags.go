@@ -52,7 +52,9 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	// ExcludeResourceTypes lists the resource types, e.g. "aws_subnet", that default tags are not applied to.
+	ExcludeResourceTypes []string
+	Tags                 KeyValueTags
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -76,6 +78,15 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ExcludesResourceType returns true if default tags are not applied to the given resource type.
+func (dc *DefaultConfig) ExcludesResourceType(typeName string) bool {
+	if dc == nil {
+		return false
+	}
+
+	return slices.Contains(dc.ExcludeResourceTypes, typeName)
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -57,6 +57,56 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigExcludesResourceType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		want          bool
+	}{
+		{
+			name:          "nil config",
+			defaultConfig: nil,
+			typeName:      "aws_opsworks_custom_layer",
+			want:          false,
+		},
+		{
+			name:          "no exclusions",
+			defaultConfig: &DefaultConfig{},
+			typeName:      "aws_opsworks_custom_layer",
+			want:          false,
+		},
+		{
+			name: "excluded",
+			defaultConfig: &DefaultConfig{
+				ExcludeResourceTypes: []string{"aws_opsworks_custom_layer", "aws_opsworks_stack"},
+			},
+			typeName: "aws_opsworks_custom_layer",
+			want:     true,
+		},
+		{
+			name: "not excluded",
+			defaultConfig: &DefaultConfig{
+				ExcludeResourceTypes: []string{"aws_opsworks_custom_layer", "aws_opsworks_stack"},
+			},
+			typeName: "aws_opsworks_php_app_layer",
+			want:     false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.defaultConfig.ExcludesResourceType(testCase.typeName); got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
@@ -42,6 +42,13 @@ type ServicePackageFrameworkResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	// ComputedRegion and ComputedAccountID indicate that the provider populates
+	// the resource's computed `region` and `account_id` attributes.
+	ComputedRegion    bool
+	ComputedAccountID bool
+	// RegionOverride indicates that the resource's Optional `region` attribute
+	// selects the Region in which the resource is managed.
+	RegionOverride bool
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
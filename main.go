@@ -10,6 +10,7 @@ import (
 	"runtime/debug"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -26,7 +27,7 @@ func main() {
 		log.Printf("Starting %s@%s (%s)...", buildInfo.Main.Path, version.ProviderVersion, buildInfo.GoVersion)
 	}
 
-	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
+	serverFactory, primary, err := provider.ProtoV5ProviderServerFactory(context.Background())
 
 	if err != nil {
 		log.Fatal(err)
@@ -44,6 +45,13 @@ func main() {
 		serveOpts...,
 	)
 
+	// Serve returns when Terraform shuts the provider down.
+	if v, ok := primary.Meta().(*conns.AWSClient); ok {
+		if err := v.Close(); err != nil {
+			log.Printf("[WARN] closing AWS client: %s", err)
+		}
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
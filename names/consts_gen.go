@@ -167,6 +167,7 @@ const (
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
 	NetworkMonitor               = "networkmonitor"
+	Notifications                = "notifications"
 	ObservabilityAccessManager   = "oam"
 	OpenSearch                   = "opensearch"
 	OpenSearchIngestion          = "osis"
@@ -251,6 +252,7 @@ const (
 	WAFV2                        = "wafv2"
 	WellArchitected              = "wellarchitected"
 	WorkLink                     = "worklink"
+	WorkMail                     = "workmail"
 	WorkSpaces                   = "workspaces"
 	WorkSpacesWeb                = "workspacesweb"
 	XRay                         = "xray"
@@ -424,6 +426,7 @@ const (
 	NetworkFirewallServiceID              = "Network Firewall"
 	NetworkManagerServiceID               = "NetworkManager"
 	NetworkMonitorServiceID               = "NetworkMonitor"
+	NotificationsServiceID                = "Notifications"
 	ObservabilityAccessManagerServiceID   = "OAM"
 	OpenSearchServiceID                   = "OpenSearch"
 	OpenSearchIngestionServiceID          = "OSIS"
@@ -508,6 +511,7 @@ const (
 	WAFV2ServiceID                        = "WAFV2"
 	WellArchitectedServiceID              = "WellArchitected"
 	WorkLinkServiceID                     = "WorkLink"
+	WorkMailServiceID                     = "WorkMail"
 	WorkSpacesServiceID                   = "WorkSpaces"
 	WorkSpacesWebServiceID                = "WorkSpaces Web"
 	XRayServiceID                         = "XRay"
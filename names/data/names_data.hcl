@@ -5651,6 +5651,32 @@ service "nimble" {
   not_implemented          = true
 }
 
+service "notifications" {
+  sdk {
+    id = "Notifications"
+  }
+
+  names {
+    provider_name_upper = "Notifications"
+    human_friendly      = "User Notifications"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListNotificationHubs"
+    endpoint_region_overrides = {
+      "aws" = "us-east-1"
+    }
+  }
+
+  resource_prefix {
+    correct = "aws_notifications_"
+  }
+
+  provider_package_correct = "notifications"
+  doc_prefix               = ["notifications_"]
+  brand                    = "AWS"
+}
+
 service "oam" {
   sdk {
     id = "OAM"
@@ -8399,6 +8425,10 @@ service "workmail" {
     human_friendly      = "WorkMail"
   }
 
+  endpoint_info {
+    endpoint_api_call = "ListOrganizations"
+  }
+
   resource_prefix {
     correct = "aws_workmail_"
   }
@@ -8406,7 +8436,6 @@ service "workmail" {
   provider_package_correct = "workmail"
   doc_prefix               = ["workmail_"]
   brand                    = "Amazon"
-  not_implemented          = true
 }
 
 service "workmailmessageflow" {